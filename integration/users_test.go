@@ -29,6 +29,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GuinsooLab/console/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -715,7 +716,6 @@ func TestCreateServiceAccountForUser(t *testing.T) {
 	userName := "testcreateserviceaccountforuser1"
 	assert := assert.New(t)
 	policy := ""
-	serviceAccountLengthInBytes := 40 // As observed, update as needed
 
 	// 1. Create the user
 	groups := []string{}
@@ -757,16 +757,16 @@ func TestCreateServiceAccountForUser(t *testing.T) {
 		log.Println(listOfAccountsError)
 		assert.Fail("Error in listOfAccountsError")
 	}
-	finalResponse := inspectHTTPResponse(listOfAccountsResponse)
 	if listOfAccountsResponse != nil {
 		fmt.Println("StatusCode:", listOfAccountsResponse.StatusCode)
-		assert.Equal(
-			200, listOfAccountsResponse.StatusCode,
-			finalResponse,
-		)
+		assert.Equal(200, listOfAccountsResponse.StatusCode)
+		serviceAccounts := models.ServiceAccounts{}
+		assert.Nil(json.NewDecoder(listOfAccountsResponse.Body).Decode(&serviceAccounts))
+		if assert.Len(serviceAccounts, 1) {
+			assert.Equal(userName, serviceAccounts[0].ParentUser)
+			assert.Len(serviceAccounts[0].AccessKey, 20)
+		}
 	}
-
-	assert.Equal(len(finalResponse), serviceAccountLengthInBytes, finalResponse)
 }
 
 func TestUsersGroupsBulk(t *testing.T) {
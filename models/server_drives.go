@@ -37,6 +37,9 @@ type ServerDrives struct {
 	// available space
 	AvailableSpace int64 `json:"availableSpace,omitempty"`
 
+	// disk index
+	DiskIndex int64 `json:"diskIndex,omitempty"`
+
 	// drive path
 	DrivePath string `json:"drivePath,omitempty"`
 
@@ -49,9 +52,15 @@ type ServerDrives struct {
 	// model
 	Model string `json:"model,omitempty"`
 
+	// pool index
+	PoolIndex int64 `json:"poolIndex,omitempty"`
+
 	// root disk
 	RootDisk bool `json:"rootDisk,omitempty"`
 
+	// set index
+	SetIndex int64 `json:"setIndex,omitempty"`
+
 	// state
 	State string `json:"state,omitempty"`
 
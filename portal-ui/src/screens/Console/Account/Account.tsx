@@ -30,7 +30,7 @@ import {
   PasswordKeyIcon,
 } from "../../../icons";
 import TableWrapper from "../Common/TableWrapper/TableWrapper";
-import { stringSort } from "../../../utils/sortFunctions";
+import { ServiceAccountItem } from "../Users/types";
 import PageHeader from "../Common/PageHeader/PageHeader";
 import {
   actionsTray,
@@ -52,7 +52,7 @@ import {
 } from "../../../common/SecureComponent/permissions";
 import { SecureComponent } from "../../../common/SecureComponent";
 import RBIconButton from "../Buckets/BucketDetails/SummaryItems/RBIconButton";
-import { selectSAs } from "../Configurations/utils";
+import { selectSAs, serviceAccountColumns } from "../Configurations/utils";
 import DeleteMultipleServiceAccounts from "../Users/DeleteMultipleServiceAccounts";
 import ServiceAccountPolicy from "./ServiceAccountPolicy";
 import { setErrorSnackMessage, setSnackBarMessage } from "../../../systemSlice";
@@ -85,7 +85,7 @@ const Account = () => {
   const classes = useStyles();
   const features = useSelector(selFeatures);
 
-  const [records, setRecords] = useState<string[]>([]);
+  const [records, setRecords] = useState<ServiceAccountItem[]>([]);
   const [loading, setLoading] = useState<boolean>(false);
   const [filter, setFilter] = useState<string>("");
   const [deleteOpen, setDeleteOpen] = useState<boolean>(false);
@@ -108,8 +108,8 @@ const Account = () => {
     if (loading) {
       api
         .invoke("GET", `/api/v1/service-accounts`)
-        .then((res: string[]) => {
-          const serviceAccounts = res.sort(stringSort);
+        .then((res: ServiceAccountItem[]) => {
+          const serviceAccounts = res || [];
 
           setLoading(false);
           setRecords(serviceAccounts);
@@ -152,7 +152,7 @@ const Account = () => {
       setSelectedSAs([]);
       return;
     }
-    setSelectedSAs(records);
+    setSelectedSAs(records.map((record) => record.accessKey));
   };
 
   const closePolicyModal = () => {
@@ -166,12 +166,12 @@ const Account = () => {
   };
 
   const tableActions = [
-    { type: "view", onClick: policyModalOpen },
-    { type: "delete", onClick: confirmDeleteServiceAccount },
+    { type: "view", onClick: policyModalOpen, sendOnlyId: true },
+    { type: "delete", onClick: confirmDeleteServiceAccount, sendOnlyId: true },
   ];
 
   const filteredRecords = records.filter((elementItem) =>
-    elementItem.toLowerCase().includes(filter.toLowerCase())
+    elementItem.accessKey.toLowerCase().includes(filter.toLowerCase())
   );
 
   return (
@@ -263,8 +263,8 @@ const Account = () => {
             isLoading={loading}
             records={filteredRecords}
             entityName={"Service Accounts"}
-            idField={""}
-            columns={[{ label: "Service Account", elementKey: "" }]}
+            idField={"accessKey"}
+            columns={serviceAccountColumns}
             itemActions={tableActions}
             selectedItems={selectedSAs}
             onSelect={(e) => selectSAs(e, setSelectedSAs, selectedSAs)}
@@ -548,3 +548,19 @@ export const selectSAs = (
   setSelectedSAs(elements);
   return elements;
 };
+
+export const serviceAccountColumns = [
+  { label: "Service Account", elementKey: "accessKey" },
+  {
+    label: "Last Used",
+    elementKey: "lastUsed",
+    renderFunction: (lastUsed: string) =>
+      lastUsed ? new Date(lastUsed).toLocaleString() : "Never",
+  },
+  { label: "Calls", elementKey: "totalCalls", width: 90 },
+  {
+    label: "Source IPs",
+    elementKey: "sourceIps",
+    renderFunction: (sourceIps: string[]) => (sourceIps || []).join(", "),
+  },
+];
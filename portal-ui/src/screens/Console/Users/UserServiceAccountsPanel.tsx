@@ -29,7 +29,7 @@ import {
 import api from "../../../common/api";
 import TableWrapper from "../Common/TableWrapper/TableWrapper";
 import { NewServiceAccount } from "../Common/CredentialsPrompt/types";
-import { stringSort } from "../../../utils/sortFunctions";
+import { ServiceAccountItem } from "./types";
 import { ErrorResponseHandler } from "../../../common/types";
 import AddUserServiceAccount from "./AddUserServiceAccount";
 import DeleteServiceAccount from "../Account/DeleteServiceAccount";
@@ -38,7 +38,7 @@ import { AddIcon, DeleteIcon } from "../../../icons";
 import PanelTitle from "../Common/PanelTitle/PanelTitle";
 import RBIconButton from "../Buckets/BucketDetails/SummaryItems/RBIconButton";
 import DeleteMultipleServiceAccounts from "./DeleteMultipleServiceAccounts";
-import { selectSAs } from "../Configurations/utils";
+import { selectSAs, serviceAccountColumns } from "../Configurations/utils";
 import ServiceAccountPolicy from "../Account/ServiceAccountPolicy";
 import {
   CONSOLE_UI_RESOURCE,
@@ -73,7 +73,7 @@ const UserServiceAccountsPanel = ({
   const dispatch = useAppDispatch();
   const navigate = useNavigate();
 
-  const [records, setRecords] = useState<string[]>([]);
+  const [records, setRecords] = useState<ServiceAccountItem[]>([]);
   const [loading, setLoading] = useState<boolean>(false);
   const [addScreenOpen, setAddScreenOpen] = useState<boolean>(false);
   const [deleteOpen, setDeleteOpen] = useState<boolean>(false);
@@ -95,8 +95,8 @@ const UserServiceAccountsPanel = ({
     if (loading) {
       api
         .invoke("GET", `/api/v1/user/${encodeURLString(user)}/service-accounts`)
-        .then((res: string[]) => {
-          const serviceAccounts = res.sort(stringSort);
+        .then((res: ServiceAccountItem[]) => {
+          const serviceAccounts = res || [];
           setLoading(false);
           setRecords(serviceAccounts);
         })
@@ -150,7 +150,7 @@ const UserServiceAccountsPanel = ({
       setSelectedSAs([]);
       return;
     }
-    setSelectedSAs(records);
+    setSelectedSAs(records.map((record) => record.accessKey));
   };
 
   const closeCredentialsModal = () => {
@@ -174,8 +174,8 @@ const UserServiceAccountsPanel = ({
   };
 
   const tableActions = [
-    { type: "view", onClick: policyModalOpen },
-    { type: "delete", onClick: confirmDeleteServiceAccount },
+    { type: "view", onClick: policyModalOpen, sendOnlyId: true },
+    { type: "delete", onClick: confirmDeleteServiceAccount, sendOnlyId: true },
   ];
 
   return (
@@ -268,8 +268,8 @@ const UserServiceAccountsPanel = ({
           isLoading={loading}
           records={records}
           entityName={"Service Accounts"}
-          idField={""}
-          columns={[{ label: "Service Account", elementKey: "" }]}
+          idField={"accessKey"}
+          columns={serviceAccountColumns}
           itemActions={tableActions}
           selectedItems={selectedSAs}
           onSelect={(e) => selectSAs(e, setSelectedSAs, selectedSAs)}
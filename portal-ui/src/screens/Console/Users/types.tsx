@@ -33,3 +33,12 @@ export interface UsersList {
 export interface IPolicyItem {
   policy: string;
 }
+
+export interface ServiceAccountItem {
+  accessKey: string;
+  parentUser?: string;
+  lastUsed?: string;
+  neverUsed?: boolean;
+  totalCalls?: number;
+  sourceIps?: string[];
+}
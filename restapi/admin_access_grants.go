@@ -0,0 +1,378 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	policyApi "github.com/GuinsooLab/console/restapi/operations/policy"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// accessGrantsMu serializes the updates of the access grants file
+var accessGrantsMu sync.Mutex
+
+// accessGrantsEnabled is set once the scheduler detaching the expired grants is running, grants
+// can't be created otherwise since they would never end
+var accessGrantsEnabled bool
+
+// accessGrantScheduler detaches the policies of the expired access grants every `interval`
+type accessGrantScheduler struct {
+	file     string
+	interval time.Duration
+	admin    MinioAdmin
+}
+
+func registerAccessGrantsHandlers(api *operations.ConsoleAPI) {
+	// list access grants
+	api.PolicyListAccessGrantsHandler = policyApi.ListAccessGrantsHandlerFunc(func(params policyApi.ListAccessGrantsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListAccessGrantsResponse(session, params)
+		if err != nil {
+			return policyApi.NewListAccessGrantsDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewListAccessGrantsOK().WithPayload(resp)
+	})
+	// create access grant
+	api.PolicyCreateAccessGrantHandler = policyApi.CreateAccessGrantHandlerFunc(func(params policyApi.CreateAccessGrantParams, session *models.Principal) middleware.Responder {
+		resp, err := getCreateAccessGrantResponse(session, params)
+		if err != nil {
+			return policyApi.NewCreateAccessGrantDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewCreateAccessGrantCreated().WithPayload(resp)
+	})
+	// revoke access grant
+	api.PolicyRevokeAccessGrantHandler = policyApi.RevokeAccessGrantHandlerFunc(func(params policyApi.RevokeAccessGrantParams, session *models.Principal) middleware.Responder {
+		resp, err := getRevokeAccessGrantResponse(session, params)
+		if err != nil {
+			return policyApi.NewRevokeAccessGrantDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewRevokeAccessGrantOK().WithPayload(resp)
+	})
+}
+
+// getAccessGrantsFile returns the file keeping the access grants
+func getAccessGrantsFile() string {
+	return filepath.Join(getDataDir(), "access-grants.json")
+}
+
+func readAccessGrants(file string) ([]*models.AccessGrant, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var grants []*models.AccessGrant
+	if err = json.Unmarshal(b, &grants); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+func writeAccessGrants(file string, grants []*models.AccessGrant) error {
+	return writeDataFile(file, grants)
+}
+
+// startAccessGrantScheduler starts detaching the expired access grants if configured, the policies are
+// detached with their own credentials since there is no user session to use
+func startAccessGrantScheduler(ctx context.Context) {
+	accessKey, secretKey := getGrantsCredentials()
+	if accessKey == "" || secretKey == "" {
+		LogInfo("access grants disabled, %s and %s are required", ConsoleGrantsAccessKey, ConsoleGrantsSecretKey)
+		return
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		LogError("access grants disabled: %v", err)
+		return
+	}
+	mAdmin.SetCustomTransport(GetConsoleHTTPClient().Transport)
+	scheduler := &accessGrantScheduler{
+		file:     getAccessGrantsFile(),
+		interval: getGrantsInterval(),
+		admin:    AdminClient{Client: mAdmin},
+	}
+	accessGrantsEnabled = true
+	go scheduler.run(ctx)
+}
+
+func (s *accessGrantScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := expireAccessGrants(ctx, s.admin, s.file, time.Now()); err != nil {
+			LogError("error expiring access grants: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getEntityPolicies returns the policies attached to a user or group
+func getEntityPolicies(ctx context.Context, client MinioAdmin, entityType, entity string) ([]string, error) {
+	if entityType == models.AccessGrantEntityTypeGroup {
+		desc, err := client.getGroupDescription(ctx, entity)
+		if err != nil {
+			return nil, err
+		}
+		return splitPolicyNames(desc.Policy), nil
+	}
+	info, err := client.getUserInfo(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	return splitPolicyNames(info.PolicyName), nil
+}
+
+// createAccessGrant attaches the policy to the user or group and records when it has to be detached
+func createAccessGrant(ctx context.Context, client MinioAdmin, file string, req *models.AccessGrantRequest, grantedBy string, now time.Time, maxDuration time.Duration) (*models.AccessGrant, error) {
+	duration, err := time.ParseDuration(swag.StringValue(req.Duration))
+	if err != nil || duration <= 0 {
+		return nil, fmt.Errorf("%w: invalid duration %q", ErrInvalidAccessGrant, swag.StringValue(req.Duration))
+	}
+	if duration > maxDuration {
+		return nil, fmt.Errorf("%w: the duration can't be longer than %s", ErrInvalidAccessGrant, maxDuration)
+	}
+	if strings.TrimSpace(swag.StringValue(req.Reason)) == "" || strings.TrimSpace(swag.StringValue(req.Approver)) == "" {
+		return nil, fmt.Errorf("%w: the reason and the approver are required", ErrInvalidAccessGrant)
+	}
+	policy, entityType, entity := swag.StringValue(req.Policy), swag.StringValue(req.EntityType), swag.StringValue(req.Entity)
+	if _, err = client.getPolicy(ctx, policy); err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchPolicy" {
+			return nil, ErrPolicyNotFound
+		}
+		return nil, err
+	}
+
+	accessGrantsMu.Lock()
+	defer accessGrantsMu.Unlock()
+	grants, err := readAccessGrants(file)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := getEntityPolicies(ctx, client, entityType, entity)
+	if err != nil {
+		if code := madmin.ToErrorResponse(err).Code; code == "XMinioAdminNoSuchUser" || code == "XMinioAdminNoSuchGroup" {
+			return nil, fmt.Errorf("%w: %s %s does not exist", ErrInvalidAccessGrant, entityType, entity)
+		}
+		return nil, err
+	}
+	grant := &models.AccessGrant{
+		ID:         strings.Split(uuid.NewString(), "-")[0],
+		Policy:     policy,
+		EntityType: entityType,
+		Entity:     entity,
+		Reason:     swag.StringValue(req.Reason),
+		Approver:   swag.StringValue(req.Approver),
+		GrantedBy:  grantedBy,
+		GrantedAt:  now.UTC().Format(time.RFC3339),
+		ExpiresAt:  now.Add(duration).UTC().Format(time.RFC3339),
+		Status:     models.AccessGrantStatusActive,
+	}
+	if containsName(policies, policy) {
+		// attached already, either permanently or by another active grant which then decides when it's detached
+		grant.AlreadyAttached = !hasActiveAccessGrant(grants, grant)
+	} else if err = client.setPolicy(ctx, strings.Join(append(policies, policy), ","), entity, entityType == models.AccessGrantEntityTypeGroup); err != nil {
+		return nil, err
+	}
+	if err = writeAccessGrants(file, append(grants, grant)); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+// hasActiveAccessGrant returns whether another active grant attached the same policy to the same entity
+func hasActiveAccessGrant(grants []*models.AccessGrant, grant *models.AccessGrant) bool {
+	for _, g := range grants {
+		if g != grant && g.Status == models.AccessGrantStatusActive && !g.AlreadyAttached &&
+			g.Policy == grant.Policy && g.EntityType == grant.EntityType && g.Entity == grant.Entity {
+			return true
+		}
+	}
+	return false
+}
+
+// endAccessGrant detaches the policy of the grant, unless it was attached before the grant or another
+// active grant of the same policy keeps it attached
+func endAccessGrant(ctx context.Context, client MinioAdmin, grants []*models.AccessGrant, grant *models.AccessGrant, status, revokedBy string, now time.Time) error {
+	if !grant.AlreadyAttached && !hasActiveAccessGrant(grants, grant) {
+		policies, err := getEntityPolicies(ctx, client, grant.EntityType, grant.Entity)
+		if err != nil {
+			return err
+		}
+		if containsName(policies, grant.Policy) {
+			remaining := []string{}
+			for _, p := range policies {
+				if p != grant.Policy {
+					remaining = append(remaining, p)
+				}
+			}
+			if err = client.setPolicy(ctx, strings.Join(remaining, ","), grant.Entity, grant.EntityType == models.AccessGrantEntityTypeGroup); err != nil {
+				return err
+			}
+		}
+	}
+	grant.Status = status
+	grant.EndedAt = now.UTC().Format(time.RFC3339)
+	grant.RevokedBy = revokedBy
+	grant.Error = ""
+	return nil
+}
+
+// expireAccessGrants detaches the policies of the grants past their expiration, a grant failing to be
+// detached stays active with the error and is retried on the next run
+func expireAccessGrants(ctx context.Context, client MinioAdmin, file string, now time.Time) error {
+	accessGrantsMu.Lock()
+	defer accessGrantsMu.Unlock()
+	grants, err := readAccessGrants(file)
+	if err != nil {
+		return err
+	}
+	changed := false
+	for _, grant := range grants {
+		if grant.Status != models.AccessGrantStatusActive {
+			continue
+		}
+		expiresAt, err := time.Parse(time.RFC3339, grant.ExpiresAt)
+		if err != nil || expiresAt.After(now) {
+			continue
+		}
+		if err = endAccessGrant(ctx, client, grants, grant, models.AccessGrantStatusExpired, "", now); err != nil {
+			LogError("unable to detach policy %s of access grant %s: %v", grant.Policy, grant.ID, err)
+			grant.Error = err.Error()
+		}
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return writeAccessGrants(file, grants)
+}
+
+// revokeAccessGrant ends an active grant before it expires
+func revokeAccessGrant(ctx context.Context, client MinioAdmin, file, id, revokedBy string, now time.Time) (*models.AccessGrant, error) {
+	accessGrantsMu.Lock()
+	defer accessGrantsMu.Unlock()
+	grants, err := readAccessGrants(file)
+	if err != nil {
+		return nil, err
+	}
+	for _, grant := range grants {
+		if grant.ID != id {
+			continue
+		}
+		if grant.Status != models.AccessGrantStatusActive {
+			return nil, ErrAccessGrantEnded
+		}
+		if err = endAccessGrant(ctx, client, grants, grant, models.AccessGrantStatusRevoked, revokedBy, now); err != nil {
+			return nil, err
+		}
+		if err = writeAccessGrants(file, grants); err != nil {
+			return nil, err
+		}
+		return grant, nil
+	}
+	return nil, ErrAccessGrantNotFound
+}
+
+// listAccessGrants returns the grants with the status, all of them when empty, the latest first
+func listAccessGrants(file, status string) (*models.AccessGrantList, error) {
+	grants, err := readAccessGrants(file)
+	if err != nil {
+		return nil, err
+	}
+	result := []*models.AccessGrant{}
+	for _, grant := range grants {
+		if status == "" || grant.Status == status {
+			result = append(result, grant)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].GrantedAt > result[j].GrantedAt })
+	return &models.AccessGrantList{Grants: result, Total: int64(len(result))}, nil
+}
+
+func getListAccessGrantsResponse(session *models.Principal, params policyApi.ListAccessGrantsParams) (*models.AccessGrantList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateSessionAdminAction(ctx, session, iampolicy.ListUserPoliciesAdminAction, "Access grants not available."); err != nil {
+		return nil, err
+	}
+	grants, err := listAccessGrants(getAccessGrantsFile(), swag.StringValue(params.Status))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return grants, nil
+}
+
+func getCreateAccessGrantResponse(session *models.Principal, params policyApi.CreateAccessGrantParams) (*models.AccessGrant, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if !accessGrantsEnabled {
+		return nil, ErrorWithContext(ctx, ErrAccessGrantsDisabled)
+	}
+	if err := validateSessionAdminAction(ctx, session, iampolicy.AttachPolicyAdminAction, "Access grants not available."); err != nil {
+		return nil, err
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	grant, err := createAccessGrant(ctx, adminClient, getAccessGrantsFile(), params.Body, session.AccountAccessKey, time.Now(), getGrantsMaxDuration())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return grant, nil
+}
+
+func getRevokeAccessGrantResponse(session *models.Principal, params policyApi.RevokeAccessGrantParams) (*models.AccessGrant, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateSessionAdminAction(ctx, session, iampolicy.AttachPolicyAdminAction, "Access grants not available."); err != nil {
+		return nil, err
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	grant, err := revokeAccessGrant(ctx, adminClient, getAccessGrantsFile(), params.ID, session.AccountAccessKey, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return grant, nil
+}
@@ -0,0 +1,128 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessGrants(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "access-grants.json")
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	userPolicies := map[string]string{"alice": "readwrite", "bob": "consoleAdmin"}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if name == "missing" {
+			return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+		}
+		return &iampolicy.Policy{}, nil
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		p, ok := userPolicies[accessKey]
+		if !ok {
+			return madmin.UserInfo{}, madmin.ErrorResponse{Code: "XMinioAdminNoSuchUser"}
+		}
+		return madmin.UserInfo{PolicyName: p}, nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		userPolicies[entityName] = policyName
+		return nil
+	}
+	request := func(entity, duration string) *models.AccessGrantRequest {
+		return &models.AccessGrantRequest{
+			Policy:     swag.String("consoleAdmin"),
+			EntityType: swag.String(models.AccessGrantEntityTypeUser),
+			Entity:     swag.String(entity),
+			Duration:   swag.String(duration),
+			Reason:     swag.String("incident 42"),
+			Approver:   swag.String("carol"),
+		}
+	}
+
+	// Test-1: the policy is added to the ones of the user until the grant expires
+	grant, err := createAccessGrant(ctx, adminClientMock{}, file, request("alice", "2h"), "admin", now, 24*time.Hour)
+	if !assert.Nil(err) {
+		return
+	}
+	assert.Equal("2022-06-01T12:00:00Z", grant.ExpiresAt)
+	assert.Equal("carol", grant.Approver)
+	assert.False(grant.AlreadyAttached)
+	assert.Equal("readwrite,consoleAdmin", userPolicies["alice"])
+
+	// Test-2: a policy attached before the grant is kept when it ends
+	kept, err := createAccessGrant(ctx, adminClientMock{}, file, request("bob", "1h"), "admin", now, 24*time.Hour)
+	assert.Nil(err)
+	assert.True(kept.AlreadyAttached)
+
+	// Test-3: the scheduler detaches the expired grants only
+	assert.Nil(expireAccessGrants(ctx, adminClientMock{}, file, now.Add(90*time.Minute)))
+	assert.Equal("readwrite,consoleAdmin", userPolicies["alice"])
+	assert.Equal("consoleAdmin", userPolicies["bob"])
+	assert.Nil(expireAccessGrants(ctx, adminClientMock{}, file, now.Add(2*time.Hour)))
+	assert.Equal("readwrite", userPolicies["alice"])
+	active, err := listAccessGrants(file, models.AccessGrantStatusActive)
+	assert.Nil(err)
+	assert.Empty(active.Grants)
+	expired, err := listAccessGrants(file, models.AccessGrantStatusExpired)
+	assert.Nil(err)
+	assert.Equal(int64(2), expired.Total)
+
+	// Test-4: overlapping grants keep the policy attached until the last one ends
+	first, err := createAccessGrant(ctx, adminClientMock{}, file, request("alice", "1h"), "admin", now, 24*time.Hour)
+	assert.Nil(err)
+	second, err := createAccessGrant(ctx, adminClientMock{}, file, request("alice", "3h"), "admin", now, 24*time.Hour)
+	assert.Nil(err)
+	assert.False(second.AlreadyAttached)
+	revoked, err := revokeAccessGrant(ctx, adminClientMock{}, file, first.ID, "ops", now.Add(time.Minute))
+	if assert.Nil(err) {
+		assert.Equal(models.AccessGrantStatusRevoked, revoked.Status)
+		assert.Equal("ops", revoked.RevokedBy)
+	}
+	assert.Equal("readwrite,consoleAdmin", userPolicies["alice"])
+	_, err = revokeAccessGrant(ctx, adminClientMock{}, file, second.ID, "ops", now.Add(time.Minute))
+	assert.Nil(err)
+	assert.Equal("readwrite", userPolicies["alice"])
+	_, err = revokeAccessGrant(ctx, adminClientMock{}, file, second.ID, "ops", now.Add(time.Minute))
+	assert.Equal(ErrAccessGrantEnded, err)
+	_, err = revokeAccessGrant(ctx, adminClientMock{}, file, "unknown", "ops", now)
+	assert.Equal(ErrAccessGrantNotFound, err)
+
+	// Test-5: invalid requests
+	_, err = createAccessGrant(ctx, adminClientMock{}, file, request("alice", "48h"), "admin", now, 24*time.Hour)
+	assert.Equal("invalid access grant: the duration can't be longer than 24h0m0s", err.Error())
+	_, err = createAccessGrant(ctx, adminClientMock{}, file, request("dave", "1h"), "admin", now, 24*time.Hour)
+	assert.Equal("invalid access grant: user dave does not exist", err.Error())
+	missing := request("alice", "1h")
+	missing.Policy = swag.String("missing")
+	_, err = createAccessGrant(ctx, adminClientMock{}, file, missing, "admin", now, 24*time.Hour)
+	assert.Equal(ErrPolicyNotFound, err)
+	noApprover := request("alice", "1h")
+	noApprover.Approver = swag.String(" ")
+	_, err = createAccessGrant(ctx, adminClientMock{}, file, noApprover, "admin", now, 24*time.Hour)
+	assert.True(errors.Is(err, ErrInvalidAccessGrant))
+}
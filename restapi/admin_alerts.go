@@ -0,0 +1,620 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	systemApi "github.com/GuinsooLab/console/restapi/operations/system"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	alertRulesFile    = "rules.json"
+	alertChannelsFile = "channels.json"
+	alertHistoryFile  = "history.json"
+	// alertsDefaultLimit is the number of alerts listed when no limit is requested
+	alertsDefaultLimit = 100
+)
+
+// alertsMu serializes the updates of the alert files, shared by the API and the alert engine
+var alertsMu sync.Mutex
+
+func registerAlertsHandlers(api *operations.ConsoleAPI) {
+	// list alert rules
+	api.SystemListAlertRulesHandler = systemApi.ListAlertRulesHandlerFunc(func(params systemApi.ListAlertRulesParams, session *models.Principal) middleware.Responder {
+		resp, err := getListAlertRulesResponse(session, params)
+		if err != nil {
+			return systemApi.NewListAlertRulesDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewListAlertRulesOK().WithPayload(resp)
+	})
+	// create alert rule
+	api.SystemCreateAlertRuleHandler = systemApi.CreateAlertRuleHandlerFunc(func(params systemApi.CreateAlertRuleParams, session *models.Principal) middleware.Responder {
+		resp, err := getCreateAlertRuleResponse(session, params)
+		if err != nil {
+			return systemApi.NewCreateAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewCreateAlertRuleCreated().WithPayload(resp)
+	})
+	// update alert rule
+	api.SystemUpdateAlertRuleHandler = systemApi.UpdateAlertRuleHandlerFunc(func(params systemApi.UpdateAlertRuleParams, session *models.Principal) middleware.Responder {
+		resp, err := getUpdateAlertRuleResponse(session, params)
+		if err != nil {
+			return systemApi.NewUpdateAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewUpdateAlertRuleOK().WithPayload(resp)
+	})
+	// delete alert rule
+	api.SystemDeleteAlertRuleHandler = systemApi.DeleteAlertRuleHandlerFunc(func(params systemApi.DeleteAlertRuleParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteAlertRuleResponse(session, params); err != nil {
+			return systemApi.NewDeleteAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewDeleteAlertRuleNoContent()
+	})
+	// silence alert rule
+	api.SystemSilenceAlertRuleHandler = systemApi.SilenceAlertRuleHandlerFunc(func(params systemApi.SilenceAlertRuleParams, session *models.Principal) middleware.Responder {
+		resp, err := getSilenceAlertRuleResponse(session, params)
+		if err != nil {
+			return systemApi.NewSilenceAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewSilenceAlertRuleOK().WithPayload(resp)
+	})
+	// remove the silence of an alert rule
+	api.SystemUnsilenceAlertRuleHandler = systemApi.UnsilenceAlertRuleHandlerFunc(func(params systemApi.UnsilenceAlertRuleParams, session *models.Principal) middleware.Responder {
+		resp, err := getUnsilenceAlertRuleResponse(session, params)
+		if err != nil {
+			return systemApi.NewUnsilenceAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewUnsilenceAlertRuleOK().WithPayload(resp)
+	})
+	// list alert channels
+	api.SystemListAlertChannelsHandler = systemApi.ListAlertChannelsHandlerFunc(func(params systemApi.ListAlertChannelsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListAlertChannelsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListAlertChannelsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewListAlertChannelsOK().WithPayload(resp)
+	})
+	// set alert channels
+	api.SystemSetAlertChannelsHandler = systemApi.SetAlertChannelsHandlerFunc(func(params systemApi.SetAlertChannelsParams, session *models.Principal) middleware.Responder {
+		resp, err := getSetAlertChannelsResponse(session, params)
+		if err != nil {
+			return systemApi.NewSetAlertChannelsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewSetAlertChannelsOK().WithPayload(resp)
+	})
+	// send a test notification
+	api.SystemTestAlertChannelHandler = systemApi.TestAlertChannelHandlerFunc(func(params systemApi.TestAlertChannelParams, session *models.Principal) middleware.Responder {
+		if err := getTestAlertChannelResponse(session, params); err != nil {
+			return systemApi.NewTestAlertChannelDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewTestAlertChannelNoContent()
+	})
+	// list alerts history
+	api.SystemListAlertsHandler = systemApi.ListAlertsHandlerFunc(func(params systemApi.ListAlertsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListAlertsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListAlertsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewListAlertsOK().WithPayload(resp)
+	})
+	// acknowledge alert
+	api.SystemAcknowledgeAlertHandler = systemApi.AcknowledgeAlertHandlerFunc(func(params systemApi.AcknowledgeAlertParams, session *models.Principal) middleware.Responder {
+		resp, err := getAcknowledgeAlertResponse(session, params)
+		if err != nil {
+			return systemApi.NewAcknowledgeAlertDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewAcknowledgeAlertOK().WithPayload(resp)
+	})
+}
+
+// getAlertsDir returns the directory keeping the alert rules, channels and history
+func getAlertsDir() string {
+	return filepath.Join(getDataDir(), "alerts")
+}
+
+// readAlertsFile decodes one of the alert files into v, a missing file leaves v untouched
+func readAlertsFile(file string, v interface{}) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func writeAlertsFile(file string, v interface{}) error {
+	return writeDataFile(file, v)
+}
+
+func readAlertRules(dir string) ([]*models.AlertRule, error) {
+	rules := []*models.AlertRule{}
+	if err := readAlertsFile(filepath.Join(dir, alertRulesFile), &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func readAlertChannels(dir string) ([]*models.AlertChannel, error) {
+	channels := []*models.AlertChannel{}
+	if err := readAlertsFile(filepath.Join(dir, alertChannelsFile), &channels); err != nil {
+		return nil, err
+	}
+	return channels, nil
+}
+
+// readAlertHistory returns the alerts in the order they started
+func readAlertHistory(dir string) ([]*models.Alert, error) {
+	alerts := []*models.Alert{}
+	if err := readAlertsFile(filepath.Join(dir, alertHistoryFile), &alerts); err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+// validateAlertRule checks the rule can be evaluated and its channels exist
+func validateAlertRule(rule *models.AlertRule, channels []*models.AlertChannel) error {
+	if strings.TrimSpace(swag.StringValue(rule.Name)) == "" {
+		return errors.New("the name of the rule is required")
+	}
+	switch swag.StringValue(rule.Type) {
+	case models.AlertRuleTypeCapacityUsage:
+		if rule.Threshold <= 0 || rule.Threshold > 100 {
+			return errors.New("the threshold of capacity usage rules must be a percentage")
+		}
+	case models.AlertRuleTypePrometheus:
+		if strings.TrimSpace(rule.Query) == "" {
+			return errors.New("the query of prometheus rules is required")
+		}
+		if getPrometheusURL() == "" && globalMetricsCollector != nil {
+			if _, err := parseMetricsQuery(rule.Query); err != nil {
+				return fmt.Errorf("query not supported by the built-in metrics collector: %v", err)
+			}
+		}
+	}
+	if rule.Threshold < 0 {
+		return errors.New("the threshold can't be negative")
+	}
+	for _, name := range rule.Channels {
+		if findAlertChannel(channels, name) == nil {
+			return fmt.Errorf("%w: %s", ErrAlertChannelNotFound, name)
+		}
+	}
+	return nil
+}
+
+// validateAlertChannels checks the channels have unique names and the settings their type requires
+func validateAlertChannels(channels []*models.AlertChannel) error {
+	names := map[string]bool{}
+	for _, channel := range channels {
+		name := swag.StringValue(channel.Name)
+		if strings.TrimSpace(name) == "" {
+			return errors.New("the name of the channels is required")
+		}
+		if names[name] {
+			return fmt.Errorf("duplicated channel %s", name)
+		}
+		names[name] = true
+		switch swag.StringValue(channel.Type) {
+		case models.AlertChannelTypeWebhook, models.AlertChannelTypeSlack:
+			u, err := url.Parse(channel.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("channel %s requires an http or https url", name)
+			}
+		case models.AlertChannelTypeEmail:
+			if len(channel.Recipients) == 0 {
+				return fmt.Errorf("channel %s requires at least one recipient", name)
+			}
+			for _, recipient := range channel.Recipients {
+				if !strings.Contains(recipient, "@") {
+					return fmt.Errorf("invalid recipient %s on channel %s", recipient, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func findAlertChannel(channels []*models.AlertChannel, name string) *models.AlertChannel {
+	for _, channel := range channels {
+		if swag.StringValue(channel.Name) == name {
+			return channel
+		}
+	}
+	return nil
+}
+
+func findAlertRule(rules []*models.AlertRule, id string) (int, error) {
+	for i, rule := range rules {
+		if rule.ID == id {
+			return i, nil
+		}
+	}
+	return 0, ErrAlertRuleNotFound
+}
+
+func newAlertRule(rule *models.AlertRule, id string) *models.AlertRule {
+	severity := rule.Severity
+	if severity == "" {
+		severity = models.AlertRuleSeverityWarning
+	}
+	return &models.AlertRule{
+		ID:        id,
+		Name:      rule.Name,
+		Type:      rule.Type,
+		Threshold: rule.Threshold,
+		Query:     rule.Query,
+		Severity:  severity,
+		Channels:  rule.Channels,
+		Disabled:  rule.Disabled,
+	}
+}
+
+func createAlertRule(dir string, rule *models.AlertRule) (*models.AlertRule, error) {
+	alertsMu.Lock()
+	defer alertsMu.Unlock()
+	rules, err := readAlertRules(dir)
+	if err != nil {
+		return nil, err
+	}
+	created := newAlertRule(rule, strings.Split(uuid.NewString(), "-")[0])
+	rules = append(rules, created)
+	if err = writeAlertsFile(filepath.Join(dir, alertRulesFile), rules); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// updateAlertRule replaces the definition of a rule, its silence is kept
+func updateAlertRule(dir, id string, rule *models.AlertRule) (*models.AlertRule, error) {
+	alertsMu.Lock()
+	defer alertsMu.Unlock()
+	rules, err := readAlertRules(dir)
+	if err != nil {
+		return nil, err
+	}
+	i, err := findAlertRule(rules, id)
+	if err != nil {
+		return nil, err
+	}
+	updated := newAlertRule(rule, id)
+	updated.SilencedUntil = rules[i].SilencedUntil
+	updated.SilenceComment = rules[i].SilenceComment
+	rules[i] = updated
+	if err = writeAlertsFile(filepath.Join(dir, alertRulesFile), rules); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func deleteAlertRule(dir, id string) error {
+	alertsMu.Lock()
+	defer alertsMu.Unlock()
+	rules, err := readAlertRules(dir)
+	if err != nil {
+		return err
+	}
+	i, err := findAlertRule(rules, id)
+	if err != nil {
+		return err
+	}
+	return writeAlertsFile(filepath.Join(dir, alertRulesFile), append(rules[:i], rules[i+1:]...))
+}
+
+// silenceAlertRule stops the notifications of a rule until the given time, a zero time removes the silence.
+// The rule is still evaluated and its alerts recorded in the history
+func silenceAlertRule(dir, id string, until time.Time, comment string) (*models.AlertRule, error) {
+	alertsMu.Lock()
+	defer alertsMu.Unlock()
+	rules, err := readAlertRules(dir)
+	if err != nil {
+		return nil, err
+	}
+	i, err := findAlertRule(rules, id)
+	if err != nil {
+		return nil, err
+	}
+	rules[i].SilencedUntil = ""
+	rules[i].SilenceComment = ""
+	if !until.IsZero() {
+		rules[i].SilencedUntil = until.UTC().Format(time.RFC3339)
+		rules[i].SilenceComment = comment
+	}
+	if err = writeAlertsFile(filepath.Join(dir, alertRulesFile), rules); err != nil {
+		return nil, err
+	}
+	return rules[i], nil
+}
+
+// alertRuleSilenced returns whether the notifications of the rule are silenced at `now`
+func alertRuleSilenced(rule *models.AlertRule, now time.Time) bool {
+	if rule.SilencedUntil == "" {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, rule.SilencedUntil)
+	return err == nil && now.Before(until)
+}
+
+// setAlertChannels replaces the notification channels, channels used by a rule can't be removed
+func setAlertChannels(dir string, channels []*models.AlertChannel) error {
+	alertsMu.Lock()
+	defer alertsMu.Unlock()
+	rules, err := readAlertRules(dir)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		for _, name := range rule.Channels {
+			if findAlertChannel(channels, name) == nil {
+				return fmt.Errorf("channel %s is used by the rule %s", name, swag.StringValue(rule.Name))
+			}
+		}
+	}
+	return writeAlertsFile(filepath.Join(dir, alertChannelsFile), channels)
+}
+
+// listAlerts returns the most recent alerts first, optionally filtered by status, along with the
+// number of alerts matching the filter
+func listAlerts(dir, status string, limit int) ([]*models.Alert, int64, error) {
+	history, err := readAlertHistory(dir)
+	if err != nil {
+		return nil, 0, err
+	}
+	alerts := []*models.Alert{}
+	var total int64
+	for i := len(history) - 1; i >= 0; i-- {
+		if status != "" && history[i].Status != status {
+			continue
+		}
+		total++
+		if len(alerts) < limit {
+			alerts = append(alerts, history[i])
+		}
+	}
+	return alerts, total, nil
+}
+
+// acknowledgeAlert marks an alert as acknowledged, acknowledged alerts aren't notified again while firing
+func acknowledgeAlert(dir, id, by string, now time.Time) (*models.Alert, error) {
+	alertsMu.Lock()
+	defer alertsMu.Unlock()
+	history, err := readAlertHistory(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, alert := range history {
+		if alert.ID != id {
+			continue
+		}
+		if !alert.Acknowledged {
+			alert.Acknowledged = true
+			alert.AcknowledgedBy = by
+			alert.AcknowledgedAt = now.UTC().Format(time.RFC3339)
+			if err = writeAlertsFile(filepath.Join(dir, alertHistoryFile), history); err != nil {
+				return nil, err
+			}
+		}
+		return alert, nil
+	}
+	return nil, ErrAlertNotFound
+}
+
+func getListAlertRulesResponse(session *models.Principal, params systemApi.ListAlertRulesParams) (*models.AlertRuleList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	rules, err := readAlertRules(getAlertsDir())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp := &models.AlertRuleList{Rules: rules}
+	if interval := getAlertsInterval(); interval > 0 {
+		resp.Interval = interval.String()
+	}
+	return resp, nil
+}
+
+func getCreateAlertRuleResponse(session *models.Principal, params systemApi.CreateAlertRuleParams) (*models.AlertRule, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	channels, err := readAlertChannels(getAlertsDir())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err = validateAlertRule(params.Body, channels); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	rule, err := createAlertRule(getAlertsDir(), params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getUpdateAlertRuleResponse(session *models.Principal, params systemApi.UpdateAlertRuleParams) (*models.AlertRule, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	channels, err := readAlertChannels(getAlertsDir())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err = validateAlertRule(params.Body, channels); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	rule, err := updateAlertRule(getAlertsDir(), params.RuleID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getDeleteAlertRuleResponse(session *models.Principal, params systemApi.DeleteAlertRuleParams) *models.Error {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return err
+	}
+	if err := deleteAlertRule(getAlertsDir(), params.RuleID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getSilenceAlertRuleResponse(session *models.Principal, params systemApi.SilenceAlertRuleParams) (*models.AlertRule, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	duration, err := time.ParseDuration(*params.Body.Duration)
+	if err != nil || duration <= 0 {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("invalid silence duration %s", *params.Body.Duration))
+	}
+	rule, err := silenceAlertRule(getAlertsDir(), params.RuleID, time.Now().Add(duration), params.Body.Comment)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getUnsilenceAlertRuleResponse(session *models.Principal, params systemApi.UnsilenceAlertRuleParams) (*models.AlertRule, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	rule, err := silenceAlertRule(getAlertsDir(), params.RuleID, time.Time{}, "")
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rule, nil
+}
+
+func getListAlertChannelsResponse(session *models.Principal, params systemApi.ListAlertChannelsParams) (*models.AlertChannelList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	channels, err := readAlertChannels(getAlertsDir())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.AlertChannelList{Channels: channels}, nil
+}
+
+func getSetAlertChannelsResponse(session *models.Principal, params systemApi.SetAlertChannelsParams) (*models.AlertChannelList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	channels := params.Body.Channels
+	if channels == nil {
+		channels = []*models.AlertChannel{}
+	}
+	if err := validateAlertChannels(channels); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	if err := setAlertChannels(getAlertsDir(), channels); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	return &models.AlertChannelList{Channels: channels}, nil
+}
+
+func getTestAlertChannelResponse(session *models.Principal, params systemApi.TestAlertChannelParams) *models.Error {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsConfigAccess(ctx, session); err != nil {
+		return err
+	}
+	channels, err := readAlertChannels(getAlertsDir())
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	channel := findAlertChannel(channels, params.Name)
+	if channel == nil {
+		return ErrorWithContext(ctx, ErrAlertChannelNotFound)
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	alert := &models.Alert{
+		ID:        "test",
+		RuleName:  "Test notification",
+		Severity:  models.AlertRuleSeverityInfo,
+		Status:    models.AlertStatusFiring,
+		Summary:   fmt.Sprintf("Test notification sent by %s", session.AccountAccessKey),
+		StartedAt: now,
+	}
+	if err = newAlertNotifier().send(ctx, channel, alert); err != nil {
+		return ErrorWithContext(ctx, ErrBadRequest, fmt.Errorf("unable to notify the channel %s: %v", params.Name, err))
+	}
+	return nil
+}
+
+func getListAlertsResponse(session *models.Principal, params systemApi.ListAlertsParams) (*models.AlertList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	limit := alertsDefaultLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = int(*params.Limit)
+	}
+	alerts, total, err := listAlerts(getAlertsDir(), swag.StringValue(params.Status), limit)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.AlertList{Alerts: alerts, Total: total}, nil
+}
+
+func getAcknowledgeAlertResponse(session *models.Principal, params systemApi.AcknowledgeAlertParams) (*models.Alert, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateAlertsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	alert, err := acknowledgeAlert(getAlertsDir(), params.AlertID, session.AccountAccessKey, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return alert, nil
+}
+
+// validateAlertsAccess verifies the session is allowed to see the alerts
+func validateAlertsAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.ServerInfoAdminAction, "Alerts not available.")
+}
+
+// validateAlertsConfigAccess verifies the session is allowed to change the alert rules and channels
+func validateAlertsConfigAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.ConfigUpdateAdminAction, "Alerts configuration not available.")
+}
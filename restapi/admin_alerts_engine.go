@@ -0,0 +1,481 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/minio/madmin-go"
+)
+
+// alertSummaryItems is the number of servers or drives named on the summary of an alert
+const alertSummaryItems = 5
+
+// alertEngine evaluates the alert rules every `interval` and notifies their channels
+type alertEngine struct {
+	dir            string
+	interval       time.Duration
+	repeatInterval time.Duration
+	maxHistory     int
+	admin          MinioAdmin
+	notifier       *alertNotifier
+}
+
+// alertEvaluation is the result of evaluating a rule
+type alertEvaluation struct {
+	firing  bool
+	value   float64
+	summary string
+}
+
+// alertNotification is a notification to send once the history is updated
+type alertNotification struct {
+	alert    models.Alert
+	channels []string
+}
+
+// startAlertEngine starts the evaluation of the alert rules if configured, the rules are evaluated with
+// their own credentials since there is no user session to use
+func startAlertEngine(ctx context.Context) {
+	interval := getAlertsInterval()
+	if interval == 0 {
+		return
+	}
+	accessKey, secretKey := getAlertsCredentials()
+	if accessKey == "" || secretKey == "" {
+		LogError("alert engine disabled, %s and %s are required", ConsoleAlertsAccessKey, ConsoleAlertsSecretKey)
+		return
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		LogError("alert engine disabled: %v", err)
+		return
+	}
+	mAdmin.SetCustomTransport(GetConsoleHTTPClient().Transport)
+	engine := &alertEngine{
+		dir:            getAlertsDir(),
+		interval:       interval,
+		repeatInterval: getAlertsRepeatInterval(),
+		maxHistory:     getAlertsMaxHistory(),
+		admin:          AdminClient{Client: mAdmin},
+		notifier:       newAlertNotifier(),
+	}
+	go engine.run(ctx)
+}
+
+func (e *alertEngine) run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.evaluate(ctx, time.Now()); err != nil {
+				LogError("error evaluating alert rules: %v", err)
+			}
+		}
+	}
+}
+
+// evaluate evaluates the enabled rules and sends the notifications of the alerts that changed, a rule
+// failing to evaluate keeps its current alert
+func (e *alertEngine) evaluate(ctx context.Context, now time.Time) error {
+	rules, err := readAlertRules(e.dir)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, e.interval)
+	defer cancel()
+
+	var info *UsageInfo
+	var infoErr error
+	results := map[string]alertEvaluation{}
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+		var result alertEvaluation
+		if swag.StringValue(rule.Type) == models.AlertRuleTypePrometheus {
+			result, err = evaluatePrometheusAlertRule(ctx, rule, now)
+		} else {
+			if info == nil && infoErr == nil {
+				info, infoErr = GetAdminInfo(ctx, e.admin)
+			}
+			result, err = evaluateInfoAlertRule(rule, info), infoErr
+		}
+		if err != nil {
+			LogError("unable to evaluate the alert rule %s: %v", swag.StringValue(rule.Name), err)
+			continue
+		}
+		results[rule.ID] = result
+	}
+
+	notifications, err := e.update(rules, results, now)
+	if err != nil {
+		return err
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+	channels, err := readAlertChannels(e.dir)
+	if err != nil {
+		return err
+	}
+	for _, n := range notifications {
+		for _, name := range n.channels {
+			channel := findAlertChannel(channels, name)
+			if channel == nil {
+				continue
+			}
+			if err = e.notifier.send(ctx, channel, &n.alert); err != nil {
+				LogError("unable to notify the alert %s to the channel %s: %v", n.alert.RuleName, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// update records the evaluation results on the history and returns the notifications to send. Alerts
+// of deleted or disabled rules are resolved without notification
+func (e *alertEngine) update(rules []*models.AlertRule, results map[string]alertEvaluation, now time.Time) ([]alertNotification, error) {
+	alertsMu.Lock()
+	defer alertsMu.Unlock()
+	history, err := readAlertHistory(e.dir)
+	if err != nil {
+		return nil, err
+	}
+	active := map[string]*models.Alert{}
+	for _, alert := range history {
+		if alert.Status == models.AlertStatusFiring {
+			active[alert.RuleID] = alert
+		}
+	}
+	timestamp := now.UTC().Format(time.RFC3339)
+	var notifications []alertNotification
+	notify := func(rule *models.AlertRule, alert *models.Alert) {
+		if alertRuleSilenced(rule, now) || len(rule.Channels) == 0 {
+			return
+		}
+		if alert.Status == models.AlertStatusFiring {
+			alert.NotifiedAt = timestamp
+		}
+		notifications = append(notifications, alertNotification{alert: *alert, channels: rule.Channels})
+	}
+
+	evaluated := map[string]bool{}
+	for _, rule := range rules {
+		if !rule.Disabled {
+			evaluated[rule.ID] = true
+		}
+		result, ok := results[rule.ID]
+		if !ok {
+			continue
+		}
+		alert := active[rule.ID]
+		switch {
+		case result.firing && alert == nil:
+			alert = &models.Alert{
+				ID:        strings.Split(uuid.NewString(), "-")[0],
+				RuleID:    rule.ID,
+				RuleName:  swag.StringValue(rule.Name),
+				Severity:  rule.Severity,
+				Status:    models.AlertStatusFiring,
+				Summary:   result.summary,
+				Value:     result.value,
+				StartedAt: timestamp,
+			}
+			history = append(history, alert)
+			notify(rule, alert)
+		case result.firing:
+			alert.Summary = result.summary
+			alert.Value = result.value
+			if alert.Acknowledged {
+				continue
+			}
+			notified, err := time.Parse(time.RFC3339, alert.NotifiedAt)
+			if err != nil || now.Sub(notified) >= e.repeatInterval {
+				notify(rule, alert)
+			}
+		case alert != nil:
+			alert.Status = models.AlertStatusResolved
+			alert.Summary = result.summary
+			alert.Value = result.value
+			alert.ResolvedAt = timestamp
+			// only alerts notified while firing are notified when resolved
+			if alert.NotifiedAt != "" {
+				notify(rule, alert)
+			}
+		}
+	}
+	for ruleID, alert := range active {
+		if !evaluated[ruleID] {
+			alert.Status = models.AlertStatusResolved
+			alert.ResolvedAt = timestamp
+		}
+	}
+
+	// drop the oldest resolved alerts over the history limit
+	for excess := len(history) - e.maxHistory; excess > 0; excess-- {
+		i := 0
+		for i < len(history) && history[i].Status != models.AlertStatusResolved {
+			i++
+		}
+		if i == len(history) {
+			break
+		}
+		history = append(history[:i], history[i+1:]...)
+	}
+	if err = writeAlertsFile(filepath.Join(e.dir, alertHistoryFile), history); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// alertItems names the first servers or drives of an alert summary
+func alertItems(items []string) string {
+	if len(items) <= alertSummaryItems {
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(items[:alertSummaryItems], ", "), len(items)-alertSummaryItems)
+}
+
+// evaluateInfoAlertRule evaluates the rules over the admin info, the rules fire when the count of
+// servers or drives is over the threshold or, for capacity usage, when the used percentage reaches it
+func evaluateInfoAlertRule(rule *models.AlertRule, info *UsageInfo) alertEvaluation {
+	if info == nil {
+		return alertEvaluation{}
+	}
+	var items []string
+	var total int
+	var used, capacity int64
+	for _, server := range info.Servers {
+		switch swag.StringValue(rule.Type) {
+		case models.AlertRuleTypeOfflineServers:
+			total++
+			if server.State != "online" {
+				items = append(items, server.Endpoint)
+			}
+		default:
+			for _, drive := range server.Drives {
+				total++
+				endpoint := drive.Endpoint
+				if endpoint == "" {
+					endpoint = server.Endpoint + drive.DrivePath
+				}
+				switch swag.StringValue(rule.Type) {
+				case models.AlertRuleTypeOfflineDrives:
+					if drive.State != madmin.DriveStateOk {
+						items = append(items, endpoint)
+					}
+				case models.AlertRuleTypeHealingDrives:
+					if drive.Healing {
+						items = append(items, endpoint)
+					}
+				}
+				used += drive.UsedSpace
+				capacity += drive.TotalSpace
+			}
+		}
+	}
+
+	switch swag.StringValue(rule.Type) {
+	case models.AlertRuleTypeCapacityUsage:
+		var usage float64
+		if capacity > 0 {
+			usage = float64(used) * 100 / float64(capacity)
+		}
+		return alertEvaluation{
+			firing:  usage >= rule.Threshold,
+			value:   usage,
+			summary: fmt.Sprintf("%.1f%% of the capacity is used (%s of %s)", usage, humanizeAlertBytes(used), humanizeAlertBytes(capacity)),
+		}
+	case models.AlertRuleTypeOfflineServers:
+		result := alertEvaluation{firing: float64(len(items)) > rule.Threshold, value: float64(len(items))}
+		result.summary = fmt.Sprintf("%d of %d servers offline", len(items), total)
+		if len(items) > 0 {
+			result.summary += ": " + alertItems(items)
+		}
+		return result
+	}
+	state := "offline"
+	if swag.StringValue(rule.Type) == models.AlertRuleTypeHealingDrives {
+		state = "healing"
+	}
+	result := alertEvaluation{firing: float64(len(items)) > rule.Threshold, value: float64(len(items))}
+	result.summary = fmt.Sprintf("%d of %d drives %s", len(items), total, state)
+	if len(items) > 0 {
+		result.summary += ": " + alertItems(items)
+	}
+	return result
+}
+
+func humanizeAlertBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return strconv.FormatInt(b, 10) + " B"
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
+// evaluatePrometheusAlertRule runs the query of the rule on Prometheus, or on the built-in metrics collector
+// when there is no Prometheus, and fires when it returns any series
+func evaluatePrometheusAlertRule(ctx context.Context, rule *models.AlertRule, now time.Time) (alertEvaluation, error) {
+	var querier widgetQuerier
+	if prometheusURL := getPrometheusURL(); prometheusURL != "" {
+		querier = prometheusQuerier{url: prometheusURL}
+	} else if globalMetricsCollector != nil {
+		querier = globalMetricsCollector
+	} else {
+		return alertEvaluation{}, errors.New("prometheus is not configured")
+	}
+	resp, err := querier.queryRange(ctx, rule.Query, now.Unix(), now.Unix(), 60)
+	if err != nil {
+		return alertEvaluation{}, err
+	}
+	var result alertEvaluation
+	var series int
+	for _, r := range resp.Data.Result {
+		if len(r.Values) == 0 {
+			continue
+		}
+		sample, ok := r.Values[len(r.Values)-1].([]interface{})
+		if !ok || len(sample) != 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(fmt.Sprint(sample[1]), 64)
+		if err != nil {
+			continue
+		}
+		if series == 0 || value > result.value {
+			result.value = value
+		}
+		series++
+	}
+	result.firing = series > 0
+	result.summary = fmt.Sprintf("the query returned %d series", series)
+	if series > 0 {
+		result.summary += fmt.Sprintf(", highest value %s", strconv.FormatFloat(result.value, 'g', -1, 64))
+	}
+	return result, nil
+}
+
+// alertSMTPConfig is the SMTP server used to send the email notifications
+type alertSMTPConfig struct {
+	server   string
+	username string
+	password string
+	from     string
+}
+
+// alertNotifier sends the notifications to the alert channels
+type alertNotifier struct {
+	client   *http.Client
+	smtp     alertSMTPConfig
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func newAlertNotifier() *alertNotifier {
+	return &alertNotifier{
+		client:   GetConsoleHTTPClient(),
+		smtp:     getAlertsSMTPConfig(),
+		sendMail: smtp.SendMail,
+	}
+}
+
+// alertMessage returns the one line description of an alert used on the notifications
+func alertMessage(alert *models.Alert) string {
+	return fmt.Sprintf("[%s] %s - %s: %s", strings.ToUpper(alert.Status), strings.ToUpper(alert.Severity), alert.RuleName, alert.Summary)
+}
+
+func (n *alertNotifier) send(ctx context.Context, channel *models.AlertChannel, alert *models.Alert) error {
+	switch swag.StringValue(channel.Type) {
+	case models.AlertChannelTypeWebhook:
+		return n.post(ctx, channel.URL, map[string]interface{}{"status": alert.Status, "alert": alert})
+	case models.AlertChannelTypeSlack:
+		return n.post(ctx, channel.URL, map[string]string{"text": alertMessage(alert)})
+	case models.AlertChannelTypeEmail:
+		return n.email(channel.Recipients, alert)
+	}
+	return fmt.Errorf("unsupported channel type %s", swag.StringValue(channel.Type))
+}
+
+func (n *alertNotifier) post(ctx context.Context, endpoint string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected response (%s)", resp.Status)
+	}
+	return nil
+}
+
+func (n *alertNotifier) email(recipients []string, alert *models.Alert) error {
+	if n.smtp.server == "" || n.smtp.from == "" {
+		return fmt.Errorf("email notifications require %s and %s", ConsoleAlertsSMTPServer, ConsoleAlertsSMTPFrom)
+	}
+	var auth smtp.Auth
+	if n.smtp.username != "" {
+		host, _, err := net.SplitHostPort(n.smtp.server)
+		if err != nil {
+			return err
+		}
+		auth = smtp.PlainAuth("", n.smtp.username, n.smtp.password, host)
+	}
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.smtp.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(recipients, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", alertMessage(alert))
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&msg, "Rule: %s\r\nSeverity: %s\r\nStatus: %s\r\nSummary: %s\r\nStarted: %s\r\n", alert.RuleName, alert.Severity, alert.Status, alert.Summary, alert.StartedAt)
+	if alert.ResolvedAt != "" {
+		fmt.Fprintf(&msg, "Resolved: %s\r\n", alert.ResolvedAt)
+	}
+	return n.sendMail(n.smtp.server, auth, n.smtp.from, recipients, []byte(msg.String()))
+}
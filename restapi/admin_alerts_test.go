@@ -0,0 +1,260 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestAlertRule(name, ruleType string, threshold float64, channels ...string) *models.AlertRule {
+	return &models.AlertRule{
+		Name:      swag.String(name),
+		Type:      swag.String(ruleType),
+		Threshold: threshold,
+		Severity:  models.AlertRuleSeverityCritical,
+		Channels:  channels,
+	}
+}
+
+func TestAlertRules(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	// Test-1: channels are validated and rules must use existing channels
+	channels := []*models.AlertChannel{
+		{Name: swag.String("ops"), Type: swag.String(models.AlertChannelTypeSlack), URL: "https://hooks.example.com/T0/B0"},
+		{Name: swag.String("oncall"), Type: swag.String(models.AlertChannelTypeEmail), Recipients: []string{"oncall@example.com"}},
+	}
+	assert.Nil(validateAlertChannels(channels))
+	assert.Error(validateAlertChannels(append(channels, &models.AlertChannel{Name: swag.String("ops"), Type: swag.String(models.AlertChannelTypeWebhook), URL: "http://localhost"})))
+	assert.Error(validateAlertChannels([]*models.AlertChannel{{Name: swag.String("hook"), Type: swag.String(models.AlertChannelTypeWebhook), URL: "ftp://localhost"}}))
+	assert.Error(validateAlertChannels([]*models.AlertChannel{{Name: swag.String("mail"), Type: swag.String(models.AlertChannelTypeEmail)}}))
+	assert.Nil(setAlertChannels(dir, channels))
+
+	stored, err := readAlertChannels(dir)
+	assert.Nil(err)
+	assert.Nil(validateAlertRule(newTestAlertRule("Drives", models.AlertRuleTypeOfflineDrives, 0, "ops"), stored))
+	err = validateAlertRule(newTestAlertRule("Drives", models.AlertRuleTypeOfflineDrives, 0, "missing"), stored)
+	assert.True(errors.Is(err, ErrAlertChannelNotFound))
+	assert.Error(validateAlertRule(newTestAlertRule("Capacity", models.AlertRuleTypeCapacityUsage, 120), stored))
+	assert.Error(validateAlertRule(newTestAlertRule("Query", models.AlertRuleTypePrometheus, 0), stored))
+
+	// Test-2: create, update and silence
+	rule, err := createAlertRule(dir, newTestAlertRule("Drives", models.AlertRuleTypeOfflineDrives, 0, "ops"))
+	assert.Nil(err)
+	assert.NotEmpty(rule.ID)
+	now := time.Now()
+	silenced, err := silenceAlertRule(dir, rule.ID, now.Add(time.Hour), "maintenance")
+	assert.Nil(err)
+	assert.True(alertRuleSilenced(silenced, now))
+	assert.False(alertRuleSilenced(silenced, now.Add(2*time.Hour)))
+
+	update := newTestAlertRule("Offline drives", models.AlertRuleTypeOfflineDrives, 1, "ops")
+	update.Severity = ""
+	updated, err := updateAlertRule(dir, rule.ID, update)
+	assert.Nil(err)
+	assert.Equal("Offline drives", *updated.Name)
+	assert.Equal(models.AlertRuleSeverityWarning, updated.Severity)
+	assert.Equal("maintenance", updated.SilenceComment)
+	unsilenced, err := silenceAlertRule(dir, rule.ID, time.Time{}, "")
+	assert.Nil(err)
+	assert.False(alertRuleSilenced(unsilenced, now))
+	_, err = updateAlertRule(dir, "missing", update)
+	assert.True(errors.Is(err, ErrAlertRuleNotFound))
+
+	// Test-3: channels used by a rule can't be removed
+	assert.Error(setAlertChannels(dir, channels[1:]))
+	assert.Nil(deleteAlertRule(dir, rule.ID))
+	assert.True(errors.Is(deleteAlertRule(dir, rule.ID), ErrAlertRuleNotFound))
+	assert.Nil(setAlertChannels(dir, channels[1:]))
+}
+
+func TestAlertEngine(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	var webhooks []map[string]interface{}
+	var slack []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		if r.URL.Path == "/slack" {
+			slack = append(slack, payload["text"].(string))
+			return
+		}
+		webhooks = append(webhooks, payload)
+	}))
+	defer server.Close()
+	var mails []string
+	notifier := &alertNotifier{
+		client: server.Client(),
+		smtp:   alertSMTPConfig{server: "smtp.example.com:587", username: "console", password: "secret", from: "console@example.com"},
+		sendMail: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+			assert.Equal("smtp.example.com:587", addr)
+			assert.Equal([]string{"oncall@example.com"}, to)
+			mails = append(mails, string(msg))
+			return nil
+		},
+	}
+	assert.Nil(setAlertChannels(dir, []*models.AlertChannel{
+		{Name: swag.String("hook"), Type: swag.String(models.AlertChannelTypeWebhook), URL: server.URL + "/hook"},
+		{Name: swag.String("ops"), Type: swag.String(models.AlertChannelTypeSlack), URL: server.URL + "/slack"},
+		{Name: swag.String("oncall"), Type: swag.String(models.AlertChannelTypeEmail), Recipients: []string{"oncall@example.com"}},
+	}))
+	drives, err := createAlertRule(dir, newTestAlertRule("Offline drives", models.AlertRuleTypeOfflineDrives, 0, "hook", "ops", "oncall"))
+	assert.Nil(err)
+	_, err = createAlertRule(dir, newTestAlertRule("Offline servers", models.AlertRuleTypeOfflineServers, 0, "hook"))
+	assert.Nil(err)
+	capacity, err := createAlertRule(dir, newTestAlertRule("Capacity", models.AlertRuleTypeCapacityUsage, 80, "hook"))
+	assert.Nil(err)
+	_, err = silenceAlertRule(dir, capacity.ID, time.Now().Add(24*time.Hour), "")
+	assert.Nil(err)
+
+	driveState := madmin.DriveStateOffline
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{{
+			State:    "online",
+			Endpoint: "node1:9000",
+			Disks: []madmin.Disk{
+				{Endpoint: "http://node1:9000/data1", State: madmin.DriveStateOk, TotalSpace: 100, UsedSpace: 90},
+				{Endpoint: "http://node1:9000/data2", State: driveState, TotalSpace: 100, UsedSpace: 80},
+			},
+		}}}, nil
+	}
+	engine := &alertEngine{dir: dir, interval: time.Minute, repeatInterval: time.Hour, maxHistory: 10, admin: adminClientMock{}, notifier: notifier}
+
+	// Test-1: the offline drive fires and is notified to every channel, the silenced capacity alert is only recorded
+	now := time.Now()
+	assert.Nil(engine.evaluate(ctx, now))
+	alerts, total, err := listAlerts(dir, models.AlertStatusFiring, 10)
+	assert.Nil(err)
+	assert.Equal(int64(2), total)
+	var driveAlert *models.Alert
+	for _, alert := range alerts {
+		switch alert.RuleID {
+		case drives.ID:
+			driveAlert = alert
+		case capacity.ID:
+			assert.Equal(float64(85), alert.Value)
+			assert.Empty(alert.NotifiedAt)
+		default:
+			t.Errorf("unexpected alert %s", alert.RuleName)
+		}
+	}
+	if assert.NotNil(driveAlert) {
+		assert.Equal("1 of 2 drives offline: http://node1:9000/data2", driveAlert.Summary)
+		assert.Equal(float64(1), driveAlert.Value)
+	}
+	if assert.Len(webhooks, 1) {
+		assert.Equal(models.AlertStatusFiring, webhooks[0]["status"])
+	}
+	assert.Equal([]string{"[FIRING] CRITICAL - Offline drives: 1 of 2 drives offline: http://node1:9000/data2"}, slack)
+	if assert.Len(mails, 1) {
+		assert.Contains(mails[0], "Subject: [FIRING] CRITICAL - Offline drives")
+	}
+
+	// Test-2: firing alerts are notified again after the repeat interval unless acknowledged
+	assert.Nil(engine.evaluate(ctx, now.Add(time.Minute)))
+	assert.Len(webhooks, 1)
+	assert.Nil(engine.evaluate(ctx, now.Add(time.Hour)))
+	assert.Len(webhooks, 2)
+	acknowledged, err := acknowledgeAlert(dir, driveAlert.ID, "admin", now)
+	assert.Nil(err)
+	assert.Equal("admin", acknowledged.AcknowledgedBy)
+	assert.Nil(engine.evaluate(ctx, now.Add(3*time.Hour)))
+	assert.Len(webhooks, 2)
+	_, err = acknowledgeAlert(dir, "missing", "admin", now)
+	assert.True(errors.Is(err, ErrAlertNotFound))
+
+	// Test-3: resolved alerts are notified, deleted rules resolve their alerts
+	driveState = madmin.DriveStateOk
+	assert.Nil(deleteAlertRule(dir, capacity.ID))
+	assert.Nil(engine.evaluate(ctx, now.Add(4*time.Hour)))
+	if assert.Len(webhooks, 3) {
+		assert.Equal(models.AlertStatusResolved, webhooks[2]["status"])
+	}
+	_, total, err = listAlerts(dir, models.AlertStatusFiring, 10)
+	assert.Nil(err)
+	assert.Equal(int64(0), total)
+	alerts, total, err = listAlerts(dir, "", 1)
+	assert.Nil(err)
+	assert.Equal(int64(2), total)
+	assert.Len(alerts, 1)
+
+	// Test-4: the history keeps the most recent alerts
+	engine.maxHistory = 1
+	assert.Nil(engine.evaluate(ctx, now.Add(5*time.Hour)))
+	alerts, _, err = listAlerts(dir, "", 10)
+	assert.Nil(err)
+	if assert.Len(alerts, 1) {
+		assert.Equal(capacity.ID, alerts[0].RuleID)
+		assert.NotEmpty(alerts[0].ResolvedAt)
+	}
+
+	// Test-5: failed evaluations keep the alerts
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("unreachable")
+	}
+	assert.Nil(engine.evaluate(ctx, now.Add(6*time.Hour)))
+	alerts, _, err = listAlerts(dir, "", 10)
+	assert.Nil(err)
+	assert.Len(alerts, 1)
+}
+
+func TestPrometheusAlertRule(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(PrometheusURL, "")
+	ctx := context.Background()
+
+	_, err := evaluatePrometheusAlertRule(ctx, &models.AlertRule{Query: "up"}, time.Now())
+	assert.Error(err)
+
+	store := newMetricsStore(time.Hour, 30*time.Second)
+	now := time.Now().Add(-time.Minute)
+	scraped, err := parseMetricsText(strings.NewReader(fmt.Sprintf(testClusterMetrics, 1655990000, 1655995000, 100, 60, 10)))
+	assert.Nil(err)
+	store.add(now, scraped)
+	globalMetricsCollector = &metricsCollector{store: store}
+	defer func() {
+		globalMetricsCollector = nil
+	}()
+
+	result, err := evaluatePrometheusAlertRule(ctx, &models.AlertRule{Query: `minio_s3_requests_total{api="getobject"}`}, time.Now())
+	assert.Nil(err)
+	assert.True(result.firing)
+	assert.Equal(float64(100), result.value)
+	assert.Equal("the query returned 2 series, highest value 100", result.summary)
+
+	result, err = evaluatePrometheusAlertRule(ctx, &models.AlertRule{Query: `minio_s3_requests_total{api="deleteobject"}`}, time.Now())
+	assert.Nil(err)
+	assert.False(result.firing)
+}
@@ -0,0 +1,322 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/minio/madmin-go"
+)
+
+const (
+	// apiStatsInterval is how often aggregates are sent to the client
+	apiStatsInterval = time.Second
+	// apiStatsDefaultWindow is the default rolling window the aggregates are computed over
+	apiStatsDefaultWindow = 10 * time.Second
+	// apiStatsMaxWindow limits the number of per second aggregates kept in memory per connection
+	apiStatsMaxWindow = 5 * time.Minute
+)
+
+// apiStatsRequest options for the live API statistics, trace filters apply to the
+// calls being aggregated
+type apiStatsRequest struct {
+	trace  TraceRequest
+	window time.Duration
+}
+
+// apiStatsMsg rolling aggregates sent every apiStatsInterval
+type apiStatsMsg struct {
+	Time    string          `json:"time"`
+	Window  string          `json:"window"`
+	APIs    []apiStatsEntry `json:"apis"`
+	Buckets []apiStatsEntry `json:"buckets"`
+	Nodes   []apiStatsEntry `json:"nodes"`
+}
+
+// apiStatsEntry aggregates of a single API, bucket or node, Rx and Tx
+// come from the callStats of every call
+type apiStatsEntry struct {
+	Name   string `json:"name"`
+	Count  int64  `json:"count"`
+	Errors int64  `json:"errors"`
+	Rx     int64  `json:"rx"`
+	Tx     int64  `json:"tx"`
+	P50    string `json:"p50"`
+	P99    string `json:"p99"`
+}
+
+// apiStatsLatencyBounds are the upper bounds of the latency histogram buckets, percentiles are
+// reported as the bound of the bucket they fall in
+var apiStatsLatencyBounds = [...]time.Duration{
+	100 * time.Microsecond, 200 * time.Microsecond, 500 * time.Microsecond,
+	time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond,
+	10 * time.Millisecond, 20 * time.Millisecond, 50 * time.Millisecond,
+	100 * time.Millisecond, 200 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2 * time.Second, 5 * time.Second,
+	10 * time.Second, 20 * time.Second, 60 * time.Second,
+}
+
+// apiStatsSample is what is aggregated of every trace entry
+type apiStatsSample struct {
+	api      string
+	bucket   string
+	node     string
+	rx       int64
+	tx       int64
+	duration time.Duration
+	err      bool
+}
+
+// apiStatsCounter aggregates the calls of a single API, bucket or node, latencies are kept as
+// a histogram so the memory used doesn't depend on the request rate
+type apiStatsCounter struct {
+	count   int64
+	errors  int64
+	rx      int64
+	tx      int64
+	latency [len(apiStatsLatencyBounds) + 1]int64
+	// max latency, reported for the calls slower than the last bound
+	max time.Duration
+}
+
+func (c *apiStatsCounter) add(s apiStatsSample) {
+	c.count++
+	c.rx += s.rx
+	c.tx += s.tx
+	if s.err {
+		c.errors++
+	}
+	c.latency[sort.Search(len(apiStatsLatencyBounds), func(i int) bool {
+		return s.duration <= apiStatsLatencyBounds[i]
+	})]++
+	if s.duration > c.max {
+		c.max = s.duration
+	}
+}
+
+func (c *apiStatsCounter) merge(o *apiStatsCounter) {
+	c.count += o.count
+	c.errors += o.errors
+	c.rx += o.rx
+	c.tx += o.tx
+	for i, n := range o.latency {
+		c.latency[i] += n
+	}
+	if o.max > c.max {
+		c.max = o.max
+	}
+}
+
+// percentile returns the nearest-rank percentile of the latencies
+func (c *apiStatsCounter) percentile(percentile int) time.Duration {
+	if c.count == 0 {
+		return 0
+	}
+	rank := (int64(percentile)*c.count + 99) / 100
+	var seen int64
+	for i, n := range c.latency {
+		seen += n
+		if seen >= rank && i < len(apiStatsLatencyBounds) {
+			return apiStatsLatencyBounds[i]
+		}
+	}
+	return c.max
+}
+
+// apiStatsSecond aggregates the calls received during one second
+type apiStatsSecond struct {
+	time    time.Time
+	apis    map[string]*apiStatsCounter
+	buckets map[string]*apiStatsCounter
+	nodes   map[string]*apiStatsCounter
+}
+
+// apiStatsAggregator keeps per second aggregates of the rolling window, at most
+// window/apiStatsInterval of them are kept
+type apiStatsAggregator struct {
+	window  time.Duration
+	seconds []*apiStatsSecond
+}
+
+func newAPIStatsAggregator(window time.Duration) *apiStatsAggregator {
+	return &apiStatsAggregator{window: window}
+}
+
+// add records a trace entry received at `now`
+func (a *apiStatsAggregator) add(now time.Time, info madmin.ServiceTraceInfo) {
+	short := shortTrace(&info)
+	sample := apiStatsSample{
+		api:      short.FuncName,
+		bucket:   traceBucket(info.Trace),
+		node:     info.Trace.NodeName,
+		rx:       int64(short.CallStats.Rx),
+		tx:       int64(short.CallStats.Tx),
+		duration: info.Trace.Duration,
+		err:      short.StatusCode >= http.StatusBadRequest,
+	}
+	t := now.Truncate(time.Second)
+	if len(a.seconds) == 0 || !a.seconds[len(a.seconds)-1].time.Equal(t) {
+		a.seconds = append(a.seconds, &apiStatsSecond{
+			time:    t,
+			apis:    map[string]*apiStatsCounter{},
+			buckets: map[string]*apiStatsCounter{},
+			nodes:   map[string]*apiStatsCounter{},
+		})
+	}
+	second := a.seconds[len(a.seconds)-1]
+	addAPIStatsSample(second.apis, sample.api, sample)
+	addAPIStatsSample(second.buckets, sample.bucket, sample)
+	addAPIStatsSample(second.nodes, sample.node, sample)
+}
+
+// addAPIStatsSample adds the sample to the counter of key, samples without a key are ignored
+func addAPIStatsSample(counters map[string]*apiStatsCounter, key string, s apiStatsSample) {
+	if key == "" {
+		return
+	}
+	counter, ok := counters[key]
+	if !ok {
+		counter = &apiStatsCounter{}
+		counters[key] = counter
+	}
+	counter.add(s)
+}
+
+// snapshot drops the seconds that left the window and aggregates the rest
+func (a *apiStatsAggregator) snapshot(now time.Time) apiStatsMsg {
+	cutoff := now.Add(-a.window)
+	first := sort.Search(len(a.seconds), func(i int) bool {
+		return a.seconds[i].time.Add(time.Second).After(cutoff)
+	})
+	a.seconds = append(a.seconds[:0], a.seconds[first:]...)
+
+	return apiStatsMsg{
+		Time:    now.UTC().Format(time.RFC3339),
+		Window:  a.window.String(),
+		APIs:    aggregateAPIStats(a.seconds, func(s *apiStatsSecond) map[string]*apiStatsCounter { return s.apis }),
+		Buckets: aggregateAPIStats(a.seconds, func(s *apiStatsSecond) map[string]*apiStatsCounter { return s.buckets }),
+		Nodes:   aggregateAPIStats(a.seconds, func(s *apiStatsSecond) map[string]*apiStatsCounter { return s.nodes }),
+	}
+}
+
+// aggregateAPIStats merges the per second counters, entries are sorted by request count
+func aggregateAPIStats(seconds []*apiStatsSecond, counters func(s *apiStatsSecond) map[string]*apiStatsCounter) []apiStatsEntry {
+	merged := map[string]*apiStatsCounter{}
+	for _, second := range seconds {
+		for name, counter := range counters(second) {
+			m, ok := merged[name]
+			if !ok {
+				m = &apiStatsCounter{}
+				merged[name] = m
+			}
+			m.merge(counter)
+		}
+	}
+
+	result := []apiStatsEntry{}
+	for name, counter := range merged {
+		result = append(result, apiStatsEntry{
+			Name:   name,
+			Count:  counter.count,
+			Errors: counter.errors,
+			Rx:     counter.rx,
+			Tx:     counter.tx,
+			P50:    counter.percentile(50).String(),
+			P99:    counter.percentile(99).String(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// getAPIStatsOptionsFromReq parses the trace options plus the `window` (in seconds)
+// the aggregates are computed over
+func getAPIStatsOptionsFromReq(req *http.Request) (*apiStatsRequest, error) {
+	traceOpts, err := getTraceOptionsFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+	// aggregates are never recorded, a trace recording can be summarized instead
+	traceOpts.record = false
+	// s3 calls unless specified otherwise
+	if !traceOpts.s3 && !traceOpts.internal && !traceOpts.storage && !traceOpts.os {
+		traceOpts.s3 = true
+	}
+
+	opts := &apiStatsRequest{trace: traceOpts, window: apiStatsDefaultWindow}
+	if w := req.URL.Query().Get("window"); w != "" {
+		seconds, err := strconv.Atoi(w)
+		if err != nil || seconds <= 0 {
+			return nil, fmt.Errorf("invalid window: %s", w)
+		}
+		opts.window = time.Duration(seconds) * time.Second
+		if opts.window > apiStatsMaxWindow {
+			opts.window = apiStatsMaxWindow
+		}
+	}
+	return opts, nil
+}
+
+// startAPIStats consumes the trace stream and sends the rolling aggregates every apiStatsInterval
+func startAPIStats(ctx context.Context, conn WSConn, client MinioAdmin, opts *apiStatsRequest) error {
+	traceOpts := opts.trace
+	traceCh := client.serviceTrace(ctx, traceOpts.threshold, traceOpts.s3, traceOpts.internal, traceOpts.storage, traceOpts.os, traceOpts.onlyErrors)
+	aggregator := newAPIStatsAggregator(opts.window)
+	ticker := time.NewTicker(apiStatsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case traceInfo, ok := <-traceCh:
+			// zero value returned because the channel is closed and empty
+			if !ok {
+				return nil
+			}
+			if traceInfo.Err != nil {
+				LogError("error on serviceTrace: %v", traceInfo.Err)
+				return traceInfo.Err
+			}
+			if matchTrace(traceOpts, traceInfo) {
+				aggregator.add(time.Now(), traceInfo)
+			}
+		case now := <-ticker.C:
+			statsBytes, err := json.Marshal(aggregator.snapshot(now))
+			if err != nil {
+				LogError("error on json.Marshal: %v", err)
+				return err
+			}
+			// Send Message through websocket connection
+			if err = conn.writeMessage(websocket.TextMessage, statsBytes); err != nil {
+				LogError("error writeMessage: %v", err)
+				return err
+			}
+		}
+	}
+}
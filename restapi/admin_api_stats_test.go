@@ -0,0 +1,171 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAPIStatsTrace(funcName, path, node string, statusCode, rx, tx int, duration time.Duration) madmin.ServiceTraceInfo {
+	return madmin.ServiceTraceInfo{Trace: madmin.TraceInfo{
+		NodeName: node,
+		FuncName: funcName,
+		Path:     path,
+		Duration: duration,
+		HTTP: &madmin.TraceHTTPStats{
+			ReqInfo:   madmin.TraceRequestInfo{Method: http.MethodGet},
+			RespInfo:  madmin.TraceResponseInfo{StatusCode: statusCode},
+			CallStats: madmin.TraceCallStats{InputBytes: rx, OutputBytes: tx},
+		},
+	}}
+}
+
+func TestAPIStatsAggregator(t *testing.T) {
+	assert := assert.New(t)
+	start := time.Date(2022, 1, 2, 15, 4, 5, 0, time.UTC)
+	aggregator := newAPIStatsAggregator(10 * time.Second)
+
+	aggregator.add(start, newAPIStatsTrace("s3.GetObject", "/photos/a.jpg", "node1", 200, 0, 100, 10*time.Millisecond))
+	aggregator.add(start.Add(5*time.Second), newAPIStatsTrace("s3.GetObject", "/photos/b.jpg", "node2", 404, 0, 10, 30*time.Millisecond))
+	aggregator.add(start.Add(6*time.Second), newAPIStatsTrace("s3.PutObject", "/docs/c.pdf", "node1", 200, 500, 0, 20*time.Millisecond))
+	aggregator.add(start.Add(7*time.Second), newAPIStatsTrace("s3.ListBuckets", "/", "node1", 200, 0, 50, time.Millisecond))
+
+	// Test-1: all samples are inside the window
+	msg := aggregator.snapshot(start.Add(8 * time.Second))
+	assert.Equal("10s", msg.Window)
+	assert.Equal([]apiStatsEntry{
+		{Name: "s3.GetObject", Count: 2, Errors: 1, Tx: 110, P50: "10ms", P99: "50ms"},
+		{Name: "s3.ListBuckets", Count: 1, Tx: 50, P50: "1ms", P99: "1ms"},
+		{Name: "s3.PutObject", Count: 1, Rx: 500, P50: "20ms", P99: "20ms"},
+	}, msg.APIs)
+	// requests without a bucket are not part of the bucket aggregates
+	assert.Equal([]apiStatsEntry{
+		{Name: "photos", Count: 2, Errors: 1, Tx: 110, P50: "10ms", P99: "50ms"},
+		{Name: "docs", Count: 1, Rx: 500, P50: "20ms", P99: "20ms"},
+	}, msg.Buckets)
+	assert.Equal([]apiStatsEntry{
+		{Name: "node1", Count: 3, Rx: 500, Tx: 150, P50: "10ms", P99: "20ms"},
+		{Name: "node2", Count: 1, Errors: 1, Tx: 10, P50: "50ms", P99: "50ms"},
+	}, msg.Nodes)
+
+	// Test-2: the first second leaves the window
+	msg = aggregator.snapshot(start.Add(12 * time.Second))
+	assert.Equal([]apiStatsEntry{
+		{Name: "node1", Count: 2, Rx: 500, Tx: 50, P50: "1ms", P99: "20ms"},
+		{Name: "node2", Count: 1, Errors: 1, Tx: 10, P50: "50ms", P99: "50ms"},
+	}, msg.Nodes)
+	assert.Len(aggregator.seconds, 3)
+
+	// Test-3: empty window
+	msg = aggregator.snapshot(start.Add(time.Minute))
+	assert.Empty(msg.APIs)
+	assert.Empty(msg.Buckets)
+	assert.Empty(msg.Nodes)
+	assert.Len(aggregator.seconds, 0)
+
+	// Test-4: calls of the same second share their aggregates and latencies are bucketed
+	for i := 0; i < 1000; i++ {
+		aggregator.add(start.Add(2*time.Minute), newAPIStatsTrace("s3.GetObject", "/photos/a.jpg", "node1", 200, 0, 1, time.Duration(i)*time.Millisecond))
+	}
+	aggregator.add(start.Add(2*time.Minute), newAPIStatsTrace("s3.ListBuckets", "/", "node1", 200, 0, 1, 90*time.Second))
+	msg = aggregator.snapshot(start.Add(2*time.Minute + time.Second))
+	assert.Len(aggregator.seconds, 1)
+	assert.Equal([]apiStatsEntry{
+		{Name: "s3.GetObject", Count: 1000, Tx: 1000, P50: "500ms", P99: "1s"},
+		// calls slower than the last bucket report the slowest call
+		{Name: "s3.ListBuckets", Count: 1, Tx: 1, P50: "1m30s", P99: "1m30s"},
+	}, msg.APIs)
+}
+
+func TestGetAPIStatsOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/ws/api-stats", nil)
+	opts, err := getAPIStatsOptionsFromReq(req)
+	assert.Nil(err)
+	assert.True(opts.trace.s3)
+	assert.Equal(apiStatsDefaultWindow, opts.window)
+
+	req, _ = http.NewRequest(http.MethodGet, `/ws/api-stats?calls=internal&window=30&record=yes&filter={"bucket":"photos"}`, nil)
+	opts, err = getAPIStatsOptionsFromReq(req)
+	assert.Nil(err)
+	assert.False(opts.trace.s3)
+	assert.True(opts.trace.internal)
+	assert.False(opts.trace.record)
+	assert.NotNil(opts.trace.filter)
+	assert.Equal(30*time.Second, opts.window)
+
+	req, _ = http.NewRequest(http.MethodGet, "/ws/api-stats?window=3600", nil)
+	opts, err = getAPIStatsOptionsFromReq(req)
+	assert.Nil(err)
+	assert.Equal(apiStatsMaxWindow, opts.window)
+
+	req, _ = http.NewRequest(http.MethodGet, "/ws/api-stats?window=-1", nil)
+	_, err = getAPIStatsOptionsFromReq(req)
+	assert.Error(err)
+}
+
+func TestStartAPIStats(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	mockWSConn := mockConn{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Test-1: aggregates are sent while the trace keeps streaming
+	minioServiceTraceMock = func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
+		ch := make(chan madmin.ServiceTraceInfo)
+		go func(ch chan<- madmin.ServiceTraceInfo) {
+			defer close(ch)
+			ch <- newAPIStatsTrace("s3.GetObject", "/photos/a.jpg", "node1", 200, 0, 100, 10*time.Millisecond)
+			ch <- newAPIStatsTrace("s3.PutObject", "/photos/b.jpg", "node1", 200, 100, 0, 10*time.Millisecond)
+			<-ctx.Done()
+		}(ch)
+		return ch
+	}
+	var received apiStatsMsg
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		_ = json.Unmarshal(data, &received)
+		cancel()
+		return nil
+	}
+	opts := &apiStatsRequest{
+		trace:  TraceRequest{s3: true, filter: &traceFilter{FuncName: "GetObject"}},
+		window: time.Minute,
+	}
+	assert.Nil(startAPIStats(ctx, mockWSConn, adminClient, opts))
+	assert.Equal([]apiStatsEntry{{Name: "s3.GetObject", Count: 1, Tx: 100, P50: "10ms", P99: "10ms"}}, received.APIs)
+
+	// Test-2: error happens on serviceTrace Minio, stats should stop
+	minioServiceTraceMock = func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
+		ch := make(chan madmin.ServiceTraceInfo, 1)
+		ch <- madmin.ServiceTraceInfo{Err: fmt.Errorf("error on trace")}
+		close(ch)
+		return ch
+	}
+	if err := startAPIStats(context.Background(), mockWSConn, adminClient, opts); assert.Error(err) {
+		assert.Equal("error on trace", err.Error())
+	}
+}
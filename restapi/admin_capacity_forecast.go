@@ -0,0 +1,416 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	systemApi "github.com/GuinsooLab/console/restapi/operations/system"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	capacitySamplesFile = "samples.jsonl"
+	// capacityForecastDays is the default number of days the trends are fitted on
+	capacityForecastDays = 30
+	// capacityStableGrowth is the growth in bytes per day under which usage is considered stable
+	capacityStableGrowth = 1 << 20
+)
+
+// capacitySamplesMu serializes the updates of the samples file
+var capacitySamplesMu sync.Mutex
+
+func registerCapacityForecastHandler(api *operations.ConsoleAPI) {
+	// forecast the capacity of the pools and buckets
+	api.SystemCapacityForecastHandler = systemApi.CapacityForecastHandlerFunc(func(params systemApi.CapacityForecastParams, session *models.Principal) middleware.Responder {
+		resp, err := getCapacityForecastResponse(session, params)
+		if err != nil {
+			return systemApi.NewCapacityForecastDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewCapacityForecastOK().WithPayload(resp)
+	})
+}
+
+// capacityUsage is the usage of a pool or a bucket on a sample
+type capacityUsage struct {
+	Name     string `json:"name"`
+	Used     int64  `json:"used"`
+	Capacity int64  `json:"capacity,omitempty"`
+	Objects  int64  `json:"objects,omitempty"`
+}
+
+// capacitySample is the usage of the deployment at a given time, stored one per line
+type capacitySample struct {
+	Time    int64           `json:"time"`
+	Pools   []capacityUsage `json:"pools"`
+	Buckets []capacityUsage `json:"buckets"`
+}
+
+// getCapacityDir returns the directory keeping the usage samples
+func getCapacityDir() string {
+	return filepath.Join(getDataDir(), "capacity")
+}
+
+// capacitySampler records the usage of the pools and buckets every `interval`
+type capacitySampler struct {
+	interval  time.Duration
+	retention time.Duration
+	dir       string
+	admin     MinioAdmin
+}
+
+// startCapacitySampler starts the usage sampling if configured, the samples are collected with their
+// own credentials since there is no user session to use
+func startCapacitySampler(ctx context.Context) {
+	interval := getCapacitySampleInterval()
+	if interval == 0 {
+		return
+	}
+	accessKey, secretKey := getCapacityCredentials()
+	if accessKey == "" || secretKey == "" {
+		LogError("capacity sampling disabled, %s and %s are required", ConsoleCapacityAccessKey, ConsoleCapacitySecretKey)
+		return
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		LogError("capacity sampling disabled: %v", err)
+		return
+	}
+	mAdmin.SetCustomTransport(GetConsoleHTTPClient().Transport)
+	sampler := &capacitySampler{
+		interval:  interval,
+		retention: getCapacityRetention(),
+		dir:       getCapacityDir(),
+		admin:     AdminClient{Client: mAdmin},
+	}
+	go sampler.run(ctx)
+}
+
+func (s *capacitySampler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := s.collect(ctx, time.Now()); err != nil {
+			LogError("error collecting usage sample: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collect records the current usage of the pools, from the usable space of the drives of their servers, and of
+// the buckets
+func (s *capacitySampler) collect(ctx context.Context, now time.Time) error {
+	info, err := s.admin.serverInfo(ctx)
+	if err != nil {
+		return err
+	}
+	buckets, err := getAccountBuckets(ctx, s.admin)
+	if err != nil {
+		return err
+	}
+	sample := capacitySample{Time: now.Unix(), Pools: []capacityUsage{}, Buckets: []capacityUsage{}}
+	// the backend is only known as an interface, decode it to get the configured parity
+	var backend madmin.ErasureBackend
+	if b, err := json.Marshal(info.Backend); err == nil {
+		_ = json.Unmarshal(b, &backend)
+	}
+	type poolDrives struct {
+		used, total uint64
+		sets        map[int]int
+	}
+	pools := map[int]*poolDrives{}
+	for _, server := range info.Servers {
+		pool, ok := pools[server.PoolNumber]
+		if !ok {
+			pool = &poolDrives{sets: map[int]int{}}
+			pools[server.PoolNumber] = pool
+		}
+		for _, drive := range server.Disks {
+			pool.used += drive.UsedSpace
+			pool.total += drive.TotalSpace
+			if drive.SetIndex >= 0 {
+				pool.sets[drive.SetIndex]++
+			}
+		}
+	}
+	for number, pool := range pools {
+		sample.Pools = append(sample.Pools, capacityUsage{
+			Name:     fmt.Sprintf("pool-%d", number),
+			Used:     int64(usableCapacity(pool.used, pool.sets, backend.StandardSCParity)),
+			Capacity: int64(usableCapacity(pool.total, pool.sets, backend.StandardSCParity)),
+		})
+	}
+	sort.Slice(sample.Pools, func(i, j int) bool {
+		return sample.Pools[i].Name < sample.Pools[j].Name
+	})
+	for _, bucket := range buckets {
+		usage := capacityUsage{Name: swag.StringValue(bucket.Name), Used: bucket.Size, Objects: bucket.Objects}
+		if bucket.Details != nil && bucket.Details.Quota != nil && bucket.Details.Quota.Type == string(madmin.HardQuota) {
+			usage.Capacity = bucket.Details.Quota.Quota
+		}
+		sample.Buckets = append(sample.Buckets, usage)
+	}
+	return appendCapacitySample(s.dir, sample, now, s.retention)
+}
+
+// usableCapacity returns the part of the raw drive space of a pool left for data once the standard parity
+// is taken out of each erasure set, the raw space is returned when the sets or the parity are unknown
+func usableCapacity(raw uint64, sets map[int]int, parity int) uint64 {
+	drives := 0
+	for _, n := range sets {
+		if n > drives {
+			drives = n
+		}
+	}
+	if drives == 0 || parity <= 0 || parity >= drives {
+		return raw
+	}
+	return raw / uint64(drives) * uint64(drives-parity)
+}
+
+// readCapacitySamples returns the samples in the order they were collected
+func readCapacitySamples(dir string) ([]capacitySample, error) {
+	file, err := os.Open(filepath.Join(dir, capacitySamplesFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+	var samples []capacitySample
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		var sample capacitySample
+		if err = json.Unmarshal(scanner.Bytes(), &sample); err != nil {
+			// skip lines partially written
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	return samples, scanner.Err()
+}
+
+// appendCapacitySample adds a sample to the samples file, the file is only rewritten when samples
+// older than the retention have to be removed
+func appendCapacitySample(dir string, sample capacitySample, now time.Time, retention time.Duration) error {
+	capacitySamplesMu.Lock()
+	defer capacitySamplesMu.Unlock()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	samples, err := readCapacitySamples(dir)
+	if err != nil {
+		return err
+	}
+	cutoff := now.Add(-retention).Unix()
+	if len(samples) > 0 && samples[0].Time < cutoff {
+		kept := []capacitySample{}
+		for _, s := range samples {
+			if s.Time >= cutoff {
+				kept = append(kept, s)
+			}
+		}
+		return writeCapacitySamples(dir, append(kept, sample))
+	}
+	line, err := json.Marshal(sample)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(filepath.Join(dir, capacitySamplesFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err = file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func writeCapacitySamples(dir string, samples []capacitySample) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, s := range samples {
+		if err := enc.Encode(s); err != nil {
+			return err
+		}
+	}
+	return writeDataFileBytes(filepath.Join(dir, capacitySamplesFile), buf.Bytes())
+}
+
+// capacityPoint is the usage of a pool, bucket or the cluster at a given time
+type capacityPoint struct {
+	time     int64
+	used     int64
+	capacity int64
+}
+
+// fitCapacityTrend fits a linear trend on the usage points with least squares and estimates when the
+// capacity is reached if usage keeps growing
+func fitCapacityTrend(name string, points []capacityPoint) *models.CapacityTrend {
+	trend := &models.CapacityTrend{
+		Name:    name,
+		Samples: int64(len(points)),
+		Trend:   models.CapacityTrendTrendInsufficientData,
+	}
+	if len(points) == 0 {
+		return trend
+	}
+	last := points[len(points)-1]
+	trend.Used = last.used
+	trend.Capacity = last.capacity
+	if len(points) < 2 {
+		return trend
+	}
+
+	// x is measured in days since the first point
+	n := float64(len(points))
+	var sumX, sumY float64
+	for _, p := range points {
+		sumX += float64(p.time-points[0].time) / 86400
+		sumY += float64(p.used)
+	}
+	meanX, meanY := sumX/n, sumY/n
+	var sxx, sxy, syy float64
+	for _, p := range points {
+		dx := float64(p.time-points[0].time)/86400 - meanX
+		dy := float64(p.used) - meanY
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return trend
+	}
+	slope := sxy / sxx
+	trend.GrowthPerDay = math.Round(slope)
+	trend.Fit = 1
+	if syy > 0 {
+		trend.Fit = math.Round(sxy*sxy/(sxx*syy)*1000) / 1000
+	}
+	switch {
+	case math.Abs(slope) < capacityStableGrowth:
+		trend.Trend = models.CapacityTrendTrendStable
+	case slope > 0:
+		trend.Trend = models.CapacityTrendTrendGrowing
+	default:
+		trend.Trend = models.CapacityTrendTrendShrinking
+	}
+	if trend.Capacity > 0 && (trend.Trend == models.CapacityTrendTrendGrowing || trend.Used >= trend.Capacity) {
+		days := 0.0
+		if trend.Used < trend.Capacity {
+			days = float64(trend.Capacity-trend.Used) / slope
+		}
+		trend.DaysUntilFull = math.Round(days*10) / 10
+		// a tiny growth puts the full date beyond what a time.Duration can hold, it is left out
+		if days < float64(math.MaxInt64/int64(24*time.Hour)) {
+			full := time.Unix(last.time, 0).Add(time.Duration(days * float64(24*time.Hour)))
+			trend.FullDate = full.UTC().Format(time.RFC3339)
+		}
+	}
+	return trend
+}
+
+// forecastCapacity fits the trends of the cluster, the pools and the buckets on the samples of the last `days`
+func forecastCapacity(samples []capacitySample, now time.Time, days int) *models.CapacityForecast {
+	cutoff := now.Add(-time.Duration(days) * 24 * time.Hour).Unix()
+	var cluster []capacityPoint
+	pools := map[string][]capacityPoint{}
+	buckets := map[string][]capacityPoint{}
+	for _, s := range samples {
+		if s.Time < cutoff {
+			continue
+		}
+		// samples collected while the servers were unreachable have no pools
+		if len(s.Pools) > 0 {
+			total := capacityPoint{time: s.Time}
+			for _, p := range s.Pools {
+				total.used += p.Used
+				total.capacity += p.Capacity
+				pools[p.Name] = append(pools[p.Name], capacityPoint{time: s.Time, used: p.Used, capacity: p.Capacity})
+			}
+			cluster = append(cluster, total)
+		}
+		for _, b := range s.Buckets {
+			buckets[b.Name] = append(buckets[b.Name], capacityPoint{time: s.Time, used: b.Used, capacity: b.Capacity})
+		}
+	}
+	trends := func(points map[string][]capacityPoint) []*models.CapacityTrend {
+		names := make([]string, 0, len(points))
+		for name := range points {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		result := []*models.CapacityTrend{}
+		for _, name := range names {
+			result = append(result, fitCapacityTrend(name, points[name]))
+		}
+		return result
+	}
+	return &models.CapacityForecast{
+		GeneratedAt: now.UTC().Format(time.RFC3339),
+		Days:        int32(days),
+		Cluster:     fitCapacityTrend("cluster", cluster),
+		Pools:       trends(pools),
+		Buckets:     trends(buckets),
+	}
+}
+
+func getCapacityForecastResponse(session *models.Principal, params systemApi.CapacityForecastParams) (*models.CapacityForecast, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateSessionAdminAction(ctx, session, iampolicy.ServerInfoAdminAction, "Capacity forecast not available."); err != nil {
+		return nil, err
+	}
+	days := capacityForecastDays
+	if params.Days != nil {
+		if *params.Days <= 0 {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, errors.New("days must be positive"))
+		}
+		days = int(*params.Days)
+	}
+	samples, err := readCapacitySamples(getCapacityDir())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	forecast := forecastCapacity(samples, time.Now(), days)
+	if interval := getCapacitySampleInterval(); interval > 0 {
+		forecast.Interval = interval.String()
+	}
+	return forecast, nil
+}
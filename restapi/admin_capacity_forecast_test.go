@@ -0,0 +1,134 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapacitySampler(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	var used uint64 = 100
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Backend: madmin.ErasureBackend{StandardSCParity: 2}, Servers: []madmin.ServerProperties{
+			{PoolNumber: 1, Disks: []madmin.Disk{{TotalSpace: 1000, UsedSpace: used}, {TotalSpace: 1000, UsedSpace: used}}},
+			{PoolNumber: 1, Disks: []madmin.Disk{{TotalSpace: 1000, UsedSpace: used}, {TotalSpace: 1000, UsedSpace: used}}},
+			{PoolNumber: 2, Disks: []madmin.Disk{{TotalSpace: 500, UsedSpace: 50, SetIndex: -1}}},
+		}}, nil
+	}
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+			{Name: "photos", Size: used, Objects: 10, Details: &madmin.BucketDetails{Quota: &madmin.BucketQuota{Quota: 400, Type: madmin.HardQuota}}},
+			{Name: "logs", Size: 20},
+		}}, nil
+	}
+	sampler := &capacitySampler{interval: time.Hour, retention: 3 * time.Hour, dir: t.TempDir(), admin: adminClientMock{}}
+
+	// Test-1: pools are summed from the drives of their servers less the parity of their sets, pools without
+	// assigned sets keep their raw space, buckets keep their hard quota
+	start := time.Unix(1656000000, 0)
+	assert.Nil(sampler.collect(ctx, start))
+	samples, err := readCapacitySamples(sampler.dir)
+	assert.Nil(err)
+	if assert.Len(samples, 1) {
+		assert.Equal([]capacityUsage{{Name: "pool-1", Used: 200, Capacity: 2000}, {Name: "pool-2", Used: 50, Capacity: 500}}, samples[0].Pools)
+		assert.Equal([]capacityUsage{{Name: "photos", Used: 100, Capacity: 400, Objects: 10}, {Name: "logs", Used: 20}}, samples[0].Buckets)
+	}
+
+	// Test-2: samples older than the retention are removed
+	for i := 1; i <= 4; i++ {
+		used += 100
+		assert.Nil(sampler.collect(ctx, start.Add(time.Duration(i)*time.Hour)))
+	}
+	samples, err = readCapacitySamples(sampler.dir)
+	assert.Nil(err)
+	if assert.Len(samples, 4) {
+		assert.Equal(start.Add(time.Hour).Unix(), samples[0].Time)
+	}
+}
+
+func TestForecastCapacity(t *testing.T) {
+	assert := assert.New(t)
+	const gib = 1 << 30
+	now := time.Unix(1656000000, 0)
+	var samples []capacitySample
+	for day := 0; day < 10; day++ {
+		samples = append(samples, capacitySample{
+			Time: now.Add(time.Duration(day-9) * 24 * time.Hour).Unix(),
+			Pools: []capacityUsage{
+				{Name: "pool-1", Used: int64(100+10*day) * gib, Capacity: 1000 * gib},
+				{Name: "pool-2", Used: 500 * gib, Capacity: 1000 * gib},
+			},
+			Buckets: []capacityUsage{
+				{Name: "photos", Used: int64(100-day) * gib, Capacity: 200 * gib},
+				{Name: "logs", Used: int64(10+day) * gib, Capacity: 30 * gib},
+			},
+		})
+	}
+	samples = append(samples, capacitySample{Time: now.Unix(), Buckets: []capacityUsage{{Name: "new", Used: gib}}})
+
+	forecast := forecastCapacity(samples, now, 30)
+	assert.Equal(int32(30), forecast.Days)
+
+	// Test-1: growing pool reaches its capacity on (1000-190)/10 days
+	if assert.Len(forecast.Pools, 2) {
+		pool := forecast.Pools[0]
+		assert.Equal("pool-1", pool.Name)
+		assert.Equal(models.CapacityTrendTrendGrowing, pool.Trend)
+		assert.Equal(float64(10*gib), pool.GrowthPerDay)
+		assert.Equal(float64(1), pool.Fit)
+		assert.Equal(81.0, pool.DaysUntilFull)
+		assert.Equal(now.Add(81*24*time.Hour).UTC().Format(time.RFC3339), pool.FullDate)
+		assert.Equal(models.CapacityTrendTrendStable, forecast.Pools[1].Trend)
+		assert.Empty(forecast.Pools[1].FullDate)
+	}
+
+	// Test-2: buckets are forecast against their quota
+	if assert.Len(forecast.Buckets, 3) {
+		logs, newBucket, photos := forecast.Buckets[0], forecast.Buckets[1], forecast.Buckets[2]
+		assert.Equal(11.0, logs.DaysUntilFull)
+		assert.Equal(models.CapacityTrendTrendInsufficientData, newBucket.Trend)
+		assert.Equal(int64(gib), newBucket.Used)
+		assert.Equal(models.CapacityTrendTrendShrinking, photos.Trend)
+		assert.Empty(photos.FullDate)
+	}
+
+	// Test-3: the cluster is the sum of the pools, samples without pools are skipped
+	assert.Equal(int64(10), forecast.Cluster.Samples)
+	assert.Equal(float64(10*gib), forecast.Cluster.GrowthPerDay)
+	assert.Equal(models.CapacityTrendTrendGrowing, forecast.Cluster.Trend)
+
+	// Test-4: only the samples in the window are used
+	forecast = forecastCapacity(samples, now, 1)
+	assert.Equal(int64(2), forecast.Pools[0].Samples)
+
+	// Test-5: a full date beyond the range of time.Duration is left out
+	trend := fitCapacityTrend("big", []capacityPoint{
+		{time: now.Unix(), used: 0, capacity: 1 << 60},
+		{time: now.Add(24 * time.Hour).Unix(), used: 2 << 20, capacity: 1 << 60},
+	})
+	assert.Equal(models.CapacityTrendTrendGrowing, trend.Trend)
+	assert.Greater(trend.DaysUntilFull, float64(1e8))
+	assert.Empty(trend.FullDate)
+}
@@ -19,17 +19,132 @@ package restapi
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	logApi "github.com/GuinsooLab/console/restapi/operations/logging"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/gorilla/websocket"
 	"github.com/minio/madmin-go"
 )
 
-const logTimeFormat string = "15:04:05 MST 01/02/2006"
+const (
+	logTimeFormat string = "15:04:05 MST 01/02/2006"
+	// number of log lines sent by every node before streaming new entries
+	defaultConsoleLogLineCount = 100
+	maxConsoleLogLineCount     = 10000
+	// consoleLogDownloadIdle is how long a download waits for the log history of the nodes
+	// that sent fewer entries than requested
+	consoleLogDownloadIdle = 2 * time.Second
+)
 
-// startConsoleLog starts log of the servers
-func startConsoleLog(ctx context.Context, conn WSConn, client MinioAdmin, logRequest LogRequest) error {
+func registerConsoleLogHandlers(api *operations.ConsoleAPI) {
+	// download console logs
+	api.LoggingDownloadConsoleLogsHandler = logApi.DownloadConsoleLogsHandlerFunc(func(params logApi.DownloadConsoleLogsParams, session *models.Principal) middleware.Responder {
+		client, logRequest, err := getDownloadConsoleLogsRequest(session, params)
+		if err != nil {
+			return logApi.NewDownloadConsoleLogsDefault(int(err.Code)).WithPayload(err)
+		}
+		return middleware.ResponderFunc(processDownloadConsoleLogsResponse(params.HTTPRequest.Context(), client, logRequest))
+	})
+}
+
+// consoleLogFilter server side filters for the console log entries, every filter set must match
+type consoleLogFilter struct {
+	message      *regexp.Regexp
+	api          string
+	level        string
+	errKind      string
+	deploymentID string
+}
+
+// match returns whether the log entry passes all the filters
+func (f *consoleLogFilter) match(logInfo *madmin.LogInfo) bool {
+	if f == nil {
+		return true
+	}
+	if f.message != nil && !f.message.MatchString(consoleLogMessage(logInfo)) {
+		return false
+	}
+	if f.api != "" && (logInfo.API == nil || !strings.EqualFold(logInfo.API.Name, f.api)) {
+		return false
+	}
+	if f.level != "" && !strings.EqualFold(logInfo.Level, f.level) {
+		return false
+	}
+	if f.errKind != "" && !strings.EqualFold(string(logInfo.LogKind), f.errKind) {
+		return false
+	}
+	if f.deploymentID != "" && logInfo.DeploymentID != f.deploymentID {
+		return false
+	}
+	return true
+}
+
+// consoleLogMessage returns all the text of a log entry the message filter is matched against
+func consoleLogMessage(logInfo *madmin.LogInfo) string {
+	messages := []string{logInfo.ConsoleMsg, logInfo.Message}
+	if logInfo.Trace != nil {
+		messages = append(messages, logInfo.Trace.Message)
+	}
+	return strings.Join(messages, "\n")
+}
+
+// newLogRequest validates the console log options
+func newLogRequest(node, logType string, lineCount int, search, api, level, errKind, deploymentID string) (LogRequest, error) {
+	logRequest := LogRequest{
+		node:      node,
+		logType:   logType,
+		lineCount: lineCount,
+	}
+	if lineCount < 1 || lineCount > maxConsoleLogLineCount {
+		return logRequest, fmt.Errorf("lineCount must be between 1 and %d", maxConsoleLogLineCount)
+	}
+	if search == "" && api == "" && level == "" && errKind == "" && deploymentID == "" {
+		return logRequest, nil
+	}
+	filter := &consoleLogFilter{
+		api:          api,
+		level:        level,
+		errKind:      errKind,
+		deploymentID: deploymentID,
+	}
+	if search != "" {
+		re, err := regexp.Compile(search)
+		if err != nil {
+			return logRequest, fmt.Errorf("invalid search expression: %v", err)
+		}
+		filter.message = re
+	}
+	logRequest.filter = filter
+	return logRequest, nil
+}
+
+// getLogOptionsFromReq builds the console log request from the websocket query parameters
+func getLogOptionsFromReq(req *http.Request) (LogRequest, error) {
+	query := req.URL.Query()
+	lineCount := defaultConsoleLogLineCount
+	if lc := query.Get("lineCount"); lc != "" {
+		var err error
+		if lineCount, err = strconv.Atoi(lc); err != nil {
+			return LogRequest{}, fmt.Errorf("invalid lineCount: %s", lc)
+		}
+	}
+	return newLogRequest(query.Get("node"), query.Get("logType"), lineCount,
+		query.Get("search"), query.Get("api"), query.Get("level"), query.Get("errKind"), query.Get("deploymentId"))
+}
+
+// getLogsChannel starts listening on the console log activity of the requested nodes
+func getLogsChannel(ctx context.Context, client MinioAdmin, logRequest LogRequest) <-chan madmin.LogInfo {
 	var node string
 	// name of node, default = "" (all)
 	if logRequest.node == "all" {
@@ -39,8 +154,6 @@ func startConsoleLog(ctx context.Context, conn WSConn, client MinioAdmin, logReq
 	}
 
 	trimNode := strings.Split(node, ":")
-	// number of log lines
-	lineCount := 100
 	// type of logs "minio"|"application"|"all" default = "all"
 	var logKind string
 	if logRequest.logType == "minio" || logRequest.logType == "application" || logRequest.logType == "all" {
@@ -49,8 +162,13 @@ func startConsoleLog(ctx context.Context, conn WSConn, client MinioAdmin, logReq
 		logKind = "all"
 	}
 
+	return client.getLogs(ctx, trimNode[0], logRequest.lineCount, logKind)
+}
+
+// startConsoleLog starts log of the servers
+func startConsoleLog(ctx context.Context, conn WSConn, client MinioAdmin, logRequest LogRequest) error {
 	// Start listening on all Console Log activity.
-	logCh := client.getLogs(ctx, trimNode[0], lineCount, logKind)
+	logCh := getLogsChannel(ctx, client, logRequest)
 
 	for {
 		select {
@@ -66,6 +184,9 @@ func startConsoleLog(ctx context.Context, conn WSConn, client MinioAdmin, logReq
 				LogError("error on console logs: %v", logInfo.Err)
 				return logInfo.Err
 			}
+			if !logRequest.filter.match(&logInfo) {
+				continue
+			}
 
 			// Serialize message to be sent
 			bytes, err := json.Marshal(serializeConsoleLogInfo(&logInfo))
@@ -102,3 +223,75 @@ func getLogTime(lt string) string {
 	}
 	return tm.Format(logTimeFormat)
 }
+
+func getDownloadConsoleLogsRequest(session *models.Principal, params logApi.DownloadConsoleLogsParams) (MinioAdmin, LogRequest, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	logRequest, err := newLogRequest(swag.StringValue(params.Node), swag.StringValue(params.LogType), int(swag.Int32Value(params.LineCount)),
+		swag.StringValue(params.Search), swag.StringValue(params.API), swag.StringValue(params.Level), swag.StringValue(params.ErrKind), swag.StringValue(params.DeploymentID))
+	if err != nil {
+		return nil, logRequest, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, logRequest, ErrorWithContext(ctx, err)
+	}
+	return AdminClient{Client: mAdmin}, logRequest, nil
+}
+
+func processDownloadConsoleLogsResponse(ctx context.Context, client MinioAdmin, logRequest LogRequest) func(w http.ResponseWriter, _ runtime.Producer) {
+	return func(w http.ResponseWriter, _ runtime.Producer) {
+		fileName := fmt.Sprintf("console-logs-%s.ndjson", time.Now().UTC().Format("20060102150405"))
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+
+		if err := downloadConsoleLogs(ctx, w, client, logRequest, consoleLogDownloadIdle); err != nil {
+			LogError("Unable to download all the console logs: %v", err)
+		}
+	}
+}
+
+// downloadConsoleLogs writes the log history sent by the nodes as NDJSON. The nodes keep streaming
+// new entries after their last `lineCount` entries, those are not part of the download: it ends once
+// a single requested node sent its history or, for all the nodes, once no history entry arrives for `idle`
+func downloadConsoleLogs(ctx context.Context, w io.Writer, client MinioAdmin, logRequest LogRequest, idle time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	logCh := getLogsChannel(ctx, client, logRequest)
+	enc := json.NewEncoder(w)
+	singleNode := logRequest.node != "" && logRequest.node != "all"
+	received := map[string]int{}
+	timer := time.NewTimer(idle)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-timer.C:
+			return nil
+		case logInfo, ok := <-logCh:
+			if !ok {
+				return nil
+			}
+			if logInfo.Err != nil {
+				return logInfo.Err
+			}
+			// entries past the history of the node are streamed live
+			if received[logInfo.NodeName] >= logRequest.lineCount {
+				continue
+			}
+			received[logInfo.NodeName]++
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(idle)
+			if logRequest.filter.match(&logInfo) {
+				if err := enc.Encode(serializeConsoleLogInfo(&logInfo)); err != nil {
+					return err
+				}
+			}
+			if singleNode && received[logInfo.NodeName] == logRequest.lineCount {
+				return nil
+			}
+		}
+	}
+}
@@ -17,10 +17,14 @@
 package restapi
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/minio/madmin-go"
 	"github.com/stretchr/testify/assert"
@@ -123,3 +127,196 @@ func TestAdminConsoleLog(t *testing.T) {
 		assert.Equal("error on Console", err.Error())
 	}
 }
+
+func TestConsoleLogFilters(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	mockWSConn := mockConn{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rawEntries := []string{
+		`{"deploymentid":"dep-1","level":"ERROR","errKind":"MINIO","api":{"name":"PutObject"},"message":"disk not found","node":"node1"}`,
+		`{"deploymentid":"dep-1","level":"INFO","errKind":"APPLICATION","ConsoleMsg":"Status: 4 Online, 0 Offline.","node":"node2"}`,
+		`{"deploymentid":"dep-2","level":"ERROR","errKind":"APPLICATION","api":{"name":"GetObject"},"error":{"message":"read failed: disk not found"},"node":"node1"}`,
+	}
+	var entries []madmin.LogInfo
+	for _, raw := range rawEntries {
+		var info madmin.LogInfo
+		assert.Nil(json.Unmarshal([]byte(raw), &info))
+		entries = append(entries, info)
+	}
+	var requestedLineCount int
+	minioGetLogsMock = func(ctx context.Context, node string, lineCnt int, logKind string) <-chan madmin.LogInfo {
+		requestedLineCount = lineCnt
+		ch := make(chan madmin.LogInfo)
+		go func(ch chan<- madmin.LogInfo) {
+			defer close(ch)
+			for _, info := range entries {
+				ch <- info
+			}
+		}(ch)
+		return ch
+	}
+
+	tests := []struct {
+		name              string
+		query             string
+		expectedNodes     []string
+		expectedLineCount int
+		wantErr           bool
+	}{
+		{
+			name:              "no filters",
+			query:             "",
+			expectedNodes:     []string{"node1", "node2", "node1"},
+			expectedLineCount: defaultConsoleLogLineCount,
+		},
+		{
+			name:              "message regex matches messages and errors",
+			query:             "search=disk+not+found&lineCount=500",
+			expectedNodes:     []string{"node1", "node1"},
+			expectedLineCount: 500,
+		},
+		{
+			name:              "console messages",
+			query:             "search=%5EStatus",
+			expectedNodes:     []string{"node2"},
+			expectedLineCount: defaultConsoleLogLineCount,
+		},
+		{
+			name:              "api name",
+			query:             "api=getobject",
+			expectedNodes:     []string{"node1"},
+			expectedLineCount: defaultConsoleLogLineCount,
+		},
+		{
+			name:              "filters are combined",
+			query:             "level=error&errKind=minio&deploymentId=dep-1",
+			expectedNodes:     []string{"node1"},
+			expectedLineCount: defaultConsoleLogLineCount,
+		},
+		{
+			name:          "no entry matches",
+			query:         "deploymentId=dep-3",
+			expectedNodes: nil,
+			// defaults are sent to MinIO
+			expectedLineCount: defaultConsoleLogLineCount,
+		},
+		{
+			name:    "invalid regex",
+			query:   "search=%28",
+			wantErr: true,
+		},
+		{
+			name:    "invalid line count",
+			query:   "lineCount=many",
+			wantErr: true,
+		},
+		{
+			name:    "line count zero",
+			query:   "lineCount=0",
+			wantErr: true,
+		},
+		{
+			name:    "line count too big",
+			query:   "lineCount=1000000",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/ws/console?"+tt.query, nil)
+			logRequest, err := getLogOptionsFromReq(req)
+			if tt.wantErr {
+				assert.Error(err)
+				return
+			}
+			assert.Nil(err)
+			var nodes []string
+			connWriteMessageMock = func(messageType int, data []byte) error {
+				var info madmin.LogInfo
+				_ = json.Unmarshal(data, &info)
+				nodes = append(nodes, info.NodeName)
+				return nil
+			}
+			assert.Nil(startConsoleLog(ctx, mockWSConn, adminClient, logRequest))
+			assert.Equal(tt.expectedNodes, nodes)
+			assert.Equal(tt.expectedLineCount, requestedLineCount)
+		})
+	}
+}
+
+func TestDownloadConsoleLogs(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the history is sent right away followed by new entries, faster than the idle timeout
+	minioGetLogsMock = func(ctx context.Context, node string, lineCnt int, logKind string) <-chan madmin.LogInfo {
+		ch := make(chan madmin.LogInfo)
+		go func(ch chan<- madmin.LogInfo) {
+			defer close(ch)
+			for i := 0; ; i++ {
+				if i >= lineCnt {
+					time.Sleep(10 * time.Millisecond)
+				}
+				nodeName := node
+				if nodeName == "" {
+					nodeName = "node1"
+				}
+				info := madmin.LogInfo{NodeName: nodeName, ConsoleMsg: fmt.Sprintf("entry %d", i)}
+				info.Level = "INFO"
+				if i%2 == 0 {
+					info.Level = "ERROR"
+				}
+				select {
+				case ch <- info:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ch)
+		return ch
+	}
+
+	logRequest, err := newLogRequest("node1:9000", "all", 10, "", "", "error", "", "")
+	assert.Nil(err)
+	var buf bytes.Buffer
+	start := time.Now()
+	assert.Nil(downloadConsoleLogs(ctx, &buf, adminClient, logRequest, 50*time.Millisecond))
+	assert.Less(time.Since(start), 5*time.Second)
+
+	decoder := json.NewDecoder(&buf)
+	var downloaded []madmin.LogInfo
+	for decoder.More() {
+		var info madmin.LogInfo
+		assert.Nil(decoder.Decode(&info))
+		downloaded = append(downloaded, info)
+	}
+	if assert.Len(downloaded, 5) {
+		assert.Equal("entry 0", downloaded[0].ConsoleMsg)
+		assert.Equal("node1", downloaded[0].NodeName)
+		assert.Equal("entry 8", downloaded[4].ConsoleMsg)
+	}
+
+	// the download of all the nodes ends once no more history arrives
+	logRequest, err = newLogRequest("all", "all", 4, "", "", "", "", "")
+	assert.Nil(err)
+	buf.Reset()
+	assert.Nil(downloadConsoleLogs(ctx, &buf, adminClient, logRequest, 50*time.Millisecond))
+	assert.Equal(4, strings.Count(buf.String(), "\n"))
+
+	// error happens on GetLogs Minio, download should stop
+	minioGetLogsMock = func(ctx context.Context, node string, lineCnt int, logKind string) <-chan madmin.LogInfo {
+		ch := make(chan madmin.LogInfo, 1)
+		ch <- madmin.LogInfo{Err: fmt.Errorf("error on Console")}
+		close(ch)
+		return ch
+	}
+	if err := downloadConsoleLogs(ctx, &buf, adminClient, logRequest, 50*time.Millisecond); assert.Error(err) {
+		assert.Equal("error on Console", err.Error())
+	}
+}
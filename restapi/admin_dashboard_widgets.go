@@ -0,0 +1,555 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	systemApi "github.com/GuinsooLab/console/restapi/operations/system"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	dashboardScopeUser   = "user"
+	dashboardScopeGlobal = "global"
+	// dashboardExportVersion is the version of the exported dashboards
+	dashboardExportVersion = 1
+	// customWidgetMinID is the first id of the custom widgets, so they never collide with the built-in ones
+	customWidgetMinID = 1000
+)
+
+// dashboardWidgetsMu serializes the updates of the dashboard files
+var dashboardWidgetsMu sync.Mutex
+
+func registerDashboardWidgetsHandlers(api *operations.ConsoleAPI) {
+	// list custom widgets
+	api.SystemListDashboardWidgetsHandler = systemApi.ListDashboardWidgetsHandlerFunc(func(params systemApi.ListDashboardWidgetsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListDashboardWidgetsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListDashboardWidgetsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewListDashboardWidgetsOK().WithPayload(resp)
+	})
+	// create custom widget
+	api.SystemCreateDashboardWidgetHandler = systemApi.CreateDashboardWidgetHandlerFunc(func(params systemApi.CreateDashboardWidgetParams, session *models.Principal) middleware.Responder {
+		resp, err := getCreateDashboardWidgetResponse(session, params)
+		if err != nil {
+			return systemApi.NewCreateDashboardWidgetDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewCreateDashboardWidgetCreated().WithPayload(resp)
+	})
+	// update custom widget
+	api.SystemUpdateDashboardWidgetHandler = systemApi.UpdateDashboardWidgetHandlerFunc(func(params systemApi.UpdateDashboardWidgetParams, session *models.Principal) middleware.Responder {
+		resp, err := getUpdateDashboardWidgetResponse(session, params)
+		if err != nil {
+			return systemApi.NewUpdateDashboardWidgetDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewUpdateDashboardWidgetOK().WithPayload(resp)
+	})
+	// delete custom widget
+	api.SystemDeleteDashboardWidgetHandler = systemApi.DeleteDashboardWidgetHandlerFunc(func(params systemApi.DeleteDashboardWidgetParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteDashboardWidgetResponse(session, params); err != nil {
+			return systemApi.NewDeleteDashboardWidgetDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewDeleteDashboardWidgetNoContent()
+	})
+	// order custom widgets
+	api.SystemOrderDashboardWidgetsHandler = systemApi.OrderDashboardWidgetsHandlerFunc(func(params systemApi.OrderDashboardWidgetsParams, session *models.Principal) middleware.Responder {
+		resp, err := getOrderDashboardWidgetsResponse(session, params)
+		if err != nil {
+			return systemApi.NewOrderDashboardWidgetsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewOrderDashboardWidgetsOK().WithPayload(resp)
+	})
+	// export custom widgets
+	api.SystemExportDashboardHandler = systemApi.ExportDashboardHandlerFunc(func(params systemApi.ExportDashboardParams, session *models.Principal) middleware.Responder {
+		resp, err := getExportDashboardResponse(session, params)
+		if err != nil {
+			return systemApi.NewExportDashboardDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewExportDashboardOK().WithPayload(resp)
+	})
+	// import custom widgets
+	api.SystemImportDashboardHandler = systemApi.ImportDashboardHandlerFunc(func(params systemApi.ImportDashboardParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportDashboardResponse(session, params)
+		if err != nil {
+			return systemApi.NewImportDashboardDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewImportDashboardOK().WithPayload(resp)
+	})
+}
+
+// getDashboardsDir returns the directory keeping the custom dashboard widgets
+func getDashboardsDir() string {
+	return filepath.Join(getDataDir(), "dashboards")
+}
+
+// dashboardWidgetsFile returns the file keeping the widgets of a scope, user files are named
+// after a hash of the access key so any access key is a valid file name
+func dashboardWidgetsFile(dir, scope, owner string) string {
+	if scope == dashboardScopeGlobal {
+		return filepath.Join(dir, "global.json")
+	}
+	sum := sha256.Sum256([]byte(owner))
+	return filepath.Join(dir, "user-"+hex.EncodeToString(sum[:8])+".json")
+}
+
+func readDashboardWidgets(file string) ([]*models.DashboardWidget, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var widgets []*models.DashboardWidget
+	if err = json.Unmarshal(b, &widgets); err != nil {
+		return nil, err
+	}
+	return widgets, nil
+}
+
+func writeDashboardWidgets(file string, widgets []*models.DashboardWidget) error {
+	for i, w := range widgets {
+		w.Position = int32(i)
+	}
+	return writeDataFile(file, widgets)
+}
+
+// nextDashboardWidgetID returns an id not used by any widget of any scope
+func nextDashboardWidgetID(dir string) (int32, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return 0, err
+	}
+	next := int32(customWidgetMinID)
+	for _, file := range files {
+		widgets, err := readDashboardWidgets(file)
+		if err != nil {
+			return 0, err
+		}
+		for _, w := range widgets {
+			if w.ID >= next {
+				next = w.ID + 1
+			}
+		}
+	}
+	return next, nil
+}
+
+// listDashboardWidgets returns the global widgets followed by the ones of the user
+func listDashboardWidgets(dir, owner string) ([]*models.DashboardWidget, error) {
+	global, err := readDashboardWidgets(dashboardWidgetsFile(dir, dashboardScopeGlobal, owner))
+	if err != nil {
+		return nil, err
+	}
+	user, err := readDashboardWidgets(dashboardWidgetsFile(dir, dashboardScopeUser, owner))
+	if err != nil {
+		return nil, err
+	}
+	return append(global, user...), nil
+}
+
+// validateDashboardWidget checks the widget can be stored, when the dashboard is served by the
+// built-in collector the queries must also be supported by it
+func validateDashboardWidget(w *models.DashboardWidget) error {
+	if strings.TrimSpace(swag.StringValue(w.Title)) == "" || strings.TrimSpace(swag.StringValue(w.Type)) == "" {
+		return errors.New("title and type are required")
+	}
+	if len(w.Targets) == 0 {
+		return errors.New("at least one target is required")
+	}
+	for _, target := range w.Targets {
+		if target == nil || strings.TrimSpace(swag.StringValue(target.Expr)) == "" {
+			return errors.New("the expression of the targets is required")
+		}
+		if getPrometheusURL() == "" && globalMetricsCollector != nil {
+			labelMap := map[string][]string{}
+			for _, lbl := range labels {
+				labelMap[lbl.Name] = globalMetricsCollector.store.labelValues(lbl.Name)
+			}
+			query := expandWidgetQuery(*target.Expr, fmt.Sprintf(`job="%s"`, getPrometheusJobID()), labelMap)
+			if _, err := parseMetricsQuery(query); err != nil {
+				return fmt.Errorf("query not supported by the built-in metrics collector: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func newDashboardWidget(w *models.DashboardWidget, id int32, scope, owner string) *models.DashboardWidget {
+	return &models.DashboardWidget{
+		ID:            id,
+		Title:         w.Title,
+		Type:          w.Type,
+		Scope:         scope,
+		MaxDataPoints: w.MaxDataPoints,
+		Calcs:         w.Calcs,
+		GridPos:       w.GridPos,
+		Targets:       w.Targets,
+		Owner:         owner,
+		Updated:       time.Now().UTC().Format(time.RFC3339),
+	}
+}
+
+func createDashboardWidget(dir, owner string, w *models.DashboardWidget) (*models.DashboardWidget, error) {
+	scope := w.Scope
+	if scope == "" {
+		scope = dashboardScopeUser
+	}
+	dashboardWidgetsMu.Lock()
+	defer dashboardWidgetsMu.Unlock()
+	id, err := nextDashboardWidgetID(dir)
+	if err != nil {
+		return nil, err
+	}
+	file := dashboardWidgetsFile(dir, scope, owner)
+	widgets, err := readDashboardWidgets(file)
+	if err != nil {
+		return nil, err
+	}
+	created := newDashboardWidget(w, id, scope, owner)
+	widgets = append(widgets, created)
+	if err = writeDashboardWidgets(file, widgets); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// findDashboardWidget returns the file and index of a widget the user can see
+func findDashboardWidget(dir, owner string, id int32) (string, []*models.DashboardWidget, int, error) {
+	for _, scope := range []string{dashboardScopeUser, dashboardScopeGlobal} {
+		file := dashboardWidgetsFile(dir, scope, owner)
+		widgets, err := readDashboardWidgets(file)
+		if err != nil {
+			return "", nil, 0, err
+		}
+		for i, w := range widgets {
+			if w.ID == id {
+				return file, widgets, i, nil
+			}
+		}
+	}
+	return "", nil, 0, ErrDashboardWidgetNotFound
+}
+
+func updateDashboardWidget(dir, owner string, id int32, w *models.DashboardWidget) (*models.DashboardWidget, error) {
+	dashboardWidgetsMu.Lock()
+	defer dashboardWidgetsMu.Unlock()
+	file, widgets, i, err := findDashboardWidget(dir, owner, id)
+	if err != nil {
+		return nil, err
+	}
+	if w.Scope != "" && w.Scope != widgets[i].Scope {
+		return nil, ErrDashboardWidgetScope
+	}
+	widgets[i] = newDashboardWidget(w, id, widgets[i].Scope, owner)
+	if err = writeDashboardWidgets(file, widgets); err != nil {
+		return nil, err
+	}
+	return widgets[i], nil
+}
+
+// dashboardWidgetScope returns the scope of a widget the user can see
+func dashboardWidgetScope(dir, owner string, id int32) (string, error) {
+	_, widgets, i, err := findDashboardWidget(dir, owner, id)
+	if err != nil {
+		return "", err
+	}
+	return widgets[i].Scope, nil
+}
+
+func deleteDashboardWidget(dir, owner string, id int32) error {
+	dashboardWidgetsMu.Lock()
+	defer dashboardWidgetsMu.Unlock()
+	file, widgets, i, err := findDashboardWidget(dir, owner, id)
+	if err != nil {
+		return err
+	}
+	return writeDashboardWidgets(file, append(widgets[:i], widgets[i+1:]...))
+}
+
+// orderDashboardWidgets sorts the widgets of a scope as listed by ids, which must contain all of them
+func orderDashboardWidgets(dir, owner, scope string, ids []int32) error {
+	dashboardWidgetsMu.Lock()
+	defer dashboardWidgetsMu.Unlock()
+	file := dashboardWidgetsFile(dir, scope, owner)
+	widgets, err := readDashboardWidgets(file)
+	if err != nil {
+		return err
+	}
+	if len(ids) != len(widgets) {
+		return ErrDashboardWidgetsOrder
+	}
+	byID := make(map[int32]*models.DashboardWidget, len(widgets))
+	for _, w := range widgets {
+		byID[w.ID] = w
+	}
+	ordered := make([]*models.DashboardWidget, 0, len(ids))
+	for _, id := range ids {
+		w, ok := byID[id]
+		if !ok {
+			return ErrDashboardWidgetsOrder
+		}
+		ordered = append(ordered, w)
+		delete(byID, id)
+	}
+	return writeDashboardWidgets(file, ordered)
+}
+
+// exportDashboard returns the widgets of a scope, or all the widgets the user can see, without the
+// fields assigned on import
+func exportDashboard(dir, owner, scope string) (*models.DashboardExport, error) {
+	widgets, err := listDashboardWidgets(dir, owner)
+	if err != nil {
+		return nil, err
+	}
+	export := &models.DashboardExport{Version: dashboardExportVersion, Widgets: []*models.DashboardWidget{}}
+	for _, w := range widgets {
+		if scope != "" && w.Scope != scope {
+			continue
+		}
+		exported := *w
+		exported.ID = 0
+		exported.Owner = ""
+		exported.Updated = ""
+		export.Widgets = append(export.Widgets, &exported)
+	}
+	return export, nil
+}
+
+// importDashboard adds the widgets of an exported dashboard with new ids, `scope` overrides the scope of the
+// exported widgets and `replace` removes the existing widgets of the scopes imported to
+func importDashboard(dir, owner string, req *models.ImportDashboardRequest) ([]*models.DashboardWidget, error) {
+	if req.Dashboard.Version != dashboardExportVersion {
+		return nil, ErrDashboardVersion
+	}
+	dashboardWidgetsMu.Lock()
+	defer dashboardWidgetsMu.Unlock()
+	id, err := nextDashboardWidgetID(dir)
+	if err != nil {
+		return nil, err
+	}
+	updated := map[string][]*models.DashboardWidget{}
+	var imported []*models.DashboardWidget
+	for _, w := range req.Dashboard.Widgets {
+		scope := req.Scope
+		if scope == "" {
+			scope = w.Scope
+		}
+		if scope == "" {
+			scope = dashboardScopeUser
+		}
+		file := dashboardWidgetsFile(dir, scope, owner)
+		if _, ok := updated[file]; !ok {
+			existing, err := readDashboardWidgets(file)
+			if err != nil {
+				return nil, err
+			}
+			if req.Replace {
+				existing = nil
+			}
+			updated[file] = existing
+		}
+		widget := newDashboardWidget(w, id, scope, owner)
+		id++
+		updated[file] = append(updated[file], widget)
+		imported = append(imported, widget)
+	}
+	for file, widgets := range updated {
+		if err = writeDashboardWidgets(file, widgets); err != nil {
+			return nil, err
+		}
+	}
+	return imported, nil
+}
+
+// dashboardWidgetMetric returns the definition of a custom widget as the built-in ones
+func dashboardWidgetMetric(w *models.DashboardWidget) Metric {
+	m := Metric{
+		ID:            w.ID,
+		Title:         *w.Title,
+		Type:          *w.Type,
+		MaxDataPoints: w.MaxDataPoints,
+		Options:       MetricOptions{ReduceOptions: ReduceOptions{Calcs: w.Calcs}},
+	}
+	if w.GridPos != nil {
+		m.GridPos = GridPos{H: w.GridPos.H, W: w.GridPos.W, X: w.GridPos.X, Y: w.GridPos.Y}
+	}
+	for _, t := range w.Targets {
+		m.Targets = append(m.Targets, Target{Expr: *t.Expr, LegendFormat: t.LegendFormat, Step: t.Step, InitialTime: t.InitialTime})
+	}
+	return m
+}
+
+// getDashboardMetrics returns the built-in widgets followed by the custom widgets the user can see
+func getDashboardMetrics(session *models.Principal) []Metric {
+	dashboard := append([]Metric{}, widgets...)
+	custom, err := listDashboardWidgets(getDashboardsDir(), session.AccountAccessKey)
+	if err != nil {
+		LogError("unable to read the custom dashboard widgets: %v", err)
+		return dashboard
+	}
+	for _, w := range custom {
+		dashboard = append(dashboard, dashboardWidgetMetric(w))
+	}
+	return dashboard
+}
+
+func getListDashboardWidgetsResponse(session *models.Principal, params systemApi.ListDashboardWidgetsParams) (*models.DashboardWidgetList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateDashboardWidgetsAccess(ctx, session, ""); err != nil {
+		return nil, err
+	}
+	widgets, err := listDashboardWidgets(getDashboardsDir(), session.AccountAccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.DashboardWidgetList{Widgets: widgets}, nil
+}
+
+func getCreateDashboardWidgetResponse(session *models.Principal, params systemApi.CreateDashboardWidgetParams) (*models.DashboardWidget, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateDashboardWidgetsAccess(ctx, session, params.Body.Scope); err != nil {
+		return nil, err
+	}
+	if err := validateDashboardWidget(params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	widget, err := createDashboardWidget(getDashboardsDir(), session.AccountAccessKey, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return widget, nil
+}
+
+func getUpdateDashboardWidgetResponse(session *models.Principal, params systemApi.UpdateDashboardWidgetParams) (*models.DashboardWidget, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	scope, err := dashboardWidgetScope(getDashboardsDir(), session.AccountAccessKey, params.WidgetID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if err := validateDashboardWidgetsAccess(ctx, session, scope); err != nil {
+		return nil, err
+	}
+	if err := validateDashboardWidget(params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	widget, err := updateDashboardWidget(getDashboardsDir(), session.AccountAccessKey, params.WidgetID, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return widget, nil
+}
+
+func getDeleteDashboardWidgetResponse(session *models.Principal, params systemApi.DeleteDashboardWidgetParams) *models.Error {
+	ctx := params.HTTPRequest.Context()
+	scope, err := dashboardWidgetScope(getDashboardsDir(), session.AccountAccessKey, params.WidgetID)
+	if err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	if err := validateDashboardWidgetsAccess(ctx, session, scope); err != nil {
+		return err
+	}
+	if err = deleteDashboardWidget(getDashboardsDir(), session.AccountAccessKey, params.WidgetID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getOrderDashboardWidgetsResponse(session *models.Principal, params systemApi.OrderDashboardWidgetsParams) (*models.DashboardWidgetList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateDashboardWidgetsAccess(ctx, session, *params.Body.Scope); err != nil {
+		return nil, err
+	}
+	if err := orderDashboardWidgets(getDashboardsDir(), session.AccountAccessKey, *params.Body.Scope, params.Body.Ids); err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	widgets, err := listDashboardWidgets(getDashboardsDir(), session.AccountAccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.DashboardWidgetList{Widgets: widgets}, nil
+}
+
+func getExportDashboardResponse(session *models.Principal, params systemApi.ExportDashboardParams) (*models.DashboardExport, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateDashboardWidgetsAccess(ctx, session, ""); err != nil {
+		return nil, err
+	}
+	scope := ""
+	if params.Scope != nil {
+		scope = *params.Scope
+	}
+	export, err := exportDashboard(getDashboardsDir(), session.AccountAccessKey, scope)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return export, nil
+}
+
+func getImportDashboardResponse(session *models.Principal, params systemApi.ImportDashboardParams) (*models.DashboardWidgetList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	// importing global widgets requires the global permissions
+	scope := params.Body.Scope
+	if scope == "" {
+		for _, w := range params.Body.Dashboard.Widgets {
+			if w.Scope == dashboardScopeGlobal {
+				scope = dashboardScopeGlobal
+			}
+		}
+	}
+	if err := validateDashboardWidgetsAccess(ctx, session, scope); err != nil {
+		return nil, err
+	}
+	for _, w := range params.Body.Dashboard.Widgets {
+		if err := validateDashboardWidget(w); err != nil {
+			return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+		}
+	}
+	widgets, err := importDashboard(getDashboardsDir(), session.AccountAccessKey, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.DashboardWidgetList{Widgets: widgets}, nil
+}
+
+// validateDashboardWidgetsAccess verifies the session can see the dashboard, changing the global
+// widgets also requires the config update permission
+func validateDashboardWidgetsAccess(ctx context.Context, session *models.Principal, scope string) *models.Error {
+	if err := validateSessionAdminAction(ctx, session, iampolicy.ServerInfoAdminAction, "Dashboard not available."); err != nil {
+		return err
+	}
+	if scope == dashboardScopeGlobal {
+		return validateSessionAdminAction(ctx, session, iampolicy.ConfigUpdateAdminAction, "Global dashboard widgets can't be changed.")
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDashboardWidget(title, scope, expr string) *models.DashboardWidget {
+	return &models.DashboardWidget{
+		Title:   swag.String(title),
+		Type:    swag.String("graph"),
+		Scope:   scope,
+		Calcs:   []string{"last"},
+		Targets: []*models.DashboardWidgetTarget{{Expr: swag.String(expr), LegendFormat: "{{server}}", Step: 60}},
+	}
+}
+
+func TestDashboardWidgets(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	// Test-1: widgets get ids after the built-in ones, global widgets are listed first
+	requests, err := createDashboardWidget(dir, "alice", newTestDashboardWidget("Requests", "", `sum(minio_s3_requests_total{$__query})`))
+	assert.Nil(err)
+	assert.Equal(int32(customWidgetMinID), requests.ID)
+	assert.Equal(dashboardScopeUser, requests.Scope)
+	assert.Equal("alice", requests.Owner)
+	errs, err := createDashboardWidget(dir, "alice", newTestDashboardWidget("Errors", dashboardScopeUser, `sum(minio_s3_requests_errors_total{$__query})`))
+	assert.Nil(err)
+	capacity, err := createDashboardWidget(dir, "bob", newTestDashboardWidget("Capacity", dashboardScopeGlobal, `minio_cluster_capacity_usable_free_bytes{$__query}`))
+	assert.Nil(err)
+	assert.Equal(requests.ID+2, capacity.ID)
+
+	list, err := listDashboardWidgets(dir, "alice")
+	assert.Nil(err)
+	if assert.Len(list, 3) {
+		assert.Equal([]int32{capacity.ID, requests.ID, errs.ID}, []int32{list[0].ID, list[1].ID, list[2].ID})
+		assert.Equal([]int32{0, 0, 1}, []int32{list[0].Position, list[1].Position, list[2].Position})
+	}
+	list, err = listDashboardWidgets(dir, "bob")
+	assert.Nil(err)
+	assert.Len(list, 1)
+
+	// Test-2: update keeps the id and the scope
+	updated, err := updateDashboardWidget(dir, "alice", requests.ID, newTestDashboardWidget("GET requests", "", `sum(minio_s3_requests_total{$__query,api="getobject"})`))
+	assert.Nil(err)
+	assert.Equal(requests.ID, updated.ID)
+	assert.Equal("GET requests", *updated.Title)
+	_, err = updateDashboardWidget(dir, "alice", requests.ID, newTestDashboardWidget("GET requests", dashboardScopeGlobal, "up"))
+	assert.True(errors.Is(err, ErrDashboardWidgetScope))
+	_, err = updateDashboardWidget(dir, "bob", requests.ID, newTestDashboardWidget("GET requests", "", "up"))
+	assert.True(errors.Is(err, ErrDashboardWidgetNotFound))
+
+	// Test-3: order must list every widget of the scope
+	assert.Nil(orderDashboardWidgets(dir, "alice", dashboardScopeUser, []int32{errs.ID, requests.ID}))
+	list, err = listDashboardWidgets(dir, "alice")
+	assert.Nil(err)
+	if assert.Len(list, 3) {
+		assert.Equal([]int32{capacity.ID, errs.ID, requests.ID}, []int32{list[0].ID, list[1].ID, list[2].ID})
+	}
+	assert.True(errors.Is(orderDashboardWidgets(dir, "alice", dashboardScopeUser, []int32{errs.ID}), ErrDashboardWidgetsOrder))
+	assert.True(errors.Is(orderDashboardWidgets(dir, "alice", dashboardScopeUser, []int32{errs.ID, errs.ID}), ErrDashboardWidgetsOrder))
+
+	// Test-4: export and import on another user
+	export, err := exportDashboard(dir, "alice", dashboardScopeUser)
+	assert.Nil(err)
+	assert.Equal(int32(dashboardExportVersion), export.Version)
+	if assert.Len(export.Widgets, 2) {
+		assert.Equal(int32(0), export.Widgets[0].ID)
+		assert.Empty(export.Widgets[0].Owner)
+	}
+	imported, err := importDashboard(dir, "carol", &models.ImportDashboardRequest{Dashboard: export})
+	assert.Nil(err)
+	if assert.Len(imported, 2) {
+		assert.Equal(capacity.ID+1, imported[0].ID)
+		assert.Equal("Errors", *imported[0].Title)
+	}
+	imported, err = importDashboard(dir, "carol", &models.ImportDashboardRequest{Dashboard: export, Replace: true})
+	assert.Nil(err)
+	list, err = listDashboardWidgets(dir, "carol")
+	assert.Nil(err)
+	if assert.Len(list, 3) {
+		assert.Equal(imported[0].ID, list[1].ID)
+	}
+	export.Version = 2
+	_, err = importDashboard(dir, "carol", &models.ImportDashboardRequest{Dashboard: export})
+	assert.True(errors.Is(err, ErrDashboardVersion))
+
+	// Test-5: delete
+	assert.Nil(deleteDashboardWidget(dir, "alice", errs.ID))
+	assert.True(errors.Is(deleteDashboardWidget(dir, "alice", errs.ID), ErrDashboardWidgetNotFound))
+	list, err = listDashboardWidgets(dir, "alice")
+	assert.Nil(err)
+	assert.Len(list, 2)
+}
+
+func TestValidateDashboardWidget(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(PrometheusURL, "")
+
+	assert.Nil(validateDashboardWidget(newTestDashboardWidget("Requests", "", `histogram_quantile(0.9, minio_s3_ttfb_seconds_distribution)`)))
+	assert.Error(validateDashboardWidget(newTestDashboardWidget(" ", "", "up")))
+	w := newTestDashboardWidget("Requests", "", "up")
+	w.Targets = nil
+	assert.Error(validateDashboardWidget(w))
+
+	// the built-in collector only supports part of PromQL
+	globalMetricsCollector = &metricsCollector{store: newMetricsStore(time.Hour, 30*time.Second)}
+	defer func() {
+		globalMetricsCollector = nil
+	}()
+	assert.Nil(validateDashboardWidget(newTestDashboardWidget("Requests", "", `sum by (server) (rate(minio_s3_requests_total{$__query,server=~"$server"}[$__rate_interval]))`)))
+	assert.Error(validateDashboardWidget(newTestDashboardWidget("Requests", "", `histogram_quantile(0.9, minio_s3_ttfb_seconds_distribution)`)))
+}
+
+func TestCustomWidgetDetails(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	ctx := context.Background()
+
+	store := newMetricsStore(time.Hour, 30*time.Second)
+	now := time.Now().Add(-time.Minute)
+	scraped, err := parseMetricsText(strings.NewReader(fmt.Sprintf(testClusterMetrics, 1655990000, 1655995000, 100, 60, 10)))
+	assert.Nil(err)
+	for _, m := range scraped {
+		m.labels["job"] = "minio-job"
+	}
+	store.add(now, scraped)
+	collector := &metricsCollector{store: store}
+
+	w, err := createDashboardWidget(getDashboardsDir(), "alice", newTestDashboardWidget("Requests", "", `sum by (server) (minio_s3_requests_total{$__query})`))
+	assert.Nil(err)
+	// add an invalid target, it must not prevent the widget from rendering
+	w.Targets = append(w.Targets, &models.DashboardWidgetTarget{Expr: swag.String("histogram_quantile(0.9, x)")})
+	_, err = updateDashboardWidget(getDashboardsDir(), "alice", w.ID, w)
+	assert.Nil(err)
+
+	session := &models.Principal{AccountAccessKey: "alice"}
+	dashboard := getDashboardMetrics(session)
+	assert.Len(dashboard, len(widgets)+1)
+	summary := getWidgetsSummary(dashboard)
+	assert.Equal(w.ID, summary[len(summary)-1].ID)
+
+	step := int32(60)
+	details, mErr := getWidgetDetails(ctx, collector, `job="minio-job"`, dashboard, w.ID, &step, nil, nil)
+	assert.Nil(mErr)
+	if assert.NotNil(details) && assert.Len(details.Targets, 2) {
+		assert.Equal("Requests", details.Title)
+		assert.Equal([]string{"last"}, details.Options.ReduceOptions.Calcs)
+		var results int
+		for _, target := range details.Targets {
+			results += len(target.Result)
+		}
+		assert.Equal(2, results)
+	}
+
+	// other users don't see the widget
+	_, mErr = getWidgetDetails(ctx, collector, `job="minio-job"`, getDashboardMetrics(&models.Principal{AccountAccessKey: "bob"}), w.ID, &step, nil, nil)
+	assert.Equal(int32(404), mErr.Code)
+}
@@ -0,0 +1,554 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	policyApi "github.com/GuinsooLab/console/restapi/operations/policy"
+	policies "github.com/GuinsooLab/console/restapi/policy"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/madmin-go"
+	"github.com/minio/pkg/bucket/policy"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/minio/pkg/wildcard"
+)
+
+// inlinePolicyName is the name reported for the policy embedded in a service account
+const inlinePolicyName = "inline"
+
+func registerEffectivePermissionsHandlers(api *operations.ConsoleAPI) {
+	// effective permissions of every user, group and service account
+	api.PolicyEffectivePermissionsHandler = policyApi.EffectivePermissionsHandlerFunc(func(params policyApi.EffectivePermissionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getEffectivePermissionsResponse(session, params)
+		if err != nil {
+			return policyApi.NewEffectivePermissionsDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewEffectivePermissionsOK().WithPayload(resp)
+	})
+	// export the effective permissions as CSV
+	api.PolicyExportEffectivePermissionsHandler = policyApi.ExportEffectivePermissionsHandlerFunc(func(params policyApi.ExportEffectivePermissionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getEffectivePermissionsResponse(session, policyApi.EffectivePermissionsParams{
+			HTTPRequest: params.HTTPRequest,
+			EntityType:  params.EntityType,
+			Bucket:      params.Bucket,
+		})
+		if err != nil {
+			return policyApi.NewExportEffectivePermissionsDefault(int(err.Code)).WithPayload(err)
+		}
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			fileName := fmt.Sprintf("effective-permissions-%s.csv", time.Now().UTC().Format("20060102150405"))
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+			if err := writeEffectivePermissionsCSV(w, resp.Permissions); err != nil {
+				LogError("Unable to export the effective permissions: %v", err)
+			}
+		})
+	})
+}
+
+// namedPolicy is a policy attached to an entity, with its variables already replaced
+type namedPolicy struct {
+	name   string
+	policy *iampolicy.Policy
+}
+
+// permissionsResolver fetches the policies and groups once while the report is built
+type permissionsResolver struct {
+	client   MinioAdmin
+	policies map[string]*iampolicy.Policy
+	groups   map[string]*madmin.GroupDesc
+}
+
+func newPermissionsResolver(client MinioAdmin) *permissionsResolver {
+	return &permissionsResolver{client: client, policies: map[string]*iampolicy.Policy{}, groups: map[string]*madmin.GroupDesc{}}
+}
+
+// getPolicy returns a canned policy, nil when it doesn't exist
+func (r *permissionsResolver) getPolicy(ctx context.Context, name string) (*iampolicy.Policy, error) {
+	if p, ok := r.policies[name]; ok {
+		return p, nil
+	}
+	p, err := r.client.getPolicy(ctx, name)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchPolicy" {
+			return nil, err
+		}
+		p = nil
+	}
+	r.policies[name] = p
+	return p, nil
+}
+
+func (r *permissionsResolver) getGroup(ctx context.Context, name string) (*madmin.GroupDesc, error) {
+	if g, ok := r.groups[name]; ok {
+		return g, nil
+	}
+	g, err := r.client.getGroupDescription(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	r.groups[name] = g
+	return g, nil
+}
+
+// resolve returns the named comma separated policies with the variables of the account replaced,
+// the same way they are replaced for the session of the account
+func (r *permissionsResolver) resolve(ctx context.Context, names, account string) ([]namedPolicy, error) {
+	var resolved []namedPolicy
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		p, err := r.getPolicy(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil {
+			continue
+		}
+		if account != "" {
+			if p, err = replaceAccountVariables(p, account); err != nil {
+				return nil, err
+			}
+		}
+		resolved = append(resolved, namedPolicy{name: name, policy: p})
+	}
+	return resolved, nil
+}
+
+// userPolicies returns the policies of a user and of its enabled groups
+func (r *permissionsResolver) userPolicies(ctx context.Context, account string, user madmin.UserInfo) ([]namedPolicy, error) {
+	resolved, err := r.resolve(ctx, user.PolicyName, account)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range user.MemberOf {
+		desc, err := r.getGroup(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		if desc.Status == string(madmin.GroupDisabled) {
+			continue
+		}
+		groupPolicies, err := r.resolve(ctx, desc.Policy, account)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, groupPolicies...)
+	}
+	return resolved, nil
+}
+
+func replaceAccountVariables(p *iampolicy.Policy, account string) (*iampolicy.Policy, error) {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	raw = policies.ReplacePolicyVariables(map[string]interface{}{}, &madmin.AccountInfo{AccountName: account, Policy: raw})
+	return iampolicy.ParseConfig(bytes.NewReader(raw))
+}
+
+// permissionsLayer is a set of policies a request has to be allowed by, service accounts with their own
+// policy are limited by both the policies of their parent and their own policy
+type permissionsLayer []namedPolicy
+
+// unconditional returns the layer as a single policy where the allow statements apply whatever their
+// conditions and the conditional deny statements are left out, so reviews see the widest access
+func (layer permissionsLayer) unconditional(allowOnly bool) iampolicy.Policy {
+	merged := iampolicy.Policy{Version: iampolicy.DefaultVersion}
+	for _, p := range layer {
+		for _, statement := range p.policy.Statements {
+			if statement.Effect == policy.Deny && (allowOnly || len(statement.Conditions) > 0) {
+				continue
+			}
+			statement = statement.Clone()
+			statement.Conditions = nil
+			merged.Statements = append(merged.Statements, statement)
+		}
+	}
+	return merged
+}
+
+// actions returns the actions the layer allows on a resource pattern, with and without its deny
+// statements
+func (layer permissionsLayer) actions(pattern string, conditions map[string][]string) (allowed, allowedWithoutDeny iampolicy.ActionSet) {
+	bucket, object := parsePolicyResource(pattern)
+	allowed = layer.unconditional(false).IsAllowedActions(bucket, object, conditions)
+	allowedWithoutDeny = layer.unconditional(true).IsAllowedActions(bucket, object, conditions)
+	return allowed, allowedWithoutDeny
+}
+
+// adminActions returns the admin actions explicitly allowed by the layer, the actions allowed unless
+// denied such as creating service accounts are only reported when a statement grants them
+func (layer permissionsLayer) adminActions(conditions map[string][]string) (allowed, allowedWithoutDeny iampolicy.ActionSet) {
+	granted := iampolicy.NewActionSet()
+	for _, p := range layer {
+		for _, statement := range p.policy.Statements {
+			if statement.Effect == policy.Allow {
+				for action := range statement.Actions {
+					granted.Add(action)
+				}
+			}
+		}
+	}
+	filter := func(actions iampolicy.ActionSet) iampolicy.ActionSet {
+		set := iampolicy.NewActionSet()
+		for action := range actions {
+			if iampolicy.AdminAction(action).IsValid() && granted.Match(action) {
+				set.Add(action)
+			}
+		}
+		return set
+	}
+	allowed = filter(layer.unconditional(false).IsAllowedActions("", "", conditions))
+	allowedWithoutDeny = filter(layer.unconditional(true).IsAllowedActions("", "", conditions))
+	return allowed, allowedWithoutDeny
+}
+
+// effectivePermissionsOf returns a row per resource pattern found in the policies of an entity, with
+// the actions allowed on it once every layer and deny statement is applied
+func effectivePermissionsOf(entityType, entity, parentUser string, layers []permissionsLayer) []*models.EffectivePermission {
+	account := entity
+	if parentUser != "" {
+		account = parentUser
+	}
+	conditions := map[string][]string{"username": {account}, "userid": {account}}
+
+	patterns := map[string]bool{}
+	hasAdmin := false
+	for _, layer := range layers {
+		for _, p := range layer {
+			for _, statement := range p.policy.Statements {
+				for _, resource := range statement.Resources.ToSlice() {
+					patterns[resource.Pattern] = true
+				}
+				for action := range statement.Actions {
+					if strings.HasPrefix(string(action), "admin:") {
+						hasAdmin = true
+					}
+				}
+			}
+		}
+	}
+	sortedPatterns := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		sortedPatterns = append(sortedPatterns, pattern)
+	}
+	sort.Strings(sortedPatterns)
+
+	var rows []*models.EffectivePermission
+	newRow := func(scope, pattern string, evaluate func(layer permissionsLayer) (iampolicy.ActionSet, iampolicy.ActionSet), applies func(statement iampolicy.Statement) bool) {
+		var allowed, allowedWithoutDeny iampolicy.ActionSet
+		for i, layer := range layers {
+			layerAllowed, layerWithoutDeny := evaluate(layer)
+			if i == 0 {
+				allowed, allowedWithoutDeny = layerAllowed, layerWithoutDeny
+				continue
+			}
+			allowed = allowed.Intersection(layerAllowed)
+			allowedWithoutDeny = allowedWithoutDeny.Intersection(layerWithoutDeny)
+		}
+		denied := iampolicy.NewActionSet()
+		for action := range allowedWithoutDeny {
+			if !allowed.Match(action) {
+				denied.Add(action)
+			}
+		}
+		if len(allowed) == 0 && len(denied) == 0 {
+			return
+		}
+		row := &models.EffectivePermission{
+			EntityType:     entityType,
+			Entity:         entity,
+			ParentUser:     parentUser,
+			Scope:          scope,
+			Resource:       pattern,
+			AllowedActions: summarizeActions(allowed, len(denied) == 0),
+			DeniedActions:  summarizeActions(denied, true),
+			Policies:       []string{},
+		}
+		if scope != models.EffectivePermissionScopeAdmin {
+			row.Bucket, _ = parsePolicyResource(pattern)
+		}
+		for _, layer := range layers {
+			for _, p := range layer {
+				contributes := false
+				for _, statement := range p.policy.Statements {
+					if applies(statement) {
+						contributes = true
+						if len(statement.Conditions) > 0 {
+							row.Conditional = true
+						}
+					}
+				}
+				if contributes && !containsName(row.Policies, p.name) {
+					row.Policies = append(row.Policies, p.name)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if hasAdmin {
+		newRow(models.EffectivePermissionScopeAdmin, "", func(layer permissionsLayer) (iampolicy.ActionSet, iampolicy.ActionSet) {
+			return layer.adminActions(conditions)
+		}, func(statement iampolicy.Statement) bool {
+			for action := range statement.Actions {
+				if strings.HasPrefix(string(action), "admin:") {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	for _, pattern := range sortedPatterns {
+		pattern := pattern
+		scope := models.EffectivePermissionScopeObject
+		if !strings.Contains(pattern, "/") {
+			scope = models.EffectivePermissionScopeBucket
+		}
+		newRow(scope, pattern, func(layer permissionsLayer) (iampolicy.ActionSet, iampolicy.ActionSet) {
+			allowed, allowedWithoutDeny := layer.actions(pattern, conditions)
+			return s3Actions(allowed), s3Actions(allowedWithoutDeny)
+		}, func(statement iampolicy.Statement) bool {
+			return statement.Resources.Match(pattern, conditions)
+		})
+	}
+	return rows
+}
+
+func s3Actions(actions iampolicy.ActionSet) iampolicy.ActionSet {
+	set := iampolicy.NewActionSet()
+	for action := range actions {
+		if !iampolicy.AdminAction(action).IsValid() {
+			set.Add(action)
+		}
+	}
+	return set
+}
+
+// summarizeActions returns the sorted actions, when every S3 or admin action is included they are
+// reported as a single wildcard unless some of them are denied
+func summarizeActions(actions iampolicy.ActionSet, collapse bool) []string {
+	result := []string{}
+	var wildcards []string
+	for _, all := range []iampolicy.Action{iampolicy.AllActions, iampolicy.Action(iampolicy.AllAdminActions)} {
+		if _, ok := actions[all]; ok && collapse {
+			result = append(result, string(all))
+			wildcards = append(wildcards, strings.TrimSuffix(string(all), "*"))
+		}
+	}
+	for action := range actions {
+		if action == iampolicy.AllActions || action == iampolicy.Action(iampolicy.AllAdminActions) {
+			continue
+		}
+		collapsed := false
+		for _, prefix := range wildcards {
+			if strings.HasPrefix(string(action), prefix) {
+				collapsed = true
+			}
+		}
+		if !collapsed {
+			result = append(result, string(action))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// getEffectivePermissions builds the effective permissions report of the users, groups and service
+// accounts of the deployment, optionally limited to one kind of entity or to one bucket
+func getEffectivePermissions(ctx context.Context, client MinioAdmin, entityType, bucket string) (*models.EffectivePermissionsReport, error) {
+	resolver := newPermissionsResolver(client)
+	var permissions []*models.EffectivePermission
+
+	var users map[string]madmin.UserInfo
+	if entityType != models.EffectivePermissionEntityTypeGroup {
+		var err error
+		if users, err = client.listUsers(ctx); err != nil {
+			return nil, err
+		}
+	}
+	userNames := make([]string, 0, len(users))
+	for name := range users {
+		userNames = append(userNames, name)
+	}
+	sort.Strings(userNames)
+
+	if entityType == "" || entityType == models.EffectivePermissionEntityTypeUser {
+		for _, name := range userNames {
+			if users[name].Status == madmin.AccountDisabled {
+				continue
+			}
+			userPolicies, err := resolver.userPolicies(ctx, name, users[name])
+			if err != nil {
+				return nil, err
+			}
+			permissions = append(permissions, effectivePermissionsOf(models.EffectivePermissionEntityTypeUser, name, "", []permissionsLayer{userPolicies})...)
+		}
+	}
+
+	if entityType == "" || entityType == models.EffectivePermissionEntityTypeGroup {
+		groups, err := client.listGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(groups)
+		for _, group := range groups {
+			desc, err := resolver.getGroup(ctx, group)
+			if err != nil {
+				return nil, err
+			}
+			if desc.Status == string(madmin.GroupDisabled) {
+				continue
+			}
+			groupPolicies, err := resolver.resolve(ctx, desc.Policy, "")
+			if err != nil {
+				return nil, err
+			}
+			permissions = append(permissions, effectivePermissionsOf(models.EffectivePermissionEntityTypeGroup, group, "", []permissionsLayer{groupPolicies})...)
+		}
+	}
+
+	if entityType == "" || entityType == models.EffectivePermissionEntityTypeServiceAccount {
+		for _, name := range userNames {
+			if users[name].Status == madmin.AccountDisabled {
+				continue
+			}
+			accounts, err := client.listServiceAccounts(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			if len(accounts.Accounts) == 0 {
+				continue
+			}
+			parentPolicies, err := resolver.userPolicies(ctx, name, users[name])
+			if err != nil {
+				return nil, err
+			}
+			sort.Strings(accounts.Accounts)
+			for _, accessKey := range accounts.Accounts {
+				info, err := client.infoServiceAccount(ctx, accessKey)
+				if err != nil {
+					return nil, err
+				}
+				if info.AccountStatus == "off" {
+					continue
+				}
+				layers := []permissionsLayer{parentPolicies}
+				if !info.ImpliedPolicy && info.Policy != "" {
+					inline, err := iampolicy.ParseConfig(bytes.NewReader([]byte(info.Policy)))
+					if err != nil {
+						return nil, err
+					}
+					if inline, err = replaceAccountVariables(inline, name); err != nil {
+						return nil, err
+					}
+					layers = append(layers, permissionsLayer{{name: inlinePolicyName, policy: inline}})
+				}
+				permissions = append(permissions, effectivePermissionsOf(models.EffectivePermissionEntityTypeServiceAccount, accessKey, name, layers)...)
+			}
+		}
+	}
+
+	report := &models.EffectivePermissionsReport{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Permissions: []*models.EffectivePermission{},
+	}
+	for _, permission := range permissions {
+		if bucket != "" && (permission.Scope == models.EffectivePermissionScopeAdmin || !wildcard.Match(permission.Bucket, bucket)) {
+			continue
+		}
+		report.Permissions = append(report.Permissions, permission)
+	}
+	report.Total = int64(len(report.Permissions))
+	return report, nil
+}
+
+// writeEffectivePermissionsCSV writes a line per entity and resource, the actions and policies are
+// separated by spaces
+func writeEffectivePermissionsCSV(w io.Writer, permissions []*models.EffectivePermission) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"entity_type", "entity", "parent_user", "scope", "resource", "allowed_actions", "denied_actions", "policies", "conditional"}); err != nil {
+		return err
+	}
+	for _, p := range permissions {
+		record := []string{
+			p.EntityType,
+			p.Entity,
+			p.ParentUser,
+			p.Scope,
+			p.Resource,
+			strings.Join(p.AllowedActions, " "),
+			strings.Join(p.DeniedActions, " "),
+			strings.Join(p.Policies, " "),
+			strconv.FormatBool(p.Conditional),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func getEffectivePermissionsResponse(session *models.Principal, params policyApi.EffectivePermissionsParams) (*models.EffectivePermissionsReport, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	var entityType, bucket string
+	if params.EntityType != nil {
+		entityType = *params.EntityType
+	}
+	if params.Bucket != nil {
+		bucket = *params.Bucket
+	}
+	report, err := getEffectivePermissions(ctx, adminClient, entityType, bucket)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return report, nil
+}
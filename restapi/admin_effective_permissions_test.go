@@ -0,0 +1,151 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEffectivePermissions(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	policyDocs := map[string]string{
+		"readphotos": `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject","s3:ListBucket"],"Effect":"Allow","Resource":["arn:aws:s3:::photos/*"]}]}`,
+		"home":       `{"Version":"2012-10-17","Statement":[{"Action":["s3:*"],"Effect":"Allow","Resource":["arn:aws:s3:::home/${aws:username}/*"]}]}`,
+		"denyprivate": `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Deny","Resource":["arn:aws:s3:::photos/private/*"]},
+			{"Action":["s3:PutObject"],"Effect":"Allow","Resource":["arn:aws:s3:::photos/*"],"Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8"]}}}]}`,
+		"diagnostics": `{"Version":"2012-10-17","Statement":[{"Action":["admin:ServerInfo","admin:Profiling"],"Effect":"Allow"}]}`,
+	}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if p, ok := policyDocs[name]; ok {
+			return iampolicy.ParseConfig(bytes.NewReader([]byte(p)))
+		}
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"alice": {PolicyName: "readphotos,home", Status: madmin.AccountEnabled, MemberOf: []string{"editors"}},
+			"bob":   {PolicyName: "readphotos", Status: madmin.AccountDisabled},
+			"ops":   {PolicyName: "diagnostics,gone", Status: madmin.AccountEnabled},
+		}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"editors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupEnabled), Policy: "denyprivate"}, nil
+	}
+	minioListServiceAccountsMock = func(ctx context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		if user == "alice" {
+			return madmin.ListServiceAccountsResp{Accounts: []string{"alice-sa"}}, nil
+		}
+		return madmin.ListServiceAccountsResp{}, nil
+	}
+	minioInfoServiceAccountMock = func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "on",
+			Policy: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["arn:aws:s3:::home/${aws:username}/*"]}]}`}, nil
+	}
+	find := func(report *models.EffectivePermissionsReport, entity, resource string) *models.EffectivePermission {
+		for _, p := range report.Permissions {
+			if p.Entity == entity && p.Resource == resource {
+				return p
+			}
+		}
+		return nil
+	}
+
+	report, err := getEffectivePermissions(ctx, adminClientMock{}, "", "")
+	if !assert.Nil(err) {
+		return
+	}
+
+	// Test-1: policy variables are replaced and user and group policies combined
+	home := find(report, "alice", "home/alice/*")
+	if assert.NotNil(home) {
+		assert.Equal([]string{"s3:*"}, home.AllowedActions)
+		assert.Equal("home", home.Bucket)
+		assert.Equal(models.EffectivePermissionScopeObject, home.Scope)
+	}
+	photos := find(report, "alice", "photos/*")
+	if assert.NotNil(photos) {
+		assert.Equal([]string{"s3:GetObject", "s3:ListBucket", "s3:PutObject"}, photos.AllowedActions)
+		assert.Equal([]string{"readphotos", "denyprivate"}, photos.Policies)
+		assert.True(photos.Conditional)
+	}
+
+	// Test-2: deny statements remove the actions on the prefixes they cover
+	private := find(report, "alice", "photos/private/*")
+	if assert.NotNil(private) {
+		assert.Equal([]string{"s3:GetObject"}, private.DeniedActions)
+		assert.Equal([]string{"s3:ListBucket", "s3:PutObject"}, private.AllowedActions)
+	}
+
+	// Test-3: disabled users are skipped, admin actions reported apart
+	assert.Nil(find(report, "bob", "photos/*"))
+	admin := find(report, "ops", "")
+	if assert.NotNil(admin) {
+		assert.Equal(models.EffectivePermissionScopeAdmin, admin.Scope)
+		assert.Equal([]string{"admin:Profiling", "admin:ServerInfo"}, admin.AllowedActions)
+	}
+
+	// Test-4: groups are reported without variables, service accounts limited by their own policy
+	assert.NotNil(find(report, "editors", "photos/*"))
+	sa := find(report, "alice-sa", "home/alice/*")
+	if assert.NotNil(sa) {
+		assert.Equal("alice", sa.ParentUser)
+		assert.Equal([]string{"s3:GetObject"}, sa.AllowedActions)
+		assert.Equal([]string{"home", "inline"}, sa.Policies)
+	}
+	assert.Nil(find(report, "alice-sa", "photos/*"))
+
+	// Test-5: filters by kind of entity and bucket
+	report, err = getEffectivePermissions(ctx, adminClientMock{}, models.EffectivePermissionEntityTypeServiceAccount, "home")
+	assert.Nil(err)
+	if assert.Len(report.Permissions, 1) {
+		assert.Equal("alice-sa", report.Permissions[0].Entity)
+	}
+	assert.Equal(int64(1), report.Total)
+}
+
+func TestWriteEffectivePermissionsCSV(t *testing.T) {
+	assert := assert.New(t)
+	var buf bytes.Buffer
+	err := writeEffectivePermissionsCSV(&buf, []*models.EffectivePermission{{
+		EntityType:     models.EffectivePermissionEntityTypeUser,
+		Entity:         "alice",
+		Scope:          models.EffectivePermissionScopeObject,
+		Resource:       "photos/*",
+		AllowedActions: []string{"s3:GetObject", "s3:ListBucket"},
+		DeniedActions:  []string{},
+		Policies:       []string{"readphotos"},
+		Conditional:    true,
+	}})
+	assert.Nil(err)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal([]string{
+		"entity_type,entity,parent_user,scope,resource,allowed_actions,denied_actions,policies,conditional",
+		"user,alice,,object,photos/*,s3:GetObject s3:ListBucket,,readphotos,true",
+	}, lines)
+}
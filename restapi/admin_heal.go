@@ -106,64 +106,62 @@ type healOptions struct {
 	Prefix     string
 	ForceStart bool
 	ForceStop  bool
+	// ClientToken of a running heal to reattach to
+	ClientToken string
+	// Owner is the access key of the session starting or reattaching to the heal
+	Owner string
 	madmin.HealOpts
 }
 
-// startHeal starts healing of the servers based on heal options
+// startHeal starts healing of the servers based on heal options, the heal keeps running if the
+// websocket is closed and can be followed again using its client token
 func startHeal(ctx context.Context, conn WSConn, client MinioAdmin, hOpts *healOptions) error {
-	// Initialize heal
-	healStart, _, err := client.heal(ctx, hOpts.BucketName, hOpts.Prefix, hOpts.HealOpts, "", hOpts.ForceStart, hOpts.ForceStop)
+	clientToken := hOpts.ClientToken
+	if clientToken != "" {
+		// a completed heal only gets its summary
+		if job, err := getHealJobSummary(getHealJobsDir(), clientToken); err == nil && !globalHealJobs.running(clientToken) {
+			if job.Owner != hOpts.Owner {
+				return ErrHealJobNotFound
+			}
+			return writeHealStatus(conn, healStatusFromSummary(job))
+		}
+	} else {
+		// Initialize heal
+		healStart, _, err := client.heal(ctx, hOpts.BucketName, hOpts.Prefix, hOpts.HealOpts, "", hOpts.ForceStart, hOpts.ForceStop)
+		if err != nil {
+			LogError("error initializing healing: %v", err)
+			return err
+		}
+		if hOpts.ForceStop {
+			return nil
+		}
+		clientToken = healStart.ClientToken
+	}
+	job, updates, unsubscribe, err := globalHealJobs.start(client, *hOpts, clientToken)
 	if err != nil {
-		LogError("error initializing healing: %v", err)
 		return err
 	}
-	if hOpts.ForceStop {
-		return nil
-	}
-	clientToken := healStart.ClientToken
-	hs := healStatus{
-		HealthBeforeCols: make(map[col]int64),
-		HealthAfterCols:  make(map[col]int64),
-	}
+	defer unsubscribe()
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
-		default:
-			_, res, err := client.heal(ctx, hOpts.BucketName, hOpts.Prefix, hOpts.HealOpts, clientToken, hOpts.ForceStart, hOpts.ForceStop)
-			if err != nil {
-				LogError("error on heal: %v", err)
-				return err
+		case msg, ok := <-updates:
+			if !ok {
+				return job.result()
 			}
-
-			hs.writeStatus(&res, conn)
-
-			if res.Summary == "finished" {
-				return nil
-			}
-
-			if res.Summary == "stopped" {
-				return fmt.Errorf("heal had an errors - %s", res.FailureDetail)
+			// Send Message through websocket connection
+			if err := conn.writeMessage(websocket.TextMessage, msg); err != nil {
+				LogError("error writeMessage: %v", err)
+				return err
 			}
-
-			time.Sleep(time.Second)
 		}
 	}
 }
 
-func (h *healStatus) writeStatus(s *madmin.HealTaskStatus, conn WSConn) error {
-	// Update state
-	h.updateDuration(s)
-	for _, item := range s.Items {
-		err := h.updateStats(item)
-		if err != nil {
-			LogError("error on updateStats: %v", err)
-			return err
-		}
-	}
-
+func writeHealStatus(conn WSConn, hs healStatus) error {
 	// Serialize message to be sent
-	infoBytes, err := json.Marshal(h)
+	infoBytes, err := json.Marshal(hs)
 	if err != nil {
 		LogError("error on json.Marshal: %v", err)
 		return err
@@ -325,6 +323,7 @@ func getHealOptionsFromReq(req *http.Request) (*healOptions, error) {
 	hOptions.BucketName = strings.TrimSpace(string(matches[0][2]))
 	hOptions.Prefix = req.FormValue("prefix")
 	hOptions.HealOpts.ScanMode = transformScanStr(req.FormValue("scan"))
+	hOptions.ClientToken = req.FormValue("clientToken")
 
 	if req.FormValue("force-start") != "" {
 		boolVal, err := strconv.ParseBool(req.FormValue("force-start"))
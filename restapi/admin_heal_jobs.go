@@ -0,0 +1,401 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	healApi "github.com/GuinsooLab/console/restapi/operations/heal"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	// healPollInterval is how often the status of a running heal is requested to MinIO
+	healPollInterval = time.Second
+	// maxHealJobErrors limits the errors kept on a heal job summary
+	maxHealJobErrors = 100
+)
+
+// globalHealJobs keeps track of the heal sequences started or reattached from console
+var globalHealJobs = newHealJobRegistry()
+
+func registerHealJobsHandlers(api *operations.ConsoleAPI) {
+	// list heal jobs
+	api.HealListHealJobsHandler = healApi.ListHealJobsHandlerFunc(func(params healApi.ListHealJobsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListHealJobsResponse(session, params)
+		if err != nil {
+			return healApi.NewListHealJobsDefault(int(err.Code)).WithPayload(err)
+		}
+		return healApi.NewListHealJobsOK().WithPayload(resp)
+	})
+}
+
+// getHealJobsDir returns the directory holding the summaries of the completed heal jobs
+func getHealJobsDir() string {
+	return filepath.Join(getDataDir(), "heals")
+}
+
+// healJob polls a heal sequence until it is done, independently of the websockets following it
+type healJob struct {
+	mu          sync.Mutex
+	id          string
+	client      MinioAdmin
+	opts        healOptions
+	clientToken string
+	startTime   time.Time
+	endTime     time.Time
+	state       string
+	err         error
+	status      healStatus
+	// receivedStatus is set once MinIO reported the status of the heal sequence at least once
+	receivedStatus bool
+	errors         []string
+	// last serialized status, sent to the websockets attaching to the job
+	lastMessage []byte
+	subscribers map[chan []byte]struct{}
+}
+
+// healJobRegistry running heal jobs by id
+type healJobRegistry struct {
+	mu   sync.Mutex
+	jobs map[string]*healJob
+}
+
+func newHealJobRegistry() *healJobRegistry {
+	return &healJobRegistry{jobs: map[string]*healJob{}}
+}
+
+// start tracks the heal sequence identified by clientToken and subscribes to its updates, if the
+// sequence is already tracked the running job is returned when it was started by the same owner.
+// The subscription is made before the job starts polling so no update is missed.
+func (r *healJobRegistry) start(client MinioAdmin, opts healOptions, clientToken string) (*healJob, <-chan []byte, func(), error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := clientToken
+	if !isValidDataID(id) {
+		id = uuid.NewString()
+	}
+	if job, ok := r.jobs[id]; ok {
+		if job.opts.Owner != opts.Owner {
+			return nil, nil, nil, ErrHealJobNotFound
+		}
+		updates, unsubscribe := job.subscribe()
+		return job, updates, unsubscribe, nil
+	}
+	job := &healJob{
+		id:          id,
+		client:      client,
+		opts:        opts,
+		clientToken: clientToken,
+		startTime:   time.Now().UTC(),
+		state:       models.HealJobStatusRunning,
+		status: healStatus{
+			HealthBeforeCols: make(map[col]int64),
+			HealthAfterCols:  make(map[col]int64),
+		},
+		subscribers: map[chan []byte]struct{}{},
+	}
+	r.jobs[id] = job
+	updates, unsubscribe := job.subscribe()
+	go func() {
+		job.run()
+		r.mu.Lock()
+		delete(r.jobs, id)
+		r.mu.Unlock()
+	}()
+	return job, updates, unsubscribe, nil
+}
+
+// running returns whether the heal sequence identified by clientToken is being polled
+func (r *healJobRegistry) running(clientToken string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, ok := r.jobs[clientToken]
+	return ok
+}
+
+// list returns the summary of the running jobs
+func (r *healJobRegistry) list() []*models.HealJob {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	jobs := []*models.HealJob{}
+	for _, job := range r.jobs {
+		jobs = append(jobs, job.summary())
+	}
+	return jobs
+}
+
+// subscribe returns a channel receiving every status update of the job, the channel is closed once the job is done.
+// Status messages are cumulative so a slow subscriber only receives the most recent one.
+func (j *healJob) subscribe() (<-chan []byte, func()) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	ch := make(chan []byte, 1)
+	if j.lastMessage != nil {
+		ch <- j.lastMessage
+	}
+	if j.state != models.HealJobStatusRunning {
+		close(ch)
+		return ch, func() {}
+	}
+	j.subscribers[ch] = struct{}{}
+	return ch, func() {
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		delete(j.subscribers, ch)
+	}
+}
+
+// run polls the heal status until the sequence finishes, stops or fails
+func (j *healJob) run() {
+	ctx := context.Background()
+	for {
+		_, res, err := j.client.heal(ctx, j.opts.BucketName, j.opts.Prefix, j.opts.HealOpts, j.clientToken, false, false)
+		if err != nil {
+			LogError("error on heal: %v", err)
+			j.finish(models.HealJobStatusFailed, err.Error(), err)
+			return
+		}
+		j.update(&res)
+
+		if res.Summary == "finished" {
+			j.finish(models.HealJobStatusFinished, "", nil)
+			return
+		}
+		if res.Summary == "stopped" {
+			j.finish(models.HealJobStatusStopped, res.FailureDetail, fmt.Errorf("heal had an errors - %s", res.FailureDetail))
+			return
+		}
+		time.Sleep(healPollInterval)
+	}
+}
+
+// update accumulates the items of the heal status and notifies the subscribers
+func (j *healJob) update(s *madmin.HealTaskStatus) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.receivedStatus = true
+	j.status.updateDuration(s)
+	for _, item := range s.Items {
+		if err := j.status.updateStats(item); err != nil {
+			LogError("error on updateStats: %v", err)
+			j.addError(fmt.Sprintf("%s/%s: %v", item.Bucket, item.Object, err))
+		}
+	}
+	// Serialize message to be sent
+	infoBytes, err := json.Marshal(j.status)
+	if err != nil {
+		LogError("error on json.Marshal: %v", err)
+		return
+	}
+	j.lastMessage = infoBytes
+	for ch := range j.subscribers {
+		publishHealMessage(ch, infoBytes)
+	}
+}
+
+// finish closes the subscribers and persists the summary of the job
+func (j *healJob) finish(state, failureDetail string, err error) {
+	j.mu.Lock()
+	j.state = state
+	j.err = err
+	j.endTime = time.Now().UTC()
+	if failureDetail != "" {
+		j.addError(failureDetail)
+	}
+	for ch := range j.subscribers {
+		close(ch)
+		delete(j.subscribers, ch)
+	}
+	persist := j.receivedStatus
+	j.mu.Unlock()
+
+	// unknown client tokens never get a status, there is nothing worth keeping
+	if !persist {
+		return
+	}
+	if err := saveHealJobSummary(getHealJobsDir(), j.id, j.summary()); err != nil {
+		LogError("error saving heal job summary: %v", err)
+	}
+}
+
+// result returns the error the job ended with, if any
+func (j *healJob) result() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+func (j *healJob) addError(msg string) {
+	if len(j.errors) < maxHealJobErrors {
+		j.errors = append(j.errors, msg)
+	}
+}
+
+func (j *healJob) summary() *models.HealJob {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	job := &models.HealJob{
+		ClientToken:      j.clientToken,
+		Owner:            j.opts.Owner,
+		Bucket:           j.opts.BucketName,
+		Prefix:           j.opts.Prefix,
+		Status:           j.state,
+		StartTime:        j.startTime.Format(time.RFC3339),
+		BytesScanned:     j.status.BytesScanned,
+		ObjectsScanned:   j.status.ObjectsScanned,
+		ObjectsHealed:    j.status.ObjectsHealed,
+		ItemsScanned:     j.status.ItemsScanned,
+		ItemsHealed:      j.status.ItemsHealed,
+		HealthBeforeCols: healColsSummary(j.status.HealthBeforeCols),
+		HealthAfterCols:  healColsSummary(j.status.HealthAfterCols),
+		Errors:           append([]string{}, j.errors...),
+	}
+	if !j.endTime.IsZero() {
+		job.EndTime = j.endTime.Format(time.RFC3339)
+	}
+	if j.err != nil {
+		job.FailureDetail = j.err.Error()
+	}
+	return job
+}
+
+// publishHealMessage replaces any pending message of the subscriber with the latest status
+func publishHealMessage(ch chan []byte, msg []byte) {
+	select {
+	case ch <- msg:
+	default:
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+func healColsSummary(cols map[col]int64) map[string]int64 {
+	summary := map[string]int64{}
+	for c, count := range cols {
+		summary[string(c)] = count
+	}
+	return summary
+}
+
+// healStatusFromSummary rebuilds the websocket status message of a completed job
+func healStatusFromSummary(job *models.HealJob) healStatus {
+	hs := healStatus{
+		BytesScanned:     job.BytesScanned,
+		ObjectsScanned:   job.ObjectsScanned,
+		ItemsScanned:     job.ItemsScanned,
+		ObjectsHealed:    job.ObjectsHealed,
+		ItemsHealed:      job.ItemsHealed,
+		HealthBeforeCols: make(map[col]int64),
+		HealthAfterCols:  make(map[col]int64),
+	}
+	for c, count := range job.HealthBeforeCols {
+		hs.HealthBeforeCols[col(c)] = count
+	}
+	for c, count := range job.HealthAfterCols {
+		hs.HealthAfterCols[col(c)] = count
+	}
+	start, errStart := time.Parse(time.RFC3339, job.StartTime)
+	end, errEnd := time.Parse(time.RFC3339, job.EndTime)
+	if errStart == nil && errEnd == nil {
+		hs.HealDuration = end.Sub(start).Seconds()
+	}
+	return hs
+}
+
+func saveHealJobSummary(dir, id string, job *models.HealJob) error {
+	return writeDataFile(filepath.Join(dir, id+".json"), job)
+}
+
+// getHealJobSummary returns the summary of a completed job
+func getHealJobSummary(dir, clientToken string) (*models.HealJob, error) {
+	var job models.HealJob
+	if err := readDataFile(dir, clientToken, ".json", &job, ErrNotFound); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// listHealJobs returns the running jobs followed by the completed ones, most recent first
+func listHealJobs(dir string, registry *healJobRegistry) ([]*models.HealJob, error) {
+	jobs := registry.list()
+	running := map[string]bool{}
+	for _, job := range jobs {
+		running[job.ClientToken] = true
+	}
+	ids, err := listDataIDs(dir, ".json")
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		var job models.HealJob
+		if err = readDataFile(dir, id, ".json", &job, ErrNotFound); err != nil {
+			LogError("skipping invalid heal job summary %s: %v", id, err)
+			continue
+		}
+		// a reattached job may be running again
+		if running[job.ClientToken] {
+			continue
+		}
+		jobs = append(jobs, &job)
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		iRunning := jobs[i].Status == models.HealJobStatusRunning
+		jRunning := jobs[j].Status == models.HealJobStatusRunning
+		if iRunning != jRunning {
+			return iRunning
+		}
+		return jobs[i].StartTime > jobs[j].StartTime
+	})
+	return jobs, nil
+}
+
+func getListHealJobsResponse(session *models.Principal, params healApi.ListHealJobsParams) (*models.ListHealJobsResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateSessionAdminAction(ctx, session, iampolicy.HealAdminAction, "Heal jobs not available."); err != nil {
+		return nil, err
+	}
+	jobs, err := listHealJobs(getHealJobsDir(), globalHealJobs)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// only the owner of a job can reattach to it using its client token
+	for _, job := range jobs {
+		if job.Owner != session.AccountAccessKey {
+			job.ClientToken = ""
+		}
+	}
+	return &models.ListHealJobsResponse{Jobs: jobs}, nil
+}
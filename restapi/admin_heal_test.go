@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GuinsooLab/console/models"
 	"github.com/minio/madmin-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -41,6 +42,7 @@ func (ac adminClientMock) heal(ctx context.Context, bucket, prefix string, healO
 
 func TestHeal(t *testing.T) {
 	assert := assert.New(t)
+	t.Setenv(ConsoleDataDir, t.TempDir())
 
 	client := adminClientMock{}
 	mockWSConn := mockConn{}
@@ -278,3 +280,138 @@ func TestHeal(t *testing.T) {
 		assert.Equal("strconv.ParseBool: parsing \"nonbool\": invalid syntax", err.Error())
 	}
 }
+
+func TestHealJobs(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	client := adminClientMock{}
+	mockWSConn := mockConn{}
+
+	mockResultItem := madmin.HealResultItem{
+		Type:         madmin.HealItemObject,
+		SetCount:     1,
+		DiskCount:    4,
+		ParityBlocks: 2,
+		DataBlocks:   2,
+		Before: struct {
+			Drives []madmin.HealDriveInfo `json:"drives"`
+		}{
+			Drives: []madmin.HealDriveInfo{
+				{State: madmin.DriveStateOk},
+				{State: madmin.DriveStateOk},
+				{State: madmin.DriveStateOk},
+				{State: madmin.DriveStateMissing},
+			},
+		},
+		After: struct {
+			Drives []madmin.HealDriveInfo `json:"drives"`
+		}{
+			Drives: []madmin.HealDriveInfo{
+				{State: madmin.DriveStateOk},
+				{State: madmin.DriveStateOk},
+				{State: madmin.DriveStateOk},
+				{State: madmin.DriveStateOk},
+			},
+		},
+	}
+	// the heal sequence finishes once `finish` is closed
+	finish := make(chan struct{})
+	minioHealMock = func(ctx context.Context, bucket, prefix string, healOpts madmin.HealOpts, clientToken string,
+		forceStart, forceStop bool,
+	) (healStart madmin.HealStartSuccess, healTaskStatus madmin.HealTaskStatus, err error) {
+		if clientToken == "" {
+			return madmin.HealStartSuccess{ClientToken: "heal-token-1"}, healTaskStatus, nil
+		}
+		if clientToken != "heal-token-1" {
+			return healStart, healTaskStatus, errors.New("heal token not found")
+		}
+		healTaskStatus = madmin.HealTaskStatus{
+			StartTime: time.Now().UTC(),
+			Items:     []madmin.HealResultItem{mockResultItem},
+			Summary:   "running",
+		}
+		select {
+		case <-finish:
+			healTaskStatus.Summary = "finished"
+		default:
+		}
+		return healStart, healTaskStatus, nil
+	}
+	received := make(chan healStatus, 10)
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var hs healStatus
+		_ = json.Unmarshal(data, &hs)
+		received <- hs
+		return nil
+	}
+	opts := &healOptions{BucketName: "testbucket", Owner: "admin"}
+	other := &healOptions{BucketName: "testbucket", ClientToken: "heal-token-1", Owner: "other"}
+
+	// Test-1: the heal keeps running once the websocket is closed
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- startHeal(ctx, mockWSConn, client, opts) }()
+	hs := <-received
+	assert.Equal(int64(1), hs.ObjectsScanned)
+	cancel()
+	assert.Nil(<-done)
+	assert.True(globalHealJobs.running("heal-token-1"))
+
+	jobs, err := listHealJobs(getHealJobsDir(), globalHealJobs)
+	assert.Nil(err)
+	if assert.Len(jobs, 1) {
+		assert.Equal("heal-token-1", jobs[0].ClientToken)
+		assert.Equal("testbucket", jobs[0].Bucket)
+		assert.Equal(models.HealJobStatusRunning, jobs[0].Status)
+		assert.Empty(jobs[0].EndTime)
+		assert.Equal("admin", jobs[0].Owner)
+	}
+	// only the session that started the heal can reattach to it
+	assert.Equal(ErrHealJobNotFound, startHeal(context.Background(), mockWSConn, client, other))
+	assert.Empty(received)
+
+	// Test-2: reattach using the client token, the websocket gets the latest status first
+	// and the heal is not initialized again
+	for len(received) > 0 {
+		<-received
+	}
+	close(finish)
+	reattach := &healOptions{BucketName: "testbucket", ClientToken: "heal-token-1", Owner: "admin"}
+	assert.Nil(startHeal(context.Background(), mockWSConn, client, reattach))
+	assert.NotEmpty(received)
+	assert.False(globalHealJobs.running("heal-token-1"))
+
+	// Test-3: the summary of the completed heal is persisted
+	jobs, err = listHealJobs(getHealJobsDir(), globalHealJobs)
+	assert.Nil(err)
+	if assert.Len(jobs, 1) {
+		assert.Equal(models.HealJobStatusFinished, jobs[0].Status)
+		assert.NotEmpty(jobs[0].EndTime)
+		assert.Greater(jobs[0].ObjectsScanned, int64(1))
+		assert.Equal(jobs[0].ObjectsScanned, jobs[0].HealthAfterCols[string(colGreen)])
+	}
+
+	// Test-4: reattaching to a completed heal sends its summary
+	for len(received) > 0 {
+		<-received
+	}
+	assert.Nil(startHeal(context.Background(), mockWSConn, client, reattach))
+	if assert.Len(received, 1) {
+		hs = <-received
+		assert.Equal(jobs[0].ObjectsScanned, hs.ObjectsScanned)
+		assert.Equal(jobs[0].ObjectsScanned, hs.HealthAfterCols[colGreen])
+	}
+	assert.Equal(ErrHealJobNotFound, startHeal(context.Background(), mockWSConn, client, other))
+
+	// Test-5: reattaching to an unknown heal fails and leaves no summary behind
+	unknown := &healOptions{BucketName: "testbucket", ClientToken: "heal-token-2"}
+	if err := startHeal(context.Background(), mockWSConn, client, unknown); assert.Error(err) {
+		assert.Equal("heal token not found", err.Error())
+	}
+	_, err = getHealJobSummary(getHealJobsDir(), "heal-token-2")
+	assert.True(errors.Is(err, ErrNotFound))
+
+	// Test-6: client tokens can't be used to reach files outside the heals directory
+	_, err = getHealJobSummary(getHealJobsDir(), "../heal-token-1")
+	assert.True(errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,306 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/gzip"
+)
+
+const (
+	anonymizationKeyFile     = "key"
+	anonymizationMappingFile = "mapping.json"
+	anonymizationRedacted    = "*redacted*"
+)
+
+var (
+	// anonymizationMu serializes the updates of the mapping file
+	anonymizationMu sync.Mutex
+
+	// fields holding hostnames, IPs or endpoints
+	healthHostKeys = map[string]bool{"addr": true, "endpoint": true, "host": true, "hostname": true, "node": true}
+	// fields holding hardware identifiers
+	healthSerialKeys = map[string]bool{"serial": true, "serial_number": true, "serialNumber": true, "hostid": true, "hostId": true}
+	// config keys and environment variables holding secrets
+	healthSecretRegexp = regexp.MustCompile(`(?i)(secret|password|passwd|token|credential|private_key|client_key|api_key|access_key)`)
+	healthIPv4Regexp   = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+)
+
+// getAnonymizationDir returns the directory holding the anonymization key and mapping file,
+// it is never part of the reports so it must stay local
+func getAnonymizationDir() string {
+	return filepath.Join(getDataDir(), "anonymization")
+}
+
+// healthAnonymizer replaces hostnames, IPs, endpoints and serials of health reports with tokens and
+// strips the secrets. Tokens are an HMAC of the original value using a local key so the same host
+// gets the same token on every report.
+type healthAnonymizer struct {
+	dir string
+	key []byte
+	// mapping of every token to its original value, used to de-anonymise the answers about a report
+	mapping map[string]string
+	tokens  map[string]string
+}
+
+func newHealthAnonymizer(dir string) (*healthAnonymizer, error) {
+	anonymizationMu.Lock()
+	defer anonymizationMu.Unlock()
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	a := &healthAnonymizer{dir: dir, mapping: map[string]string{}, tokens: map[string]string{}}
+
+	keyHex, err := os.ReadFile(filepath.Join(dir, anonymizationKeyFile))
+	switch {
+	case err == nil:
+		if a.key, err = hex.DecodeString(strings.TrimSpace(string(keyHex))); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, os.ErrNotExist):
+		a.key = make([]byte, 32)
+		if _, err = rand.Read(a.key); err != nil {
+			return nil, err
+		}
+		if err = os.WriteFile(filepath.Join(dir, anonymizationKeyFile), []byte(hex.EncodeToString(a.key)), 0o600); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if a.mapping, err = readAnonymizationMapping(dir); err != nil {
+		return nil, err
+	}
+	for token, value := range a.mapping {
+		a.tokens[value] = token
+	}
+	return a, nil
+}
+
+func readAnonymizationMapping(dir string) (map[string]string, error) {
+	mapping := map[string]string{}
+	b, err := os.ReadFile(filepath.Join(dir, anonymizationMappingFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return mapping, nil
+		}
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &mapping); err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// token returns the token of a value, `kind` tells what the token stands for
+func (a *healthAnonymizer) token(kind, value string) string {
+	if t, ok := a.tokens[value]; ok {
+		return t
+	}
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(value))
+	t := kind + "-" + hex.EncodeToString(mac.Sum(nil))[:12]
+	a.tokens[value] = t
+	a.mapping[t] = value
+	return t
+}
+
+// addHost registers the host of an endpoint, URL or address, ports are kept as they are
+func (a *healthAnonymizer) addHost(value string, hosts map[string]string) {
+	host := value
+	if u, err := url.Parse(value); err == nil && u.Host != "" {
+		host = u.Host
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.Trim(host, "[]")
+	if host == "" {
+		return
+	}
+	kind := "host"
+	if net.ParseIP(host) != nil {
+		kind = "ip"
+	}
+	hosts[host] = a.token(kind, host)
+}
+
+// collect walks the report registering the hosts and IPs found
+func (a *healthAnonymizer) collect(v interface{}, hosts map[string]string) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, value := range t {
+			if s, ok := value.(string); ok && healthHostKeys[k] {
+				a.addHost(s, hosts)
+			}
+			a.collect(value, hosts)
+		}
+	case []interface{}:
+		for _, value := range t {
+			a.collect(value, hosts)
+		}
+	case string:
+		for _, ip := range healthIPv4Regexp.FindAllString(t, -1) {
+			if net.ParseIP(ip) != nil {
+				hosts[ip] = a.token("ip", ip)
+			}
+		}
+	}
+}
+
+// replace returns a copy of the report with the hosts replaced on every key and value,
+// secrets redacted and serials replaced by their token
+func (a *healthAnonymizer) replace(v interface{}, replacer *strings.Replacer) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		// config key-values come as {"key","value"} objects
+		secretKV := false
+		if k, ok := t["key"].(string); ok && healthSecretRegexp.MatchString(k) {
+			secretKV = true
+		}
+		for k, value := range t {
+			s, isString := value.(string)
+			switch {
+			case isString && s != "" && (healthSecretRegexp.MatchString(k) || (secretKV && k == "value")):
+				out[replacer.Replace(k)] = anonymizationRedacted
+			case isString && s != "" && healthSerialKeys[k]:
+				out[k] = a.token("serial", s)
+			default:
+				out[replacer.Replace(k)] = a.replace(value, replacer)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, value := range t {
+			out[i] = a.replace(value, replacer)
+		}
+		return out
+	case string:
+		return replacer.Replace(t)
+	default:
+		return t
+	}
+}
+
+// anonymize returns an anonymized copy of a health report
+func (a *healthAnonymizer) anonymize(healthInfo interface{}) (interface{}, error) {
+	b, err := json.Marshal(healthInfo)
+	if err != nil {
+		return nil, err
+	}
+	var report interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	// keep large counters as they are
+	dec.UseNumber()
+	if err = dec.Decode(&report); err != nil {
+		return nil, err
+	}
+
+	hosts := map[string]string{}
+	a.collect(report, hosts)
+	originals := make([]string, 0, len(hosts))
+	for original := range hosts {
+		originals = append(originals, original)
+	}
+	// longest first so `node10` isn't replaced as `node1` followed by `0`
+	sort.Slice(originals, func(i, j int) bool {
+		if len(originals[i]) != len(originals[j]) {
+			return len(originals[i]) > len(originals[j])
+		}
+		return originals[i] < originals[j]
+	})
+	pairs := make([]string, 0, 2*len(originals))
+	for _, original := range originals {
+		pairs = append(pairs, original, hosts[original])
+	}
+	return a.replace(report, strings.NewReplacer(pairs...)), nil
+}
+
+// save merges the tokens into the mapping file
+func (a *healthAnonymizer) save() error {
+	anonymizationMu.Lock()
+	defer anonymizationMu.Unlock()
+	mapping, err := readAnonymizationMapping(a.dir)
+	if err != nil {
+		return err
+	}
+	for token, value := range a.mapping {
+		mapping[token] = value
+	}
+	return writeDataFile(filepath.Join(a.dir, anonymizationMappingFile), mapping)
+}
+
+// anonymizeHealthInfo anonymizes a health report recording the tokens on the local mapping file
+func anonymizeHealthInfo(healthInfo interface{}) (interface{}, error) {
+	anonymizer, err := newHealthAnonymizer(getAnonymizationDir())
+	if err != nil {
+		return nil, err
+	}
+	anonymized, err := anonymizer.anonymize(healthInfo)
+	if err != nil {
+		return nil, err
+	}
+	if err = anonymizer.save(); err != nil {
+		return nil, err
+	}
+	return anonymized, nil
+}
+
+// anonymizeHealthReport anonymizes a compressed health report as written by tarGZ
+func anonymizeHealthReport(r io.Reader) ([]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(gz)
+	dec.UseNumber()
+	var header struct {
+		Version string `json:"version"`
+	}
+	if err = dec.Decode(&header); err != nil {
+		return nil, err
+	}
+	var healthInfo interface{}
+	if err = dec.Decode(&healthInfo); err != nil {
+		return nil, err
+	}
+	anonymized, err := anonymizeHealthInfo(healthInfo)
+	if err != nil {
+		return nil, err
+	}
+	return tarGZ(anonymized, header.Version)
+}
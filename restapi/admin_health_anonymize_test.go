@@ -0,0 +1,144 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnonymizationTestHealthInfo() madmin.HealthInfo {
+	var config interface{}
+	_ = json.Unmarshal([]byte(`{
+		"identity_openid": {"_": [{"key": "client_id", "value": "console"}, {"key": "client_secret", "value": "s3cr3t"}]},
+		"region": {"_": [{"key": "name", "value": "us-east-1"}]}
+	}`), &config)
+	return madmin.HealthInfo{
+		Version: madmin.HealthInfoVersion,
+		Minio: madmin.MinioHealthInfo{
+			Config: madmin.MinioConfig{Config: config},
+			Info: madmin.MinioInfo{
+				DeploymentID: "deployment-1",
+				Servers: []madmin.ServerInfo{
+					{
+						Endpoint: "node1.example.com:9000",
+						State:    "online",
+						Network:  map[string]string{"node1.example.com:9000": "online", "node10.example.com:9000": "online"},
+						Drives: []madmin.Disk{
+							{Endpoint: "http://node1.example.com:9000/data1", State: "ok"},
+						},
+						MinioEnvVars: map[string]string{"MINIO_ROOT_PASSWORD": "minio123", "MINIO_VOLUMES": "/data1"},
+					},
+					{
+						Endpoint: "node10.example.com:9000",
+						State:    "offline",
+					},
+				},
+			},
+		},
+		Sys: madmin.SysInfo{
+			SysErrs: []madmin.SysErrors{{NodeCommon: madmin.NodeCommon{Addr: "10.0.0.1:9000", Error: "unable to reach 10.0.0.1"}}},
+		},
+	}
+}
+
+func TestHealthAnonymizer(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	anonymizer, err := newHealthAnonymizer(dir)
+	assert.Nil(err)
+	anonymized, err := anonymizer.anonymize(newAnonymizationTestHealthInfo())
+	assert.Nil(err)
+	b, err := json.Marshal(anonymized)
+	assert.Nil(err)
+	report := string(b)
+
+	// Test-1: hostnames, IPs and secrets are not part of the report anymore
+	for _, value := range []string{"node1.example.com", "node10.example.com", "10.0.0.1", "s3cr3t", "minio123"} {
+		assert.NotContains(report, value)
+	}
+	// non sensitive values are kept
+	for _, value := range []string{"console", "us-east-1", "deployment-1", "/data1", ":9000"} {
+		assert.Contains(report, value)
+	}
+
+	// Test-2: hosts get the same token everywhere, ports and paths are kept
+	var info madmin.HealthInfo
+	assert.Nil(json.Unmarshal(b, &info))
+	node1 := anonymizer.tokens["node1.example.com"]
+	node10 := anonymizer.tokens["node10.example.com"]
+	assert.True(strings.HasPrefix(node1, "host-"))
+	assert.NotEqual(node1, node10)
+	assert.Equal(node1+":9000", info.Minio.Info.Servers[0].Endpoint)
+	assert.Equal(node10+":9000", info.Minio.Info.Servers[1].Endpoint)
+	assert.Equal("http://"+node1+":9000/data1", info.Minio.Info.Servers[0].Drives[0].Endpoint)
+	assert.Equal("online", info.Minio.Info.Servers[0].Network[node10+":9000"])
+	ip := anonymizer.tokens["10.0.0.1"]
+	assert.True(strings.HasPrefix(ip, "ip-"))
+	assert.Equal("unable to reach "+ip, info.Sys.SysErrs[0].Error)
+	assert.Equal(anonymizationRedacted, info.Minio.Info.Servers[0].MinioEnvVars["MINIO_ROOT_PASSWORD"])
+
+	// Test-3: the mapping file de-anonymises the tokens
+	assert.Nil(anonymizer.save())
+	mapping, err := readAnonymizationMapping(dir)
+	assert.Nil(err)
+	assert.Equal("node1.example.com", mapping[node1])
+	assert.Equal("10.0.0.1", mapping[ip])
+	assert.NotContains(mapping, "s3cr3t")
+
+	// Test-4: the key is kept so tokens are consistent across reports
+	other, err := newHealthAnonymizer(dir)
+	assert.Nil(err)
+	hosts := map[string]string{}
+	other.addHost("https://node1.example.com:9000", hosts)
+	assert.Equal(map[string]string{"node1.example.com": node1}, hosts)
+	other.tokens = map[string]string{}
+	assert.Equal(node1, other.token("host", "node1.example.com"))
+}
+
+func TestAnonymizeHealthReport(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleDataDir, t.TempDir())
+
+	compressedDiag, err := tarGZ(newAnonymizationTestHealthInfo(), madmin.HealthInfoVersion)
+	assert.Nil(err)
+	anonymized, err := anonymizeHealthReport(bytes.NewReader(compressedDiag))
+	assert.Nil(err)
+
+	gz, err := gzip.NewReader(bytes.NewReader(anonymized))
+	assert.Nil(err)
+	dec := json.NewDecoder(gz)
+	var header struct {
+		Version string `json:"version"`
+	}
+	assert.Nil(dec.Decode(&header))
+	assert.Equal(madmin.HealthInfoVersion, header.Version)
+	var info madmin.HealthInfo
+	assert.Nil(dec.Decode(&info))
+	assert.NotContains(info.Minio.Info.Servers[0].Endpoint, "node1.example.com")
+
+	mapping, err := readAnonymizationMapping(getAnonymizationDir())
+	assert.Nil(err)
+	assert.Contains(mapping, strings.TrimSuffix(info.Minio.Info.Servers[0].Endpoint, ":9000"))
+}
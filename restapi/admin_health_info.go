@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/klauspost/compress/gzip"
@@ -31,35 +32,47 @@ import (
 	"github.com/minio/madmin-go"
 )
 
+// healthInfoDataTypes is the health data collected from the servers
+var healthInfoDataTypes = []madmin.HealthDataType{
+	madmin.HealthDataTypePerfDrive,
+	madmin.HealthDataTypePerfNet,
+	madmin.HealthDataTypeMinioInfo,
+	madmin.HealthDataTypeMinioConfig,
+	madmin.HealthDataTypeSysCPU,
+	madmin.HealthDataTypeSysDriveHw,
+	madmin.HealthDataTypeSysDocker,
+	madmin.HealthDataTypeSysOsInfo,
+	madmin.HealthDataTypeSysLoad,
+	madmin.HealthDataTypeSysMem,
+	madmin.HealthDataTypeSysNet,
+	madmin.HealthDataTypeSysProcess,
+}
+
+// healthInfoRequest options of a health info collection
+type healthInfoRequest struct {
+	deadline  time.Duration
+	anonymize bool
+}
+
 // startHealthInfo starts fetching mc.ServerHealthInfo and
-// sends messages with the corresponding data on the websocket connection
-func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadline *time.Duration) error {
+// sends messages with the corresponding data on the websocket connection,
+// the report is anonymized first if requested
+func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadline *time.Duration, anonymize bool) error {
 	if deadline == nil {
 		return errors.New("duration can't be nil on startHealthInfo")
 	}
 
-	// Fetch info of all servers (cluster or single server)
-	healthDataTypes := []madmin.HealthDataType{
-		madmin.HealthDataTypePerfDrive,
-		madmin.HealthDataTypePerfNet,
-		madmin.HealthDataTypeMinioInfo,
-		madmin.HealthDataTypeMinioConfig,
-		madmin.HealthDataTypeSysCPU,
-		madmin.HealthDataTypeSysDriveHw,
-		madmin.HealthDataTypeSysDocker,
-		madmin.HealthDataTypeSysOsInfo,
-		madmin.HealthDataTypeSysLoad,
-		madmin.HealthDataTypeSysMem,
-		madmin.HealthDataTypeSysNet,
-		madmin.HealthDataTypeSysProcess,
-	}
-
 	var err error
 	// Fetch info of all servers (cluster or single server)
-	healthInfo, version, err := client.serverHealthInfo(ctx, healthDataTypes, *deadline)
+	healthInfo, version, err := client.serverHealthInfo(ctx, healthInfoDataTypes, *deadline)
 	if err != nil {
 		return err
 	}
+	if anonymize {
+		if healthInfo, err = anonymizeHealthInfo(healthInfo); err != nil {
+			return err
+		}
+	}
 
 	compressedDiag, err := tarGZ(healthInfo, version)
 	if err != nil {
@@ -110,12 +123,18 @@ func tarGZ(healthInfo interface{}, version string) ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
-// getHealthInfoOptionsFromReq gets duration for startHealthInfo request
-// path come as : `/health-info?deadline=2h`
-func getHealthInfoOptionsFromReq(req *http.Request) (*time.Duration, error) {
+// getHealthInfoOptionsFromReq gets duration and anonymization for startHealthInfo request
+// path come as : `/health-info?deadline=2h&anonymize=true`
+func getHealthInfoOptionsFromReq(req *http.Request) (*healthInfoRequest, error) {
 	deadlineDuration, err := time.ParseDuration(req.FormValue("deadline"))
 	if err != nil {
 		return nil, err
 	}
-	return &deadlineDuration, nil
+	opts := &healthInfoRequest{deadline: deadlineDuration}
+	if req.FormValue("anonymize") != "" {
+		if opts.anonymize, err = strconv.ParseBool(req.FormValue("anonymize")); err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
 }
@@ -149,7 +149,7 @@ func Test_serverHealthInfo(t *testing.T) {
 				return info, madmin.HealthInfoVersion, nil
 			}
 			connWriteMessageMock = tt.args.wsWriteMock
-			err := startHealthInfo(ctx, mockWSConn, client, &deadlineDuration)
+			err := startHealthInfo(ctx, mockWSConn, client, &deadlineDuration, false)
 			// close test mock channel
 			close(testReceiver)
 			// check that the TestReceiver got the same number of data from Console.
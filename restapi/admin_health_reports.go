@@ -0,0 +1,477 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	systemApi "github.com/GuinsooLab/console/restapi/operations/system"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/klauspost/compress/gzip"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	healthReportExt = ".json.gz"
+	// healthReportsPrefix is the prefix of the reports uploaded to the health info bucket
+	healthReportsPrefix = "health-reports/"
+)
+
+func registerHealthReportsHandlers(api *operations.ConsoleAPI) {
+	// list health reports
+	api.SystemListHealthReportsHandler = systemApi.ListHealthReportsHandlerFunc(func(params systemApi.ListHealthReportsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListHealthReportsResponse(session, params)
+		if err != nil {
+			return systemApi.NewListHealthReportsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewListHealthReportsOK().WithPayload(resp)
+	})
+	// download health report
+	api.SystemDownloadHealthReportHandler = systemApi.DownloadHealthReportHandlerFunc(func(params systemApi.DownloadHealthReportParams, session *models.Principal) middleware.Responder {
+		file, err := getDownloadHealthReportResponse(session, params)
+		if err != nil {
+			return systemApi.NewDownloadHealthReportDefault(int(err.Code)).WithPayload(err)
+		}
+		return middleware.ResponderFunc(processDownloadHealthReportResponse(params.ID, file))
+	})
+	// diff health reports
+	api.SystemDiffHealthReportsHandler = systemApi.DiffHealthReportsHandlerFunc(func(params systemApi.DiffHealthReportsParams, session *models.Principal) middleware.Responder {
+		resp, err := getDiffHealthReportsResponse(session, params)
+		if err != nil {
+			return systemApi.NewDiffHealthReportsDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewDiffHealthReportsOK().WithPayload(resp)
+	})
+}
+
+// healthInfoScheduler collects a health report every `interval` and archives it
+type healthInfoScheduler struct {
+	interval   time.Duration
+	deadline   time.Duration
+	dir        string
+	bucket     string
+	retention  time.Duration
+	maxReports int
+	// anonymize the reports uploaded to the bucket, the local copy is kept as is
+	anonymize bool
+	admin     MinioAdmin
+	// s3 is only set when the reports are also uploaded to a bucket
+	s3 MinioClient
+}
+
+// startHealthInfoScheduler starts the scheduled health info collections if configured, the
+// collections run with their own credentials since there is no user session to use
+func startHealthInfoScheduler(ctx context.Context) {
+	interval := getHealthInfoSchedule()
+	if interval == 0 {
+		return
+	}
+	accessKey, secretKey := getHealthInfoCredentials()
+	if accessKey == "" || secretKey == "" {
+		LogError("scheduled health info disabled, %s and %s are required", ConsoleHealthInfoAccessKey, ConsoleHealthInfoSecretKey)
+		return
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		LogError("scheduled health info disabled: %v", err)
+		return
+	}
+	mAdmin.SetCustomTransport(GetConsoleHTTPClient().Transport)
+	scheduler := &healthInfoScheduler{
+		interval:   interval,
+		deadline:   getHealthInfoDeadline(),
+		dir:        getHealthReportsDir(),
+		bucket:     getHealthInfoBucket(),
+		retention:  getHealthInfoRetention(),
+		maxReports: getHealthInfoMaxReports(),
+		anonymize:  getHealthInfoAnonymize(),
+		admin:      AdminClient{Client: mAdmin},
+	}
+	if scheduler.bucket != "" {
+		mClient, err := minio.New(getMinIOEndpoint(), &minio.Options{
+			Creds:     credentials.NewStaticV4(accessKey, secretKey, ""),
+			Secure:    getMinIOEndpointIsSecure(),
+			Transport: GetConsoleHTTPClient().Transport,
+		})
+		if err != nil {
+			LogError("scheduled health info disabled: %v", err)
+			return
+		}
+		scheduler.s3 = minioClient{client: mClient}
+	}
+	go scheduler.run(ctx)
+}
+
+func (s *healthInfoScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.collect(ctx); err != nil {
+				LogError("error collecting scheduled health report: %v", err)
+			}
+		}
+	}
+}
+
+// collect archives a new health report and applies the retention policy, a failed upload to the
+// bucket is logged but the local report is kept
+func (s *healthInfoScheduler) collect(ctx context.Context) (*models.HealthReport, error) {
+	healthInfo, version, err := s.admin.serverHealthInfo(ctx, healthInfoDataTypes, s.deadline)
+	if err != nil {
+		return nil, err
+	}
+	compressedDiag, err := tarGZ(healthInfo, version)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	report, err := saveHealthReport(s.dir, now, compressedDiag)
+	if err != nil {
+		return nil, err
+	}
+	if s.s3 != nil {
+		if err = s.upload(ctx, report.ID, compressedDiag); err != nil {
+			LogError("error uploading health report %s to %s: %v", report.ID, s.bucket, err)
+		}
+	}
+	if err = pruneHealthReports(s.dir, now, s.retention, s.maxReports); err != nil {
+		LogError("error applying health reports retention: %v", err)
+	}
+	return report, nil
+}
+
+func (s *healthInfoScheduler) upload(ctx context.Context, id string, compressedDiag []byte) error {
+	var err error
+	if s.anonymize {
+		if compressedDiag, err = anonymizeHealthReport(bytes.NewReader(compressedDiag)); err != nil {
+			return err
+		}
+	}
+	objectName := healthReportsPrefix + id + healthReportExt
+	_, err = s.s3.putObject(ctx, s.bucket, objectName, bytes.NewReader(compressedDiag), int64(len(compressedDiag)), minio.PutObjectOptions{ContentType: "application/gzip"})
+	return err
+}
+
+// saveHealthReport archives a compressed health report as returned by tarGZ
+func saveHealthReport(dir string, now time.Time, compressedDiag []byte) (*models.HealthReport, error) {
+	id := newDataID(now)
+	if err := writeDataFileBytes(filepath.Join(dir, id+healthReportExt), compressedDiag); err != nil {
+		return nil, err
+	}
+	return &models.HealthReport{
+		ID:   id,
+		Time: now.Format(time.RFC3339),
+		Size: int64(len(compressedDiag)),
+	}, nil
+}
+
+// listHealthReports returns the archived reports, most recent first
+func listHealthReports(dir string) ([]*models.HealthReport, error) {
+	ids, err := listDataIDs(dir, healthReportExt)
+	if err != nil {
+		return nil, err
+	}
+	reports := []*models.HealthReport{}
+	for _, id := range ids {
+		t, err := time.Parse(dataIDTimeFormat, strings.Split(id, "-")[0])
+		if err != nil {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(dir, id+healthReportExt))
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, &models.HealthReport{
+			ID:   id,
+			Time: t.Format(time.RFC3339),
+			Size: info.Size(),
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].ID > reports[j].ID
+	})
+	return reports, nil
+}
+
+// pruneHealthReports removes the reports older than `retention` and the ones exceeding `maxReports`,
+// a zero value disables the corresponding limit
+func pruneHealthReports(dir string, now time.Time, retention time.Duration, maxReports int) error {
+	reports, err := listHealthReports(dir)
+	if err != nil {
+		return err
+	}
+	for i, report := range reports {
+		expired := false
+		if retention > 0 {
+			t, err := time.Parse(time.RFC3339, report.Time)
+			expired = err == nil && now.Sub(t) > retention
+		}
+		if !expired && (maxReports == 0 || i < maxReports) {
+			continue
+		}
+		if err = removeDataFile(dir, report.ID, healthReportExt, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func openHealthReport(dir, id string) (*os.File, error) {
+	return openDataFile(dir, id, healthReportExt, ErrHealthReportNotFound)
+}
+
+// readHealthReport decodes an archived report, only reports of the current health info version are supported
+func readHealthReport(dir, id string) (*madmin.HealthInfo, error) {
+	file, err := openHealthReport(dir, id)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	dec := json.NewDecoder(gz)
+	var header struct {
+		Version string `json:"version"`
+	}
+	if err = dec.Decode(&header); err != nil {
+		return nil, err
+	}
+	if header.Version != madmin.HealthInfoVersion {
+		return nil, ErrHealthReportVersion
+	}
+	var info madmin.HealthInfo
+	if err = dec.Decode(&info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// diffHealthReports lists the server, drive and config differences between two reports
+func diffHealthReports(base, target *madmin.HealthInfo) []*models.HealthReportChange {
+	changes := []*models.HealthReportChange{}
+
+	type fields map[string]string
+	// diff compares the fields of every item, fields are compared in the given order
+	diff := func(category string, before, after map[string]fields, fieldOrder []string) {
+		items := map[string]bool{}
+		for item := range before {
+			items[item] = true
+		}
+		for item := range after {
+			items[item] = true
+		}
+		sorted := make([]string, 0, len(items))
+		for item := range items {
+			sorted = append(sorted, item)
+		}
+		sort.Strings(sorted)
+		for _, item := range sorted {
+			b, inBase := before[item]
+			a, inTarget := after[item]
+			switch {
+			case !inTarget:
+				changes = append(changes, &models.HealthReportChange{Category: category, Item: item, Status: models.HealthReportChangeStatusRemoved})
+			case !inBase:
+				changes = append(changes, &models.HealthReportChange{Category: category, Item: item, Status: models.HealthReportChangeStatusAdded})
+			default:
+				for _, field := range fieldOrder {
+					if b[field] != a[field] {
+						changes = append(changes, &models.HealthReportChange{
+							Category: category,
+							Item:     item,
+							Field:    field,
+							Before:   b[field],
+							After:    a[field],
+							Status:   models.HealthReportChangeStatusChanged,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	servers := func(info *madmin.HealthInfo) map[string]fields {
+		result := map[string]fields{}
+		for _, server := range info.Minio.Info.Servers {
+			result[server.Endpoint] = fields{"state": server.State, "version": server.Version}
+		}
+		return result
+	}
+	diff(models.HealthReportChangeCategoryServer, servers(base), servers(target), []string{"state", "version"})
+
+	drives := func(info *madmin.HealthInfo) map[string]fields {
+		result := map[string]fields{}
+		for _, server := range info.Minio.Info.Servers {
+			for _, disk := range server.Drives {
+				endpoint := disk.Endpoint
+				if endpoint == "" {
+					endpoint = server.Endpoint + disk.DrivePath
+				}
+				result[endpoint] = fields{"state": disk.State, "healing": strconv.FormatBool(disk.Healing), "uuid": disk.UUID}
+			}
+		}
+		return result
+	}
+	diff(models.HealthReportChangeCategoryDrive, drives(base), drives(target), []string{"state", "healing", "uuid"})
+
+	config := func(info *madmin.HealthInfo) map[string]fields {
+		flat := map[string]string{}
+		flattenHealthConfig("", info.Minio.Config.Config, flat)
+		result := map[string]fields{}
+		for k, v := range flat {
+			result[k] = fields{"value": v}
+		}
+		return result
+	}
+	diff(models.HealthReportChangeCategoryConfig, config(base), config(target), []string{"value"})
+
+	return changes
+}
+
+// flattenHealthConfig flattens the config of a health report into `subsystem:target:key` entries,
+// config key-values come as lists of {"key","value"} objects
+func flattenHealthConfig(prefix string, v interface{}, out map[string]string) {
+	join := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + ":" + k
+	}
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, value := range t {
+			flattenHealthConfig(join(k), value, out)
+		}
+	case []interface{}:
+		for i, value := range t {
+			if kv, ok := value.(map[string]interface{}); ok {
+				if k, ok := kv["key"].(string); ok {
+					flattenHealthConfig(join(k), kv["value"], out)
+					continue
+				}
+			}
+			flattenHealthConfig(join(strconv.Itoa(i)), value, out)
+		}
+	case nil:
+		if prefix != "" {
+			out[prefix] = ""
+		}
+	default:
+		if prefix == "" {
+			prefix = "config"
+		}
+		out[prefix] = fmt.Sprint(t)
+	}
+}
+
+func getListHealthReportsResponse(session *models.Principal, params systemApi.ListHealthReportsParams) (*models.ListHealthReportsResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateHealthReportsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	reports, err := listHealthReports(getHealthReportsDir())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	resp := &models.ListHealthReportsResponse{Reports: reports}
+	if interval := getHealthInfoSchedule(); interval > 0 {
+		resp.Schedule = interval.String()
+	}
+	return resp, nil
+}
+
+func getDownloadHealthReportResponse(session *models.Principal, params systemApi.DownloadHealthReportParams) (io.ReadCloser, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateHealthReportsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	file, err := openHealthReport(getHealthReportsDir(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	if params.Anonymize == nil || !*params.Anonymize {
+		return file, nil
+	}
+	defer file.Close()
+	anonymized, err := anonymizeHealthReport(file)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return io.NopCloser(bytes.NewReader(anonymized)), nil
+}
+
+func processDownloadHealthReportResponse(id string, r io.ReadCloser) func(w http.ResponseWriter, _ runtime.Producer) {
+	return func(w http.ResponseWriter, _ runtime.Producer) {
+		defer r.Close()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"health-%s%s\"", id, healthReportExt))
+		if _, err := io.Copy(w, r); err != nil {
+			LogError("Unable to write all the health report data: %v", err)
+		}
+	}
+}
+
+func getDiffHealthReportsResponse(session *models.Principal, params systemApi.DiffHealthReportsParams) (*models.HealthReportDiff, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateHealthReportsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	base, err := readHealthReport(getHealthReportsDir(), params.Base)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	target, err := readHealthReport(getHealthReportsDir(), params.Target)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.HealthReportDiff{
+		Base:    params.Base,
+		Target:  params.Target,
+		Changes: diffHealthReports(base, target),
+	}, nil
+}
+
+// validateHealthReportsAccess verifies the session is allowed to collect health info
+func validateHealthReportsAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.HealthInfoAdminAction, "Health reports not available.")
+}
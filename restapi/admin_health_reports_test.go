@@ -0,0 +1,157 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestHealthInfo(driveState, region string) madmin.HealthInfo {
+	var config interface{}
+	_ = json.Unmarshal([]byte(`{"region": {"_": [{"key": "name", "value": "`+region+`"}]}}`), &config)
+	return madmin.HealthInfo{
+		Version: madmin.HealthInfoVersion,
+		Minio: madmin.MinioHealthInfo{
+			Config: madmin.MinioConfig{Config: config},
+			Info: madmin.MinioInfo{Servers: []madmin.ServerInfo{
+				{
+					Endpoint: "node1:9000",
+					State:    "online",
+					Version:  "2022-07-01",
+					Drives: []madmin.Disk{
+						{Endpoint: "http://node1:9000/data1", State: "ok", UUID: "uuid-1"},
+						{Endpoint: "http://node1:9000/data2", State: driveState, UUID: "uuid-2"},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestHealthInfoScheduler(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	healthInfo := newTestHealthInfo("ok", "us-east-1")
+	minioServerHealthInfoMock = func(ctx context.Context, healthDataTypes []madmin.HealthDataType, deadline time.Duration) (interface{}, string, error) {
+		return healthInfo, madmin.HealthInfoVersion, nil
+	}
+	var uploaded []string
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (info minio.UploadInfo, err error) {
+		uploaded = append(uploaded, bucketName+"/"+objectName)
+		return info, nil
+	}
+	scheduler := &healthInfoScheduler{
+		interval:   time.Hour,
+		deadline:   time.Minute,
+		dir:        dir,
+		bucket:     "diagnostics",
+		maxReports: 2,
+		admin:      adminClientMock{},
+		s3:         minioClientMock{},
+	}
+
+	// Test-1: the report is archived locally and uploaded to the bucket
+	base, err := scheduler.collect(ctx)
+	assert.Nil(err)
+	assert.Equal([]string{"diagnostics/health-reports/" + base.ID + healthReportExt}, uploaded)
+	reports, err := listHealthReports(dir)
+	assert.Nil(err)
+	if assert.Len(reports, 1) {
+		assert.Equal(base.ID, reports[0].ID)
+		assert.Equal(base.Size, reports[0].Size)
+	}
+
+	// Test-2: a failed upload keeps the local report
+	minioPutObjectMock = func(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (info minio.UploadInfo, err error) {
+		return info, errors.New("bucket not found")
+	}
+	healthInfo = newTestHealthInfo("faulty", "eu-west-1")
+	time.Sleep(time.Second)
+	target, err := scheduler.collect(ctx)
+	assert.Nil(err)
+
+	// Test-3: diff the two reports
+	baseInfo, err := readHealthReport(dir, base.ID)
+	assert.Nil(err)
+	targetInfo, err := readHealthReport(dir, target.ID)
+	assert.Nil(err)
+	assert.Equal([]*models.HealthReportChange{
+		{Category: "drive", Item: "http://node1:9000/data2", Field: "state", Before: "ok", After: "faulty", Status: "changed"},
+		{Category: "config", Item: "region:_:name", Field: "value", Before: "us-east-1", After: "eu-west-1", Status: "changed"},
+	}, diffHealthReports(baseInfo, targetInfo))
+
+	// Test-4: retention keeps the most recent reports
+	time.Sleep(time.Second)
+	latest, err := scheduler.collect(ctx)
+	assert.Nil(err)
+	reports, err = listHealthReports(dir)
+	assert.Nil(err)
+	if assert.Len(reports, 2) {
+		assert.Equal(latest.ID, reports[0].ID)
+		assert.Equal(target.ID, reports[1].ID)
+	}
+	assert.Nil(pruneHealthReports(dir, time.Now().Add(time.Hour), 30*time.Minute, 0))
+	reports, err = listHealthReports(dir)
+	assert.Nil(err)
+	assert.Len(reports, 0)
+
+	// Test-5: collection errors are returned and nothing is archived
+	minioServerHealthInfoMock = func(ctx context.Context, healthDataTypes []madmin.HealthDataType, deadline time.Duration) (interface{}, string, error) {
+		return nil, "", errors.New("access denied")
+	}
+	_, err = scheduler.collect(ctx)
+	assert.Error(err)
+
+	// Test-6: ids can't be used to reach files outside the reports directory
+	_, err = openHealthReport(dir, "../"+latest.ID)
+	assert.True(errors.Is(err, ErrHealthReportNotFound))
+}
+
+func TestDiffHealthReports(t *testing.T) {
+	assert := assert.New(t)
+
+	base := newTestHealthInfo("ok", "us-east-1")
+	target := newTestHealthInfo("ok", "us-east-1")
+	assert.Empty(diffHealthReports(&base, &target))
+
+	// servers and drives added or removed
+	target.Minio.Info.Servers[0].Version = "2022-08-01"
+	target.Minio.Info.Servers[0].Drives = target.Minio.Info.Servers[0].Drives[:1]
+	target.Minio.Info.Servers = append(target.Minio.Info.Servers, madmin.ServerInfo{
+		Endpoint: "node2:9000",
+		State:    "online",
+		Drives:   []madmin.Disk{{Endpoint: "http://node2:9000/data1", State: "ok", Healing: true}},
+	})
+	assert.Equal([]*models.HealthReportChange{
+		{Category: "server", Item: "node1:9000", Field: "version", Before: "2022-07-01", After: "2022-08-01", Status: "changed"},
+		{Category: "server", Item: "node2:9000", Status: "added"},
+		{Category: "drive", Item: "http://node1:9000/data2", Status: "removed"},
+		{Category: "drive", Item: "http://node2:9000/data1", Status: "added"},
+	}, diffHealthReports(&base, &target))
+}
@@ -0,0 +1,663 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	policyApi "github.com/GuinsooLab/console/restapi/operations/policy"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"gopkg.in/yaml.v2"
+)
+
+// iamBundleVersion is the version of the IAM bundles written by the export
+const iamBundleVersion = 1
+
+// iamBundleSecretLength is the length of the secret keys generated for imported users and service
+// accounts whose secret is not in the bundle
+const iamBundleSecretLength = 40
+
+func registerIAMBundleHandlers(api *operations.ConsoleAPI) {
+	// export the IAM state as a bundle
+	api.PolicyExportIAMHandler = policyApi.ExportIAMHandlerFunc(func(params policyApi.ExportIAMParams, session *models.Principal) middleware.Responder {
+		data, err := getExportIAMResponse(session, params)
+		if err != nil {
+			return policyApi.NewExportIAMDefault(int(err.Code)).WithPayload(err)
+		}
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			contentType := "application/json"
+			if *params.Format == "yaml" {
+				contentType = "application/x-yaml"
+			}
+			fileName := fmt.Sprintf("iam-%s.%s", time.Now().UTC().Format("20060102150405"), *params.Format)
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+			if _, err := w.Write(data); err != nil {
+				LogError("Unable to write the IAM bundle: %v", err)
+			}
+		})
+	})
+	// import an IAM bundle
+	api.PolicyImportIAMHandler = policyApi.ImportIAMHandlerFunc(func(params policyApi.ImportIAMParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportIAMResponse(session, params)
+		if err != nil {
+			return policyApi.NewImportIAMDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewImportIAMOK().WithPayload(resp)
+	})
+}
+
+// iamBundle is a snapshot of the policies, users, groups and service accounts of a deployment, secret
+// keys are never exported
+type iamBundle struct {
+	Version         int                       `json:"version"`
+	ExportedAt      string                    `json:"exportedAt"`
+	Policies        []iamBundlePolicy         `json:"policies"`
+	Users           []iamBundleUser           `json:"users"`
+	Groups          []iamBundleGroup          `json:"groups"`
+	ServiceAccounts []iamBundleServiceAccount `json:"serviceAccounts"`
+}
+
+type iamBundlePolicy struct {
+	Name   string            `json:"name"`
+	Policy *iampolicy.Policy `json:"policy"`
+}
+
+type iamBundleUser struct {
+	AccessKey string   `json:"accessKey"`
+	SecretKey string   `json:"secretKey,omitempty"`
+	Status    string   `json:"status"`
+	Policies  []string `json:"policies"`
+}
+
+type iamBundleGroup struct {
+	Name     string   `json:"name"`
+	Status   string   `json:"status"`
+	Members  []string `json:"members"`
+	Policies []string `json:"policies"`
+}
+
+// iamBundleServiceAccount is a service account, without policy when it inherits the policies of
+// its parent user
+type iamBundleServiceAccount struct {
+	AccessKey  string            `json:"accessKey"`
+	ParentUser string            `json:"parentUser"`
+	Status     string            `json:"status"`
+	Policy     *iampolicy.Policy `json:"policy,omitempty"`
+}
+
+// splitPolicyNames returns the sorted policy names of a comma separated mapping
+func splitPolicyNames(names string) []string {
+	result := []string{}
+	for _, name := range strings.Split(names, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// exportIAMBundle reads the IAM state of the deployment
+func exportIAMBundle(ctx context.Context, client MinioAdmin) (*iamBundle, error) {
+	bundle := &iamBundle{
+		Version:         iamBundleVersion,
+		ExportedAt:      time.Now().UTC().Format(time.RFC3339),
+		Policies:        []iamBundlePolicy{},
+		Users:           []iamBundleUser{},
+		Groups:          []iamBundleGroup{},
+		ServiceAccounts: []iamBundleServiceAccount{},
+	}
+	allPolicies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for name, p := range allPolicies {
+		bundle.Policies = append(bundle.Policies, iamBundlePolicy{Name: name, Policy: p})
+	}
+	sort.Slice(bundle.Policies, func(i, j int) bool { return bundle.Policies[i].Name < bundle.Policies[j].Name })
+
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for accessKey, user := range users {
+		bundle.Users = append(bundle.Users, iamBundleUser{AccessKey: accessKey, Status: string(user.Status), Policies: splitPolicyNames(user.PolicyName)})
+	}
+	sort.Slice(bundle.Users, func(i, j int) bool { return bundle.Users[i].AccessKey < bundle.Users[j].AccessKey })
+
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(groups)
+	for _, group := range groups {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		members := append([]string{}, desc.Members...)
+		sort.Strings(members)
+		bundle.Groups = append(bundle.Groups, iamBundleGroup{Name: group, Status: desc.Status, Members: members, Policies: splitPolicyNames(desc.Policy)})
+	}
+
+	for _, user := range bundle.Users {
+		accounts, err := client.listServiceAccounts(ctx, user.AccessKey)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(accounts.Accounts)
+		for _, accessKey := range accounts.Accounts {
+			info, err := client.infoServiceAccount(ctx, accessKey)
+			if err != nil {
+				return nil, err
+			}
+			account := iamBundleServiceAccount{AccessKey: accessKey, ParentUser: user.AccessKey, Status: info.AccountStatus}
+			if !info.ImpliedPolicy && info.Policy != "" {
+				if account.Policy, err = iampolicy.ParseConfig(strings.NewReader(info.Policy)); err != nil {
+					return nil, err
+				}
+			}
+			bundle.ServiceAccounts = append(bundle.ServiceAccounts, account)
+		}
+	}
+	return bundle, nil
+}
+
+// encodeIAMBundle writes the bundle as indented JSON or as YAML
+func encodeIAMBundle(bundle *iamBundle, format string) ([]byte, error) {
+	data, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil || format != "yaml" {
+		return data, err
+	}
+	// go through a generic value so the YAML keys are the same as the JSON ones
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(value)
+}
+
+// decodeIAMBundle reads a bundle written as JSON or YAML
+func decodeIAMBundle(data []byte) (*iamBundle, error) {
+	var bundle iamBundle
+	if trimmed := strings.TrimSpace(string(data)); !strings.HasPrefix(trimmed, "{") {
+		var value interface{}
+		if err := yaml.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		var err error
+		if data, err = json.Marshal(yamlToJSONValue(value)); err != nil {
+			return nil, err
+		}
+	}
+	if err := json.Unmarshal(data, &bundle); err != nil {
+		return nil, err
+	}
+	if bundle.Version != iamBundleVersion {
+		return nil, ErrIAMBundleVersion
+	}
+	return &bundle, nil
+}
+
+// yamlToJSONValue converts the maps decoded from YAML so they can be encoded as JSON
+func yamlToJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprintf("%v", key)] = yamlToJSONValue(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = yamlToJSONValue(item)
+		}
+		return v
+	}
+	return value
+}
+
+// iamImporter compares a bundle with the deployment and applies the differences unless it is a dry run,
+// nothing missing from the bundle is ever removed
+type iamImporter struct {
+	client MinioAdmin
+	dryRun bool
+	// author of the policy revisions recorded by the import
+	author string
+	result *models.IamImportResult
+	// secrets generated for the created users and service accounts, as access key and secret key
+	secrets [][]string
+}
+
+func (im *iamImporter) record(kind, name, action string, details ...string) {
+	if details == nil {
+		details = []string{}
+	}
+	im.result.Changes = append(im.result.Changes, &models.IamImportChange{Kind: kind, Name: name, Action: action, Details: details})
+	switch action {
+	case models.IamImportChangeActionCreate:
+		im.result.Created++
+	case models.IamImportChangeActionUpdate:
+		im.result.Updated++
+	default:
+		im.result.Unchanged++
+	}
+}
+
+// apply runs the change unless it is a dry run
+func (im *iamImporter) apply(fn func() error) error {
+	if im.dryRun {
+		return nil
+	}
+	return fn()
+}
+
+// storeSecrets keeps the generated secret keys as a CSV file that can be downloaded once, the
+// same way as the ones generated by a users import
+func (im *iamImporter) storeSecrets(now time.Time) (string, error) {
+	if len(im.secrets) == 0 {
+		return "", nil
+	}
+	var secrets bytes.Buffer
+	if err := csv.NewWriter(&secrets).WriteAll(append([][]string{{"access_key", "secret_key"}}, im.secrets...)); err != nil {
+		return "", err
+	}
+	return storeImportedUsersSecrets(secrets.Bytes(), im.author, now), nil
+}
+
+// validateIAMBundle checks the policies, users and parents referenced in the bundle exist either in the
+// bundle or in the deployment, so the import doesn't stop half way
+func validateIAMBundle(bundle *iamBundle, policies map[string]*iampolicy.Policy, users map[string]madmin.UserInfo) error {
+	knownPolicies := map[string]bool{}
+	for name := range policies {
+		knownPolicies[name] = true
+	}
+	knownUsers := map[string]bool{}
+	for name := range users {
+		knownUsers[name] = true
+	}
+	for _, p := range bundle.Policies {
+		if p.Name == "" || p.Policy == nil {
+			return fmt.Errorf("policy %q has no name or document", p.Name)
+		}
+		knownPolicies[p.Name] = true
+	}
+	for _, user := range bundle.Users {
+		if user.AccessKey == "" {
+			return fmt.Errorf("a user has no access key")
+		}
+		knownUsers[user.AccessKey] = true
+	}
+	checkPolicies := func(entity string, names []string) error {
+		for _, name := range names {
+			if !knownPolicies[name] {
+				return fmt.Errorf("policy %s of %s does not exist", name, entity)
+			}
+		}
+		return nil
+	}
+	for _, user := range bundle.Users {
+		if err := checkPolicies("user "+user.AccessKey, user.Policies); err != nil {
+			return err
+		}
+	}
+	for _, group := range bundle.Groups {
+		if err := checkPolicies("group "+group.Name, group.Policies); err != nil {
+			return err
+		}
+		for _, member := range group.Members {
+			if !knownUsers[member] {
+				return fmt.Errorf("member %s of group %s does not exist", member, group.Name)
+			}
+		}
+	}
+	for _, account := range bundle.ServiceAccounts {
+		if !knownUsers[account.ParentUser] {
+			return fmt.Errorf("parent user %s of service account %s does not exist", account.ParentUser, account.AccessKey)
+		}
+	}
+	return nil
+}
+
+// importIAMBundle creates or updates the policies, users, groups and service accounts of the bundle
+func importIAMBundle(ctx context.Context, client MinioAdmin, bundle *iamBundle, dryRun bool, author string) (*models.IamImportResult, error) {
+	currentPolicies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentUsers, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIAMBundle(bundle, currentPolicies, currentUsers); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrIAMBundleInvalid, err)
+	}
+	currentGroups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	im := &iamImporter{client: client, dryRun: dryRun, author: author, result: &models.IamImportResult{DryRun: dryRun, Changes: []*models.IamImportChange{}}}
+	err = im.importBundle(ctx, bundle, currentPolicies, currentUsers, currentGroups)
+	// the secrets generated before a failure are kept too, the entries were created already
+	secretsID, secretsErr := im.storeSecrets(time.Now())
+	if err != nil {
+		if secretsID != "" {
+			return nil, fmt.Errorf("%w, the secret keys generated before the failure can be downloaded once with the id %s", err, secretsID)
+		}
+		return nil, err
+	}
+	if secretsErr != nil {
+		return nil, secretsErr
+	}
+	im.result.SecretsID = secretsID
+	return im.result, nil
+}
+
+// importBundle applies the entries of the bundle in dependency order
+func (im *iamImporter) importBundle(ctx context.Context, bundle *iamBundle, currentPolicies map[string]*iampolicy.Policy, currentUsers map[string]madmin.UserInfo, currentGroups []string) error {
+	client := im.client
+	for _, p := range bundle.Policies {
+		p := p
+		current, ok := currentPolicies[p.Name]
+		switch {
+		case !ok:
+			im.record(models.IamImportChangeKindPolicy, p.Name, models.IamImportChangeActionCreate)
+		case current == nil || !current.Equals(*p.Policy):
+			im.record(models.IamImportChangeKindPolicy, p.Name, models.IamImportChangeActionUpdate, "policy document changed")
+		default:
+			im.record(models.IamImportChangeKindPolicy, p.Name, models.IamImportChangeActionUnchanged)
+			continue
+		}
+		if err := im.apply(func() error {
+			_, err := savePolicy(ctx, client, getPolicyHistoryDir(), p.Name, im.author, "", 0, p.Policy)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, user := range bundle.Users {
+		if err := im.importUser(ctx, user, currentUsers); err != nil {
+			return err
+		}
+	}
+	for _, group := range bundle.Groups {
+		if err := im.importGroup(ctx, group, containsName(currentGroups, group.Name)); err != nil {
+			return err
+		}
+	}
+	for _, account := range bundle.ServiceAccounts {
+		if err := im.importServiceAccount(ctx, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (im *iamImporter) importUser(ctx context.Context, user iamBundleUser, currentUsers map[string]madmin.UserInfo) error {
+	status := madmin.AccountStatus(user.Status)
+	if status == "" {
+		status = madmin.AccountEnabled
+	}
+	policies := append([]string{}, user.Policies...)
+	sort.Strings(policies)
+	current, ok := currentUsers[user.AccessKey]
+	if !ok {
+		var details []string
+		secretKey := user.SecretKey
+		generated := secretKey == ""
+		if generated {
+			secretKey = RandomCharString(iamBundleSecretLength)
+			details = append(details, "secret key generated, it can be downloaded once after the import")
+		}
+		im.record(models.IamImportChangeKindUser, user.AccessKey, models.IamImportChangeActionCreate, details...)
+		return im.apply(func() error {
+			if err := im.client.addUser(ctx, user.AccessKey, secretKey); err != nil {
+				return err
+			}
+			if generated {
+				im.secrets = append(im.secrets, []string{user.AccessKey, secretKey})
+			}
+			if len(policies) > 0 {
+				if err := im.client.setPolicy(ctx, strings.Join(policies, ","), user.AccessKey, false); err != nil {
+					return err
+				}
+			}
+			if status != madmin.AccountEnabled {
+				return im.client.setUserStatus(ctx, user.AccessKey, status)
+			}
+			return nil
+		})
+	}
+
+	var details []string
+	currentPolicies := splitPolicyNames(current.PolicyName)
+	policiesChanged := strings.Join(currentPolicies, ",") != strings.Join(policies, ",")
+	if policiesChanged {
+		details = append(details, fmt.Sprintf("policies %s -> %s", strings.Join(currentPolicies, ","), strings.Join(policies, ",")))
+	}
+	statusChanged := current.Status != status
+	if statusChanged {
+		details = append(details, fmt.Sprintf("status %s -> %s", current.Status, status))
+	}
+	if details == nil {
+		im.record(models.IamImportChangeKindUser, user.AccessKey, models.IamImportChangeActionUnchanged)
+		return nil
+	}
+	im.record(models.IamImportChangeKindUser, user.AccessKey, models.IamImportChangeActionUpdate, details...)
+	return im.apply(func() error {
+		if policiesChanged {
+			if err := im.client.setPolicy(ctx, strings.Join(policies, ","), user.AccessKey, false); err != nil {
+				return err
+			}
+		}
+		if statusChanged {
+			return im.client.setUserStatus(ctx, user.AccessKey, status)
+		}
+		return nil
+	})
+}
+
+func (im *iamImporter) importGroup(ctx context.Context, group iamBundleGroup, exists bool) error {
+	status := madmin.GroupStatus(group.Status)
+	if status == "" {
+		status = madmin.GroupEnabled
+	}
+	policies := append([]string{}, group.Policies...)
+	sort.Strings(policies)
+	if !exists {
+		im.record(models.IamImportChangeKindGroup, group.Name, models.IamImportChangeActionCreate)
+		return im.apply(func() error {
+			if err := im.client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: group.Name, Members: group.Members}); err != nil {
+				return err
+			}
+			if len(policies) > 0 {
+				if err := im.client.setPolicy(ctx, strings.Join(policies, ","), group.Name, true); err != nil {
+					return err
+				}
+			}
+			if status != madmin.GroupEnabled {
+				return im.client.setGroupStatus(ctx, group.Name, status)
+			}
+			return nil
+		})
+	}
+
+	current, err := im.client.getGroupDescription(ctx, group.Name)
+	if err != nil {
+		return err
+	}
+	var details, missing []string
+	for _, member := range group.Members {
+		if !containsName(current.Members, member) {
+			missing = append(missing, member)
+		}
+	}
+	if len(missing) > 0 {
+		details = append(details, fmt.Sprintf("add members %s", strings.Join(missing, ",")))
+	}
+	currentPolicies := splitPolicyNames(current.Policy)
+	policiesChanged := strings.Join(currentPolicies, ",") != strings.Join(policies, ",")
+	if policiesChanged {
+		details = append(details, fmt.Sprintf("policies %s -> %s", strings.Join(currentPolicies, ","), strings.Join(policies, ",")))
+	}
+	statusChanged := current.Status != string(status)
+	if statusChanged {
+		details = append(details, fmt.Sprintf("status %s -> %s", current.Status, status))
+	}
+	if details == nil {
+		im.record(models.IamImportChangeKindGroup, group.Name, models.IamImportChangeActionUnchanged)
+		return nil
+	}
+	im.record(models.IamImportChangeKindGroup, group.Name, models.IamImportChangeActionUpdate, details...)
+	return im.apply(func() error {
+		if len(missing) > 0 {
+			if err := im.client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: group.Name, Members: missing}); err != nil {
+				return err
+			}
+		}
+		if policiesChanged {
+			if err := im.client.setPolicy(ctx, strings.Join(policies, ","), group.Name, true); err != nil {
+				return err
+			}
+		}
+		if statusChanged {
+			return im.client.setGroupStatus(ctx, group.Name, status)
+		}
+		return nil
+	})
+}
+
+func (im *iamImporter) importServiceAccount(ctx context.Context, account iamBundleServiceAccount) error {
+	status := account.Status
+	if status == "" {
+		status = "on"
+	}
+	current, err := im.client.infoServiceAccount(ctx, account.AccessKey)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code != "XMinioAdminServiceAccountNotFound" {
+			return err
+		}
+		im.record(models.IamImportChangeKindServiceAccount, account.AccessKey, models.IamImportChangeActionCreate,
+			"secret key generated, it can be downloaded once after the import")
+		return im.apply(func() error {
+			secretKey := RandomCharString(iamBundleSecretLength)
+			if _, err := im.client.addServiceAccount(ctx, account.Policy, account.ParentUser, account.AccessKey, secretKey); err != nil {
+				return err
+			}
+			im.secrets = append(im.secrets, []string{account.AccessKey, secretKey})
+			if status != "on" {
+				return im.client.updateServiceAccount(ctx, account.AccessKey, madmin.UpdateServiceAccountReq{NewStatus: status})
+			}
+			return nil
+		})
+	}
+
+	var details []string
+	if current.ParentUser != account.ParentUser {
+		details = append(details, fmt.Sprintf("parent user is %s instead of %s, it can't be changed", current.ParentUser, account.ParentUser))
+	}
+	var update madmin.UpdateServiceAccountReq
+	if current.AccountStatus != status {
+		details = append(details, fmt.Sprintf("status %s -> %s", current.AccountStatus, status))
+		update.NewStatus = status
+	}
+	var currentPolicy *iampolicy.Policy
+	if !current.ImpliedPolicy && current.Policy != "" {
+		if currentPolicy, err = iampolicy.ParseConfig(strings.NewReader(current.Policy)); err != nil {
+			return err
+		}
+	}
+	switch {
+	case account.Policy != nil && (currentPolicy == nil || !currentPolicy.Equals(*account.Policy)):
+		details = append(details, "policy document changed")
+		if update.NewPolicy, err = json.Marshal(account.Policy); err != nil {
+			return err
+		}
+	case account.Policy == nil && currentPolicy != nil:
+		details = append(details, "the policy can't be removed to inherit the parent user policies, it is kept")
+	}
+	if details == nil {
+		im.record(models.IamImportChangeKindServiceAccount, account.AccessKey, models.IamImportChangeActionUnchanged)
+		return nil
+	}
+	if update.NewStatus == "" && update.NewPolicy == nil {
+		im.record(models.IamImportChangeKindServiceAccount, account.AccessKey, models.IamImportChangeActionUnchanged, details...)
+		return nil
+	}
+	im.record(models.IamImportChangeKindServiceAccount, account.AccessKey, models.IamImportChangeActionUpdate, details...)
+	return im.apply(func() error {
+		return im.client.updateServiceAccount(ctx, account.AccessKey, update)
+	})
+}
+
+func getExportIAMResponse(session *models.Principal, params policyApi.ExportIAMParams) ([]byte, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	bundle, err := exportIAMBundle(ctx, adminClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	data, err := encodeIAMBundle(bundle, *params.Format)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return data, nil
+}
+
+func getImportIAMResponse(session *models.Principal, params policyApi.ImportIAMParams) (*models.IamImportResult, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	bundle, err := decodeIAMBundle([]byte(*params.Body.Bundle))
+	if err != nil {
+		if err == ErrIAMBundleVersion {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := importIAMBundle(ctx, adminClient, bundle, params.Body.DryRun, session.AccountAccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
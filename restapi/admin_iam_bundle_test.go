@@ -0,0 +1,252 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+const iamBundleTestPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`
+
+func mockIAMBundleDeployment(t *testing.T) {
+	readPhotos, err := iampolicy.ParseConfig(strings.NewReader(iamBundleTestPolicy))
+	if err != nil {
+		t.Fatal(err)
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readphotos": readPhotos}, nil
+	}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if name != "readphotos" {
+			return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+		}
+		return readPhotos, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"alice": {PolicyName: "readphotos", Status: madmin.AccountEnabled}}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"editors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupEnabled), Members: []string{"alice"}, Policy: "readphotos"}, nil
+	}
+	minioListServiceAccountsMock = func(ctx context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		return madmin.ListServiceAccountsResp{Accounts: []string{"alice-sa"}}, nil
+	}
+	minioInfoServiceAccountMock = func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error) {
+		if serviceAccount != "alice-sa" {
+			return madmin.InfoServiceAccountResp{}, madmin.ErrorResponse{Code: "XMinioAdminServiceAccountNotFound"}
+		}
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "on", ImpliedPolicy: true}, nil
+	}
+}
+
+func TestExportIAMBundle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	mockIAMBundleDeployment(t)
+
+	bundle, err := exportIAMBundle(ctx, adminClientMock{})
+	if !assert.Nil(err) {
+		return
+	}
+	assert.Equal(iamBundleVersion, bundle.Version)
+	if assert.Len(bundle.Policies, 1) {
+		assert.Equal("readphotos", bundle.Policies[0].Name)
+	}
+	assert.Equal([]iamBundleUser{{AccessKey: "alice", Status: "enabled", Policies: []string{"readphotos"}}}, bundle.Users)
+	assert.Equal([]iamBundleGroup{{Name: "editors", Status: "enabled", Members: []string{"alice"}, Policies: []string{"readphotos"}}}, bundle.Groups)
+	assert.Equal([]iamBundleServiceAccount{{AccessKey: "alice-sa", ParentUser: "alice", Status: "on"}}, bundle.ServiceAccounts)
+
+	// both formats decode back to the same bundle
+	for _, format := range []string{"json", "yaml"} {
+		data, err := encodeIAMBundle(bundle, format)
+		assert.Nil(err)
+		decoded, err := decodeIAMBundle(data)
+		if assert.Nil(err, format) {
+			assert.Equal(bundle.Users, decoded.Users, format)
+			assert.Equal(bundle.Groups, decoded.Groups, format)
+			assert.True(decoded.Policies[0].Policy.Equals(*bundle.Policies[0].Policy), format)
+		}
+	}
+
+	_, err = decodeIAMBundle([]byte(`{"version":2}`))
+	assert.Equal(ErrIAMBundleVersion, err)
+}
+
+func TestImportIAMBundle(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	mockIAMBundleDeployment(t)
+	var calls []string
+	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
+		calls = append(calls, "addPolicy "+name)
+		return nil
+	}
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		calls = append(calls, "addUser "+accessKey)
+		return nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		calls = append(calls, "setPolicy "+policyName+" "+entityName)
+		return nil
+	}
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		calls = append(calls, "setUserStatus "+accessKey+" "+string(status))
+		return nil
+	}
+	minioUpdateGroupMembersMock = func(req madmin.GroupAddRemove) error {
+		calls = append(calls, "updateGroupMembers "+req.Group+" "+strings.Join(req.Members, ","))
+		return nil
+	}
+	minioSetGroupStatusMock = func(group string, status madmin.GroupStatus) error {
+		calls = append(calls, "setGroupStatus "+group)
+		return nil
+	}
+	minioAddServiceAccountMock = func(ctx context.Context, policy *iampolicy.Policy, user string, accessKey string, secretKey string) (madmin.Credentials, error) {
+		calls = append(calls, "addServiceAccount "+accessKey)
+		return madmin.Credentials{AccessKey: accessKey, SecretKey: secretKey}, nil
+	}
+	minioUpdateServiceAccountMock = func(ctx context.Context, serviceAccount string, opts madmin.UpdateServiceAccountReq) error {
+		calls = append(calls, "updateServiceAccount "+serviceAccount+" "+opts.NewStatus)
+		return nil
+	}
+	bundle, err := decodeIAMBundle([]byte(`
+version: 1
+policies:
+  - name: writelogs
+    policy:
+      Version: "2012-10-17"
+      Statement:
+        - Effect: Allow
+          Action: ["s3:PutObject"]
+          Resource: ["arn:aws:s3:::logs/*"]
+users:
+  - accessKey: alice
+    status: disabled
+    policies: [readphotos]
+  - accessKey: bob
+    policies: [writelogs]
+groups:
+  - name: editors
+    status: enabled
+    members: [alice, bob]
+    policies: [readphotos]
+serviceAccounts:
+  - accessKey: alice-sa
+    parentUser: alice
+    status: "on"
+  - accessKey: bob-sa
+    parentUser: bob
+    status: "off"
+`))
+	if !assert.Nil(err) {
+		return
+	}
+
+	// Test-1: a dry run only reports the changes
+	result, err := importIAMBundle(ctx, adminClientMock{}, bundle, true, "admin")
+	if !assert.Nil(err) {
+		return
+	}
+	assert.Empty(calls)
+	assert.True(result.DryRun)
+	assert.Empty(result.SecretsID)
+	assert.Equal(int64(3), result.Created)
+	assert.Equal(int64(2), result.Updated)
+	assert.Equal(int64(1), result.Unchanged)
+	var changes []string
+	for _, change := range result.Changes {
+		changes = append(changes, change.Kind+" "+change.Name+" "+change.Action)
+	}
+	assert.Equal([]string{
+		"policy writelogs create",
+		"user alice update",
+		"user bob create",
+		"group editors update",
+		"serviceAccount alice-sa unchanged",
+		"serviceAccount bob-sa create",
+	}, changes)
+	assert.Equal([]string{"status enabled -> disabled"}, result.Changes[1].Details)
+	assert.Equal([]string{"add members bob"}, result.Changes[3].Details)
+
+	// Test-2: the changes are applied in dependency order
+	result, err = importIAMBundle(ctx, adminClientMock{}, bundle, false, "admin")
+	assert.Nil(err)
+	assert.False(result.DryRun)
+	assert.Equal([]string{
+		"addPolicy writelogs",
+		"setUserStatus alice disabled",
+		"addUser bob",
+		"setPolicy writelogs bob",
+		"updateGroupMembers editors bob",
+		"addServiceAccount bob-sa",
+		"updateServiceAccount bob-sa off",
+	}, calls)
+	// the imported policies are recorded in their history
+	history, err := readPolicyHistory(getPolicyHistoryDir(), "writelogs")
+	if assert.Nil(err) && assert.Len(history.Revisions, 1) {
+		assert.Equal("admin", history.Revisions[0].Author)
+	}
+	// the generated secret keys are returned once
+	secrets, err := takeImportedUsersSecrets(result.SecretsID, "admin", time.Now())
+	if assert.Nil(err) {
+		lines := strings.Split(strings.TrimSpace(string(secrets)), "\n")
+		if assert.Len(lines, 3) {
+			assert.True(strings.HasPrefix(lines[1], "bob,"))
+			assert.Len(strings.TrimPrefix(lines[2], "bob-sa,"), iamBundleSecretLength)
+		}
+	}
+
+	// Test-3: the secret keys generated before a failure can still be downloaded
+	minioAddServiceAccountMock = func(ctx context.Context, policy *iampolicy.Policy, user string, accessKey string, secretKey string) (madmin.Credentials, error) {
+		return madmin.Credentials{}, errors.New("unable to add the service account")
+	}
+	_, err = importIAMBundle(ctx, adminClientMock{}, bundle, false, "admin")
+	if assert.Error(err) {
+		id := err.Error()[strings.LastIndex(err.Error(), " ")+1:]
+		secrets, err = takeImportedUsersSecrets(id, "admin", time.Now())
+		assert.Nil(err)
+		assert.Contains(string(secrets), "bob,")
+	}
+
+	// Test-4: references to missing entities are rejected before any change
+	calls = nil
+	_, err = importIAMBundle(ctx, adminClientMock{}, &iamBundle{
+		Version: iamBundleVersion,
+		Groups:  []iamBundleGroup{{Name: "ops", Members: []string{"carol"}}},
+	}, false, "admin")
+	assert.True(errors.Is(err, ErrIAMBundleInvalid))
+	assert.Equal("invalid IAM bundle: member carol of group ops does not exist", err.Error())
+	assert.Empty(calls)
+	_, err = importIAMBundle(ctx, adminClientMock{}, &iamBundle{
+		Version: iamBundleVersion,
+		Users:   []iamBundleUser{{AccessKey: "carol", Policies: []string{"missing"}}},
+	}, false, "admin")
+	assert.True(errors.Is(err, ErrIAMBundleInvalid))
+	assert.Equal(int32(400), ErrorWithContext(ctx, err).Code)
+}
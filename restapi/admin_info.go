@@ -46,7 +46,7 @@ func registerAdminInfoHandlers(api *operations.ConsoleAPI) {
 	})
 	// return single widget results
 	api.SystemDashboardWidgetDetailsHandler = systemApi.DashboardWidgetDetailsHandlerFunc(func(params systemApi.DashboardWidgetDetailsParams, session *models.Principal) middleware.Responder {
-		infoResp, err := getAdminInfoWidgetResponse(params)
+		infoResp, err := getAdminInfoWidgetResponse(session, params)
 		if err != nil {
 			return systemApi.NewDashboardWidgetDetailsDefault(int(err.Code)).WithPayload(err)
 		}
@@ -96,6 +96,9 @@ func GetAdminInfo(ctx context.Context, client MinioAdmin) (*UsageInfo, error) {
 				TotalSpace:     int64(drive.TotalSpace),
 				UsedSpace:      int64(drive.UsedSpace),
 				AvailableSpace: int64(drive.AvailableSpace),
+				PoolIndex:      int64(drive.PoolIndex),
+				SetIndex:       int64(drive.SetIndex),
+				DiskIndex:      int64(drive.DiskIndex),
 			})
 		}
 
@@ -872,12 +875,17 @@ func getAdminInfoResponse(session *models.Principal, params systemApi.AdminInfoP
 		prometheusURL = getPrometheusURL()
 	}
 
+	// the built-in collector serves the widgets when there is no Prometheus
+	if !*params.DefaultOnly && prometheusURL == "" && globalMetricsCollector != nil {
+		return &models.AdminInfoResponse{Widgets: getWidgetsSummary(getDashboardMetrics(session))}, nil
+	}
+
 	mAdmin, err := NewMinioAdminClient(session)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
 
-	sessionResp, err2 := getUsageWidgetsForDeployment(ctx, prometheusURL, mAdmin)
+	sessionResp, err2 := getUsageWidgetsForDeployment(ctx, prometheusURL, mAdmin, getDashboardMetrics(session))
 	if err2 != nil {
 		return nil, ErrorWithContext(ctx, err2)
 	}
@@ -885,7 +893,7 @@ func getAdminInfoResponse(session *models.Principal, params systemApi.AdminInfoP
 	return sessionResp, nil
 }
 
-func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, mAdmin *madmin.AdminClient) (*models.AdminInfoResponse, error) {
+func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, mAdmin *madmin.AdminClient, dashboard []Metric) (*models.AdminInfoResponse, error) {
 	prometheusNotReady := false
 	if prometheusURL != "" && !testPrometheusURL(ctx, prometheusURL) {
 		prometheusNotReady = true
@@ -909,9 +917,18 @@ func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, mAd
 		return sessionResp, nil
 	}
 
+	// count the number of widgets that have completed calculating
+	sessionResp := &models.AdminInfoResponse{}
+
+	sessionResp.Widgets = getWidgetsSummary(dashboard)
+	return sessionResp, nil
+}
+
+// getWidgetsSummary returns the widgets of the dashboard without their results
+func getWidgetsSummary(dashboard []Metric) []*models.Widget {
 	var wdgts []*models.Widget
 
-	for _, m := range widgets {
+	for _, m := range dashboard {
 		// for each target we will launch another goroutine to fetch the values
 		wdgtResult := models.Widget{
 			ID:    m.ID,
@@ -928,19 +945,49 @@ func getUsageWidgetsForDeployment(ctx context.Context, prometheusURL string, mAd
 
 		wdgts = append(wdgts, &wdgtResult)
 	}
+	return wdgts
+}
 
-	// count the number of widgets that have completed calculating
-	sessionResp := &models.AdminInfoResponse{}
+// widgetQuerier runs the queries of the dashboard widgets, either against Prometheus or the built-in metrics collector
+type widgetQuerier interface {
+	labelValues(ctx context.Context, label string) ([]string, error)
+	queryRange(ctx context.Context, query string, start, end int64, step int32) (*PromResp, error)
+}
 
-	sessionResp.Widgets = wdgts
-	return sessionResp, nil
+// prometheusQuerier runs the widget queries against a Prometheus server
+type prometheusQuerier struct {
+	url string
+}
+
+func (p prometheusQuerier) labelValues(ctx context.Context, label string) ([]string, error) {
+	endpoint := fmt.Sprintf("%s/api/v1/label/%s/values", p.url, label)
+
+	var response LabelResponse
+	if unmarshalPrometheus(ctx, endpoint, &response) {
+		return nil, fmt.Errorf("unable to fetch values of label %s", label)
+	}
+	return response.Data, nil
+}
+
+func (p prometheusQuerier) queryRange(ctx context.Context, query string, start, end int64, step int32) (*PromResp, error) {
+	endpoint := fmt.Sprintf("%s/api/v1/query_range?query=%s&start=%d&end=%d&step=%d", p.url, url.QueryEscape(query), start, end, step)
+
+	var response PromResp
+	if unmarshalPrometheus(ctx, endpoint, &response) {
+		return nil, fmt.Errorf("unable to run query %s", query)
+	}
+	return &response, nil
 }
 
 func unmarshalPrometheus(ctx context.Context, endpoint string, data interface{}) bool {
-	httpClnt := GetConsoleHTTPClient()
-	resp, err := httpClnt.Get(endpoint)
+	httpClnt, err := getPrometheusClient()
 	if err != nil {
-		ErrorWithContext(ctx, fmt.Errorf("Unable to fetch labels from prometheus (%s)", resp.Status))
+		ErrorWithContext(ctx, fmt.Errorf("Unable to configure the prometheus client (%v)", err))
+		return true
+	}
+	resp, err := httpClnt.get(ctx, endpoint)
+	if err != nil {
+		ErrorWithContext(ctx, fmt.Errorf("Unable to fetch data from prometheus (%v)", err))
 		return true
 	}
 	defer resp.Body.Close()
@@ -959,12 +1006,17 @@ func unmarshalPrometheus(ctx context.Context, endpoint string, data interface{})
 }
 
 func testPrometheusURL(ctx context.Context, url string) bool {
+	httpClnt, err := getPrometheusClient()
+	if err != nil {
+		ErrorWithContext(ctx, fmt.Errorf("error configuring the prometheus client: (%v)", err))
+		return false
+	}
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url+"/-/healthy", nil)
 	if err != nil {
 		ErrorWithContext(ctx, fmt.Errorf("error Building Request: (%v)", err))
 		return false
 	}
-	response, err := GetConsoleHTTPClient().Do(req)
+	response, err := httpClnt.do(req)
 	if err != nil {
 		ErrorWithContext(ctx, fmt.Errorf("default Prometheus URL not reachable, trying root testing: (%v)", err))
 		newTestURL := req.URL.Scheme + "://" + req.URL.Host + "/-/healthy"
@@ -973,7 +1025,7 @@ func testPrometheusURL(ctx context.Context, url string) bool {
 			ErrorWithContext(ctx, fmt.Errorf("error Building Root Request: (%v)", err))
 			return false
 		}
-		rootResponse, err := GetConsoleHTTPClient().Do(req2)
+		rootResponse, err := httpClnt.do(req2)
 		if err != nil {
 			// URL & Root tests didn't work. Prometheus not reachable
 			ErrorWithContext(ctx, fmt.Errorf("root Prometheus URL not reachable: (%v)", err))
@@ -984,13 +1036,19 @@ func testPrometheusURL(ctx context.Context, url string) bool {
 	return response.StatusCode == http.StatusOK
 }
 
-func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams) (*models.WidgetDetails, *models.Error) {
+func getAdminInfoWidgetResponse(session *models.Principal, params systemApi.DashboardWidgetDetailsParams) (*models.WidgetDetails, *models.Error) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
 	prometheusURL := getPrometheusURL()
 	prometheusJobID := getPrometheusJobID()
 	prometheusExtraLabels := getPrometheusExtraLabels()
 
+	// without Prometheus the widgets are served by the built-in collector, which labels the series with the job id
+	if prometheusURL == "" && globalMetricsCollector != nil {
+		selector := fmt.Sprintf(`job="%s"`, prometheusJobID)
+		return getWidgetDetails(ctx, globalMetricsCollector, selector, getDashboardMetrics(session), params.WidgetID, params.Step, params.Start, params.End)
+	}
+
 	// We test if prometheus URL is reachable. this is meant to avoid unuseful calls and application hang.
 	if !testPrometheusURL(ctx, prometheusURL) {
 		return nil, ErrorWithContext(ctx, errors.New("Prometheus URL is unreachable"))
@@ -1000,22 +1058,38 @@ func getAdminInfoWidgetResponse(params systemApi.DashboardWidgetDetailsParams) (
 	if strings.TrimSpace(prometheusExtraLabels) != "" {
 		selector = fmt.Sprintf(`job="%s",%s`, prometheusJobID, prometheusExtraLabels)
 	}
-	return getWidgetDetails(ctx, prometheusURL, selector, params.WidgetID, params.Step, params.Start, params.End)
+	return getWidgetDetails(ctx, prometheusQuerier{url: prometheusURL}, selector, getDashboardMetrics(session), params.WidgetID, params.Step, params.Start, params.End)
+}
+
+// expandWidgetQuery replaces the variables of a widget expression, `$__query` is replaced by the
+// selector and label variables such as `$instance` by the values found for them
+func expandWidgetQuery(expr string, selector string, labelMap map[string][]string) string {
+	// replace the `$__rate_interval` global for step with unit (s for seconds)
+	queryExpr := strings.ReplaceAll(expr, "$__rate_interval", fmt.Sprintf("%ds", 240))
+	if strings.Contains(queryExpr, "$") {
+		re := regexp.MustCompile(`\$([a-z]+)`)
+
+		for _, match := range re.FindAllStringSubmatch(queryExpr, -1) {
+			if val, ok := labelMap[match[1]]; ok {
+				queryExpr = strings.ReplaceAll(queryExpr, "$"+match[1], fmt.Sprintf("(%s)", strings.Join(val, "|")))
+			}
+		}
+	}
+
+	return strings.ReplaceAll(queryExpr, "$__query", selector)
 }
 
-func getWidgetDetails(ctx context.Context, prometheusURL string, selector string, widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *models.Error) {
+func getWidgetDetails(ctx context.Context, querier widgetQuerier, selector string, dashboard []Metric, widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *models.Error) {
 	labelResultsCh := make(chan LabelResults)
 
 	for _, lbl := range labels {
 		go func(lbl WidgetLabel) {
-			endpoint := fmt.Sprintf("%s/api/v1/label/%s/values", prometheusURL, lbl.Name)
-
-			var response LabelResponse
-			if unmarshalPrometheus(ctx, endpoint, &response) {
+			values, err := querier.labelValues(ctx, lbl.Name)
+			if err != nil {
 				return
 			}
 
-			labelResultsCh <- LabelResults{Label: lbl.Name, Response: response}
+			labelResultsCh <- LabelResults{Label: lbl.Name, Response: LabelResponse{Status: "success", Data: values}}
 		}(lbl)
 	}
 
@@ -1037,7 +1111,7 @@ LabelsWaitLoop:
 
 	// launch a goroutines per widget
 
-	for _, m := range widgets {
+	for _, m := range dashboard {
 		if m.ID != widgetID {
 			continue
 		}
@@ -1046,7 +1120,6 @@ LabelsWaitLoop:
 		// for each target we will launch another goroutine to fetch the values
 		for _, target := range m.Targets {
 			go func(target Target, inStep *int32, inStart *int64, inEnd *int64) {
-				apiType := "query_range"
 				now := time.Now()
 
 				var initTime int64 = -15
@@ -1057,7 +1130,7 @@ LabelsWaitLoop:
 
 				timeCalculated := time.Duration(initTime * int64(time.Minute))
 
-				extraParamters := fmt.Sprintf("&start=%d&end=%d", now.Add(timeCalculated).Unix(), now.Unix())
+				queryStart, queryEnd := now.Add(timeCalculated).Unix(), now.Unix()
 
 				var step int32 = 60
 				if target.Step > 0 {
@@ -1066,31 +1139,18 @@ LabelsWaitLoop:
 				if inStep != nil && *inStep > 0 {
 					step = *inStep
 				}
-				if step > 0 {
-					extraParamters = fmt.Sprintf("%s&step=%d", extraParamters, step)
-				}
 
 				if inStart != nil && inEnd != nil {
-					extraParamters = fmt.Sprintf("&start=%d&end=%d&step=%d", *inStart, *inEnd, *inStep)
-				}
-
-				// replace the `$__rate_interval` global for step with unit (s for seconds)
-				queryExpr := strings.ReplaceAll(target.Expr, "$__rate_interval", fmt.Sprintf("%ds", 240))
-				if strings.Contains(queryExpr, "$") {
-					re := regexp.MustCompile(`\$([a-z]+)`)
-
-					for _, match := range re.FindAllStringSubmatch(queryExpr, -1) {
-						if val, ok := labelMap[match[1]]; ok {
-							queryExpr = strings.ReplaceAll(queryExpr, "$"+match[1], fmt.Sprintf("(%s)", strings.Join(val, "|")))
-						}
-					}
+					queryStart, queryEnd = *inStart, *inEnd
 				}
 
-				queryExpr = strings.ReplaceAll(queryExpr, "$__query", selector)
-				endpoint := fmt.Sprintf("%s/api/v1/%s?query=%s%s", prometheusURL, apiType, url.QueryEscape(queryExpr), extraParamters)
+				queryExpr := expandWidgetQuery(target.Expr, selector, labelMap)
 
-				var response PromResp
-				if unmarshalPrometheus(ctx, endpoint, &response) {
+				response, err := querier.queryRange(ctx, queryExpr, queryStart, queryEnd, step)
+				if err != nil {
+					// custom widgets may have invalid queries, the target is returned empty so the widget still renders
+					ErrorWithContext(ctx, err)
+					targetResults <- &models.ResultTarget{LegendFormat: target.LegendFormat}
 					return
 				}
 
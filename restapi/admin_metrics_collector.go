@@ -0,0 +1,435 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	metricsClusterPath  = "/minio/v2/metrics/cluster"
+	metricsSeriesFile   = "series.gob"
+	metricsPersistEvery = 5 * time.Minute
+)
+
+// globalMetricsCollector is set when the dashboard is served by the built-in collector instead of Prometheus
+var globalMetricsCollector *metricsCollector
+
+// metricsSample is a sample of a series, T is in milliseconds
+type metricsSample struct {
+	T int64
+	V float64
+}
+
+// metricsSeries keeps the samples of a series on a ring buffer
+type metricsSeries struct {
+	Name    string
+	Labels  map[string]string
+	Samples []metricsSample
+	// Head is the index of the oldest sample once the buffer is full
+	Head int
+}
+
+func (s *metricsSeries) add(sample metricsSample, capacity int) {
+	if len(s.Samples) < capacity {
+		s.Samples = append(s.Samples, sample)
+		return
+	}
+	s.Samples[s.Head] = sample
+	s.Head = (s.Head + 1) % len(s.Samples)
+}
+
+func (s *metricsSeries) at(i int) metricsSample {
+	return s.Samples[(s.Head+i)%len(s.Samples)]
+}
+
+// between returns the samples in the (from, to] interval, oldest first
+func (s *metricsSeries) between(from, to int64) []metricsSample {
+	n := len(s.Samples)
+	first := sort.Search(n, func(i int) bool { return s.at(i).T > from })
+	var samples []metricsSample
+	for i := first; i < n && s.at(i).T <= to; i++ {
+		samples = append(samples, s.at(i))
+	}
+	return samples
+}
+
+// metricsStore is the in-memory time series database of the collector
+type metricsStore struct {
+	mu        sync.RWMutex
+	capacity  int
+	retention time.Duration
+	series    map[string]*metricsSeries
+}
+
+func newMetricsStore(retention, interval time.Duration) *metricsStore {
+	capacity := int(retention / interval)
+	if capacity < 2 {
+		capacity = 2
+	}
+	return &metricsStore{capacity: capacity, retention: retention, series: map[string]*metricsSeries{}}
+}
+
+// metricsScraped is a sample read from the metrics endpoint
+type metricsScraped struct {
+	name   string
+	labels map[string]string
+	value  float64
+}
+
+// add appends a scrape to the store, series without samples within the retention are dropped
+func (s *metricsStore) add(now time.Time, scraped []metricsScraped) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t := now.UnixMilli()
+	for _, m := range scraped {
+		key := m.name + "{" + metricsLabelsKey(m.labels) + "}"
+		series, ok := s.series[key]
+		if !ok {
+			series = &metricsSeries{Name: m.name, Labels: m.labels}
+			s.series[key] = series
+		}
+		series.add(metricsSample{T: t, V: m.value}, s.capacity)
+	}
+	s.prune(t)
+}
+
+func (s *metricsStore) prune(now int64) {
+	oldest := now - s.retention.Milliseconds()
+	for key, series := range s.series {
+		if len(series.Samples) == 0 || series.at(len(series.Samples)-1).T < oldest {
+			delete(s.series, key)
+		}
+	}
+}
+
+// labelValues returns the values of a label across all the series
+func (s *metricsStore) labelValues(label string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	found := map[string]bool{}
+	for _, series := range s.series {
+		if v, ok := series.Labels[label]; ok {
+			found[v] = true
+		}
+	}
+	values := make([]string, 0, len(found))
+	for v := range found {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// queryRange evaluates a query between start and end, returning the result as Prometheus does
+func (s *metricsStore) queryRange(query string, start, end time.Time, step time.Duration) (*PromResp, error) {
+	expr, err := parseMetricsQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if step <= 0 {
+		return nil, errors.New("step must be greater than zero")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var keys []string
+	results := map[string]*DataResult{}
+	for t := start; !t.After(end); t = t.Add(step) {
+		value, err := expr.eval(s, t.UnixMilli())
+		if err != nil {
+			return nil, err
+		}
+		points := value.vector
+		if value.scalar {
+			points = []metricsPoint{{labels: map[string]string{}, value: value.value}}
+		}
+		for _, p := range points {
+			key := metricsLabelsKey(p.labels)
+			result, ok := results[key]
+			if !ok {
+				result = &DataResult{Metric: p.labels}
+				results[key] = result
+				keys = append(keys, key)
+			}
+			result.Values = append(result.Values, []interface{}{float64(t.UnixMilli()) / 1000, strconv.FormatFloat(p.value, 'f', -1, 64)})
+		}
+	}
+	sort.Strings(keys)
+	resp := &PromResp{Status: "success", Data: PromRespData{ResultType: "matrix", Result: []DataResult{}}}
+	for _, key := range keys {
+		resp.Data.Result = append(resp.Data.Result, *results[key])
+	}
+	return resp, nil
+}
+
+func (s *metricsStore) save(file string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(s.series); err != nil {
+		return err
+	}
+	return writeDataFileBytes(file, buf.Bytes())
+}
+
+// load restores the series persisted by save, the samples are re-added so the capacity and
+// retention in use apply to them
+func (s *metricsStore) load(file string, now time.Time) error {
+	f, err := os.Open(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	var persisted map[string]*metricsSeries
+	if err = gob.NewDecoder(f).Decode(&persisted); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	oldest := now.Add(-s.retention).UnixMilli()
+	for key, p := range persisted {
+		series := &metricsSeries{Name: p.Name, Labels: p.Labels}
+		for i := range p.Samples {
+			if sample := p.at(i); sample.T >= oldest {
+				series.add(sample, s.capacity)
+			}
+		}
+		if len(series.Samples) > 0 {
+			s.series[key] = series
+		}
+	}
+	return nil
+}
+
+// parseMetricsText parses the Prometheus text exposition format, timestamps are ignored
+// as all the samples are recorded with the scrape time
+func parseMetricsText(r io.Reader) ([]metricsScraped, error) {
+	var scraped []metricsScraped
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m := metricsScraped{labels: map[string]string{}}
+		i := 0
+		for i < len(line) && isMetricsIdentChar(line[i], i == 0) {
+			i++
+		}
+		m.name = line[:i]
+		if m.name == "" {
+			return nil, fmt.Errorf("invalid metric line %q", line)
+		}
+		if i < len(line) && line[i] == '{' {
+			i++
+			for {
+				for i < len(line) && (line[i] == ' ' || line[i] == ',') {
+					i++
+				}
+				if i < len(line) && line[i] == '}' {
+					i++
+					break
+				}
+				eq := strings.IndexByte(line[i:], '=')
+				if eq < 0 || i+eq+1 >= len(line) || line[i+eq+1] != '"' {
+					return nil, fmt.Errorf("invalid labels on %q", line)
+				}
+				label := strings.TrimSpace(line[i : i+eq])
+				j := i + eq + 2
+				var value strings.Builder
+				for ; j < len(line) && line[j] != '"'; j++ {
+					if line[j] == '\\' && j+1 < len(line) {
+						j++
+						switch line[j] {
+						case 'n':
+							value.WriteByte('\n')
+						default:
+							value.WriteByte(line[j])
+						}
+						continue
+					}
+					value.WriteByte(line[j])
+				}
+				if j >= len(line) {
+					return nil, fmt.Errorf("invalid labels on %q", line)
+				}
+				m.labels[label] = value.String()
+				i = j + 1
+			}
+		}
+		fields := strings.Fields(line[i:])
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("missing value on %q", line)
+		}
+		value, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value on %q: %v", line, err)
+		}
+		m.value = value
+		scraped = append(scraped, m)
+	}
+	return scraped, scanner.Err()
+}
+
+// metricsCollector scrapes the MinIO cluster metrics periodically so the dashboard
+// widgets can be served without a Prometheus server
+type metricsCollector struct {
+	endpoint    string
+	interval    time.Duration
+	job         string
+	instance    string
+	accessKey   string
+	secretKey   string
+	persistFile string
+	client      *http.Client
+	store       *metricsStore
+}
+
+// startMetricsCollector starts the built-in metrics collector when enabled and no Prometheus is configured
+func startMetricsCollector(ctx context.Context) {
+	if !getMetricsCollectorEnabled() {
+		return
+	}
+	if getPrometheusURL() != "" {
+		LogInfo("built-in metrics collector disabled, %s is set", PrometheusURL)
+		return
+	}
+	accessKey, secretKey := getMetricsCollectorCredentials()
+	c := &metricsCollector{
+		endpoint:  strings.TrimSuffix(getMinIOServer(), "/") + metricsClusterPath,
+		interval:  getMetricsCollectorInterval(),
+		job:       getPrometheusJobID(),
+		instance:  getMinIOEndpoint(),
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    GetConsoleHTTPClient(),
+	}
+	c.store = newMetricsStore(getMetricsCollectorRetention(), c.interval)
+	if getMetricsCollectorPersist() {
+		c.persistFile = filepath.Join(getDataDir(), "metrics", metricsSeriesFile)
+		if err := c.store.load(c.persistFile, time.Now()); err != nil {
+			LogError("unable to load the persisted metrics: %v", err)
+		}
+	}
+	globalMetricsCollector = c
+	go c.run(ctx)
+}
+
+func (c *metricsCollector) run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	persisted := time.Now()
+	for {
+		if err := c.scrape(ctx); err != nil {
+			LogError("unable to scrape the cluster metrics: %v", err)
+		}
+		if c.persistFile != "" && time.Since(persisted) >= metricsPersistEvery {
+			if err := c.store.save(c.persistFile); err != nil {
+				LogError("unable to persist the metrics: %v", err)
+			}
+			persisted = time.Now()
+		}
+		select {
+		case <-ctx.Done():
+			if c.persistFile != "" {
+				if err := c.store.save(c.persistFile); err != nil {
+					LogError("unable to persist the metrics: %v", err)
+				}
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// token returns the bearer token MinIO expects on the metrics endpoint, same as `mc admin prometheus generate`
+func (c *metricsCollector) token() (string, error) {
+	claims := jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(c.interval + time.Minute)),
+		Subject:   c.accessKey,
+		Issuer:    "prometheus",
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS512, claims).SignedString([]byte(c.secretKey))
+}
+
+func (c *metricsCollector) scrape(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.interval)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if c.accessKey != "" {
+		token, err := c.token()
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from %s (%s)", c.endpoint, resp.Status)
+	}
+	scraped, err := parseMetricsText(resp.Body)
+	if err != nil {
+		return err
+	}
+	// the labels Prometheus would attach to the target
+	for _, m := range scraped {
+		if _, ok := m.labels["job"]; !ok {
+			m.labels["job"] = c.job
+		}
+		if _, ok := m.labels["instance"]; !ok {
+			m.labels["instance"] = c.instance
+		}
+	}
+	c.store.add(time.Now(), scraped)
+	return nil
+}
+
+func (c *metricsCollector) labelValues(_ context.Context, label string) ([]string, error) {
+	return c.store.labelValues(label), nil
+}
+
+func (c *metricsCollector) queryRange(_ context.Context, query string, start, end int64, step int32) (*PromResp, error) {
+	return c.store.queryRange(query, time.Unix(start, 0), time.Unix(end, 0), time.Duration(step)*time.Second)
+}
@@ -0,0 +1,243 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+const testClusterMetrics = `# HELP minio_node_process_starttime_seconds Start time for MinIO process
+# TYPE minio_node_process_starttime_seconds gauge
+minio_node_process_starttime_seconds{server="node1:9000"} %d
+minio_node_process_starttime_seconds{server="node2:9000"} %d
+# TYPE minio_s3_requests_total counter
+minio_s3_requests_total{api="getobject",server="node1:9000"} %d
+minio_s3_requests_total{api="putobject",server="node1:9000"} %d
+minio_s3_requests_total{api="getobject",server="node2:9000"} %d
+minio_cluster_capacity_usable_total_bytes{server="node1:9000"} 1000
+minio_cluster_capacity_usable_free_bytes{server="node1:9000"} 400
+minio_bucket_usage_total_bytes{bucket="photos",server="node1:9000"} 10
+minio_bucket_usage_total_bytes{bucket="logs",server="node1:9000"} 20
+minio_bucket_usage_total_bytes{bucket="logs",server="node2:9000"} 20
+`
+
+func TestParseMetricsText(t *testing.T) {
+	assert := assert.New(t)
+	scraped, err := parseMetricsText(strings.NewReader(`# TYPE test gauge
+minio_node_go_routine_total{server="node1:9000"} 12 1656000000000
+minio_heal_objects_total 3
+minio_node_disk_used_bytes{disk="/data\"1\"",server="node1:9000",} 1.5e+06
+minio_s3_ttfb_seconds_distribution{api="getobject",le="+Inf"} NaN
+`))
+	assert.Nil(err)
+	if assert.Len(scraped, 4) {
+		assert.Equal(metricsScraped{name: "minio_node_go_routine_total", labels: map[string]string{"server": "node1:9000"}, value: 12}, scraped[0])
+		assert.Equal(metricsScraped{name: "minio_heal_objects_total", labels: map[string]string{}, value: 3}, scraped[1])
+		assert.Equal(map[string]string{"disk": `/data"1"`, "server": "node1:9000"}, scraped[2].labels)
+		assert.Equal(float64(1500000), scraped[2].value)
+	}
+
+	_, err = parseMetricsText(strings.NewReader(`minio_heal_objects_total{server="node1} 3`))
+	assert.Error(err)
+	_, err = parseMetricsText(strings.NewReader(`minio_heal_objects_total three`))
+	assert.Error(err)
+}
+
+func TestMetricsStoreQueryRange(t *testing.T) {
+	assert := assert.New(t)
+	store := newMetricsStore(time.Hour, 30*time.Second)
+	start := time.Unix(1656000000, 0)
+	for i := 0; i < 10; i++ {
+		scraped, err := parseMetricsText(strings.NewReader(fmt.Sprintf(testClusterMetrics, 1655990000, 1655995000, 100+30*i, 60*i, 10)))
+		assert.Nil(err)
+		for _, m := range scraped {
+			m.labels["job"] = "minio-job"
+		}
+		store.add(start.Add(time.Duration(i)*30*time.Second), scraped)
+	}
+	end := start.Add(270 * time.Second)
+	query := func(q string) []DataResult {
+		resp, err := store.queryRange(q, end, end, time.Minute)
+		assert.Nil(err, q)
+		if resp == nil {
+			return nil
+		}
+		assert.Equal("matrix", resp.Data.ResultType)
+		return resp.Data.Result
+	}
+	value := func(r DataResult) interface{} {
+		return r.Values[0].([]interface{})[1]
+	}
+
+	// Test-1: time and aggregations
+	result := query(`time() - max(minio_node_process_starttime_seconds{job="minio-job"})`)
+	if assert.Len(result, 1) {
+		assert.Equal(map[string]string{}, result[0].Metric)
+		assert.Equal("5270", value(result[0]))
+	}
+	result = query(`count(count by (bucket) (minio_bucket_usage_total_bytes{job="minio-job"}))`)
+	if assert.Len(result, 1) {
+		assert.Equal("2", value(result[0]))
+	}
+	result = query(`sum by (bucket) (minio_bucket_usage_total_bytes{job="minio-job"})`)
+	if assert.Len(result, 2) {
+		assert.Equal(map[string]string{"bucket": "logs"}, result[0].Metric)
+		assert.Equal("40", value(result[0]))
+		assert.Equal("10", value(result[1]))
+	}
+
+	// Test-2: binary operations match on the labels
+	result = query(`topk(1, sum(minio_cluster_capacity_usable_total_bytes{job="minio-job"}) by (server)) - topk(1, sum(minio_cluster_capacity_usable_free_bytes{job="minio-job"}) by (server))`)
+	if assert.Len(result, 1) {
+		assert.Equal(map[string]string{"server": "node1:9000"}, result[0].Metric)
+		assert.Equal("600", value(result[0]))
+	}
+
+	// Test-3: rates over counters
+	result = query(`sum by (server,api) (rate(minio_s3_requests_total{job="minio-job",api=~"get.*"}[240s]))`)
+	if assert.Len(result, 2) {
+		assert.Equal(map[string]string{"api": "getobject", "server": "node1:9000"}, result[0].Metric)
+		assert.Equal("1", value(result[0]))
+		assert.Equal("0", value(result[1]))
+	}
+	result = query(`increase(minio_s3_requests_total{api="putobject"}[2m])`)
+	if assert.Len(result, 1) {
+		assert.Equal(map[string]string{"api": "putobject", "job": "minio-job", "server": "node1:9000"}, result[0].Metric)
+		assert.Equal("240", value(result[0]))
+	}
+
+	// Test-4: a range over the retention returns a value per step
+	resp, err := store.queryRange(`minio_node_process_starttime_seconds{server="node1:9000"}`, start, end, time.Minute)
+	assert.Nil(err)
+	if assert.Len(resp.Data.Result, 1) {
+		assert.Equal("minio_node_process_starttime_seconds", resp.Data.Result[0].Metric["__name__"])
+		assert.Len(resp.Data.Result[0].Values, 5)
+	}
+
+	// Test-5: label values
+	assert.Equal([]string{"node1:9000", "node2:9000"}, store.labelValues("server"))
+
+	// Test-6: unsupported queries are errors
+	for _, q := range []string{`rate(minio_s3_requests_total)`, `minio_s3_requests_total[5m]`, `histogram_quantile(0.9, x)`, `sum(`} {
+		_, err = store.queryRange(q, end, end, time.Minute)
+		assert.Error(err, q)
+	}
+}
+
+func TestMetricsStoreRingBuffer(t *testing.T) {
+	assert := assert.New(t)
+	store := newMetricsStore(2*time.Minute, 30*time.Second)
+	now := time.Unix(1656000000, 0)
+	for i := 0; i < 6; i++ {
+		store.add(now.Add(time.Duration(i)*30*time.Second), []metricsScraped{{name: "minio_heal_objects_total", labels: map[string]string{}, value: float64(i)}})
+	}
+	now = now.Add(150 * time.Second)
+	series := store.series["minio_heal_objects_total{}"]
+	if assert.NotNil(series) {
+		assert.Len(series.Samples, 4)
+		assert.Equal([]metricsSample{{T: now.Add(-90 * time.Second).UnixMilli(), V: 2}, {T: now.Add(-time.Minute).UnixMilli(), V: 3}}, series.between(0, now.Add(-time.Minute).UnixMilli()))
+	}
+
+	// Test-1: persisted series are restored within the retention
+	file := filepath.Join(t.TempDir(), "metrics", metricsSeriesFile)
+	assert.Nil(store.save(file))
+	restored := newMetricsStore(time.Minute, 30*time.Second)
+	assert.Nil(restored.load(file, now))
+	if assert.Contains(restored.series, "minio_heal_objects_total{}") {
+		assert.Equal([]metricsSample{{T: now.Add(-30 * time.Second).UnixMilli(), V: 4}, {T: now.UnixMilli(), V: 5}}, restored.series["minio_heal_objects_total{}"].between(0, now.UnixMilli()))
+	}
+
+	// Test-2: series without recent samples are dropped
+	store.add(now.Add(3*time.Minute), nil)
+	assert.Empty(store.series)
+}
+
+func TestMetricsCollectorScrape(t *testing.T) {
+	assert := assert.New(t)
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != metricsClusterPath {
+			http.NotFound(w, r)
+			return
+		}
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprintf(w, testClusterMetrics, 1655990000, 1655995000, 100, 60, 10)
+	}))
+	defer server.Close()
+
+	collector := &metricsCollector{
+		endpoint:  server.URL + metricsClusterPath,
+		interval:  30 * time.Second,
+		job:       "minio-job",
+		instance:  "localhost:9000",
+		accessKey: "minio",
+		secretKey: "minio123",
+		client:    server.Client(),
+		store:     newMetricsStore(time.Hour, 30*time.Second),
+	}
+	ctx := context.Background()
+
+	// Test-1: the scrape is authenticated with a token signed with the secret key
+	assert.Nil(collector.scrape(ctx))
+	claims := jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(strings.TrimPrefix(authorization, "Bearer "), &claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte("minio123"), nil
+	})
+	assert.Nil(err)
+	assert.Equal("minio", claims.Subject)
+	assert.Equal("prometheus", claims.Issuer)
+
+	// Test-2: the series get the job and instance labels and can be queried as widgets
+	assert.Equal([]string{"minio-job"}, collector.store.labelValues("job"))
+	assert.Equal([]string{"localhost:9000"}, collector.store.labelValues("instance"))
+	// queries run with a precision of seconds
+	time.Sleep(time.Second)
+	step := int32(60)
+	details, mErr := getWidgetDetails(ctx, collector, `job="minio-job"`, widgets, 1, &step, nil, nil)
+	assert.Nil(mErr)
+	if assert.NotNil(details) && assert.Len(details.Targets, 1) {
+		assert.Equal("Uptime", details.Title)
+		assert.Equal("matrix", details.Targets[0].ResultType)
+		assert.NotEmpty(details.Targets[0].Result)
+	}
+
+	// Test-3: errors from the endpoint are returned
+	collector.endpoint = server.URL + "/missing"
+	assert.Error(collector.scrape(ctx))
+}
+
+func TestMetricsQueriesOfWidgets(t *testing.T) {
+	assert := assert.New(t)
+	// every built-in widget must be supported by the collector
+	for _, w := range widgets {
+		for _, target := range w.Targets {
+			query := expandWidgetQuery(target.Expr, `job="minio-job"`, nil)
+			_, err := parseMetricsQuery(query)
+			assert.Nil(err, "%s: %s", w.Title, query)
+		}
+	}
+}
@@ -0,0 +1,703 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// metricsLookback is how far back an instant selector looks for the latest sample, same as Prometheus
+const metricsLookback = 5 * time.Minute
+
+// metricsPoint is a sample of an instant vector
+type metricsPoint struct {
+	labels map[string]string
+	value  float64
+}
+
+// metricsValue is the result of evaluating an expression at a given time, either a scalar or an instant vector
+type metricsValue struct {
+	scalar bool
+	value  float64
+	vector []metricsPoint
+}
+
+// metricsExpr is a parsed PromQL expression. Only the subset used by the dashboard widgets is supported:
+// selectors, rate/irate/increase, time(), sum/min/max/avg/count/topk/bottomk aggregations and arithmetic.
+type metricsExpr interface {
+	eval(s *metricsStore, t int64) (metricsValue, error)
+}
+
+type metricsNumberExpr struct {
+	value float64
+}
+
+func (e *metricsNumberExpr) eval(_ *metricsStore, _ int64) (metricsValue, error) {
+	return metricsValue{scalar: true, value: e.value}, nil
+}
+
+type metricsTimeExpr struct{}
+
+func (e *metricsTimeExpr) eval(_ *metricsStore, t int64) (metricsValue, error) {
+	return metricsValue{scalar: true, value: float64(t) / 1000}, nil
+}
+
+type metricsMatcher struct {
+	name  string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func (m *metricsMatcher) matches(v string) bool {
+	switch m.op {
+	case "=":
+		return v == m.value
+	case "!=":
+		return v != m.value
+	case "=~":
+		return m.re.MatchString(v)
+	default:
+		return !m.re.MatchString(v)
+	}
+}
+
+type metricsSelectorExpr struct {
+	name     string
+	matchers []*metricsMatcher
+	// rng is set on range selectors, `metric[5m]`
+	rng time.Duration
+}
+
+func (e *metricsSelectorExpr) matches(series *metricsSeries) bool {
+	if e.name != "" && series.Name != e.name {
+		return false
+	}
+	for _, m := range e.matchers {
+		v := series.Labels[m.name]
+		if m.name == "__name__" {
+			v = series.Name
+		}
+		if !m.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *metricsSelectorExpr) eval(s *metricsStore, t int64) (metricsValue, error) {
+	if e.rng > 0 {
+		return metricsValue{}, fmt.Errorf("range vector %s can only be used within a function", e.name)
+	}
+	result := metricsValue{}
+	for _, series := range s.series {
+		if !e.matches(series) {
+			continue
+		}
+		samples := series.between(t-metricsLookback.Milliseconds(), t)
+		if len(samples) == 0 {
+			continue
+		}
+		labels := map[string]string{"__name__": series.Name}
+		for k, v := range series.Labels {
+			labels[k] = v
+		}
+		result.vector = append(result.vector, metricsPoint{labels: labels, value: samples[len(samples)-1].V})
+	}
+	return result, nil
+}
+
+type metricsFuncExpr struct {
+	name string
+	arg  *metricsSelectorExpr
+}
+
+func (e *metricsFuncExpr) eval(s *metricsStore, t int64) (metricsValue, error) {
+	result := metricsValue{}
+	for _, series := range s.series {
+		if !e.arg.matches(series) {
+			continue
+		}
+		samples := series.between(t-e.arg.rng.Milliseconds(), t)
+		if len(samples) < 2 {
+			continue
+		}
+		var value float64
+		switch e.name {
+		case "irate":
+			last, prev := samples[len(samples)-1], samples[len(samples)-2]
+			delta := last.V - prev.V
+			if delta < 0 {
+				// counter reset
+				delta = last.V
+			}
+			value = delta / (float64(last.T-prev.T) / 1000)
+		default:
+			var increase float64
+			for i := 1; i < len(samples); i++ {
+				if samples[i].V < samples[i-1].V {
+					increase += samples[i].V
+				} else {
+					increase += samples[i].V - samples[i-1].V
+				}
+			}
+			value = increase / (float64(samples[len(samples)-1].T-samples[0].T) / 1000)
+			if e.name == "increase" {
+				// extrapolate the rate to the whole range
+				value *= e.arg.rng.Seconds()
+			}
+		}
+		labels := make(map[string]string, len(series.Labels))
+		for k, v := range series.Labels {
+			labels[k] = v
+		}
+		result.vector = append(result.vector, metricsPoint{labels: labels, value: value})
+	}
+	return result, nil
+}
+
+type metricsAggregateExpr struct {
+	op       string
+	without  bool
+	grouping []string
+	// param is the `k` of topk and bottomk
+	param int
+	expr  metricsExpr
+}
+
+func (e *metricsAggregateExpr) groupLabels(labels map[string]string) map[string]string {
+	group := map[string]string{}
+	if e.without {
+		for k, v := range labels {
+			group[k] = v
+		}
+		delete(group, "__name__")
+		for _, l := range e.grouping {
+			delete(group, l)
+		}
+		return group
+	}
+	for _, l := range e.grouping {
+		if v, ok := labels[l]; ok {
+			group[l] = v
+		}
+	}
+	return group
+}
+
+func (e *metricsAggregateExpr) eval(s *metricsStore, t int64) (metricsValue, error) {
+	inner, err := e.expr.eval(s, t)
+	if err != nil {
+		return metricsValue{}, err
+	}
+	if inner.scalar {
+		return metricsValue{}, fmt.Errorf("%s expects a vector", e.op)
+	}
+	var keys []string
+	groups := map[string][]metricsPoint{}
+	groupLabels := map[string]map[string]string{}
+	for _, p := range inner.vector {
+		labels := e.groupLabels(p.labels)
+		key := metricsLabelsKey(labels)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+			groupLabels[key] = labels
+		}
+		groups[key] = append(groups[key], p)
+	}
+	sort.Strings(keys)
+
+	result := metricsValue{}
+	for _, key := range keys {
+		points := groups[key]
+		if e.op == "topk" || e.op == "bottomk" {
+			sort.SliceStable(points, func(i, j int) bool {
+				if e.op == "topk" {
+					return points[i].value > points[j].value
+				}
+				return points[i].value < points[j].value
+			})
+			if len(points) > e.param {
+				points = points[:e.param]
+			}
+			result.vector = append(result.vector, points...)
+			continue
+		}
+		value := points[0].value
+		for _, p := range points[1:] {
+			switch e.op {
+			case "sum", "avg":
+				value += p.value
+			case "min":
+				value = math.Min(value, p.value)
+			case "max":
+				value = math.Max(value, p.value)
+			}
+		}
+		switch e.op {
+		case "avg":
+			value /= float64(len(points))
+		case "count":
+			value = float64(len(points))
+		}
+		result.vector = append(result.vector, metricsPoint{labels: groupLabels[key], value: value})
+	}
+	return result, nil
+}
+
+type metricsBinaryExpr struct {
+	op  string
+	lhs metricsExpr
+	rhs metricsExpr
+}
+
+func metricsApply(op string, lhs, rhs float64) float64 {
+	switch op {
+	case "+":
+		return lhs + rhs
+	case "-":
+		return lhs - rhs
+	case "*":
+		return lhs * rhs
+	default:
+		return lhs / rhs
+	}
+}
+
+func (e *metricsBinaryExpr) eval(s *metricsStore, t int64) (metricsValue, error) {
+	lhs, err := e.lhs.eval(s, t)
+	if err != nil {
+		return metricsValue{}, err
+	}
+	rhs, err := e.rhs.eval(s, t)
+	if err != nil {
+		return metricsValue{}, err
+	}
+	switch {
+	case lhs.scalar && rhs.scalar:
+		return metricsValue{scalar: true, value: metricsApply(e.op, lhs.value, rhs.value)}, nil
+	case rhs.scalar:
+		result := metricsValue{}
+		for _, p := range lhs.vector {
+			result.vector = append(result.vector, metricsPoint{labels: metricsDropName(p.labels), value: metricsApply(e.op, p.value, rhs.value)})
+		}
+		return result, nil
+	case lhs.scalar:
+		result := metricsValue{}
+		for _, p := range rhs.vector {
+			result.vector = append(result.vector, metricsPoint{labels: metricsDropName(p.labels), value: metricsApply(e.op, lhs.value, p.value)})
+		}
+		return result, nil
+	}
+	// one-to-one matching on all the labels but the metric name
+	rhsPoints := map[string]float64{}
+	for _, p := range rhs.vector {
+		rhsPoints[metricsLabelsKey(metricsDropName(p.labels))] = p.value
+	}
+	result := metricsValue{}
+	for _, p := range lhs.vector {
+		labels := metricsDropName(p.labels)
+		if v, ok := rhsPoints[metricsLabelsKey(labels)]; ok {
+			result.vector = append(result.vector, metricsPoint{labels: labels, value: metricsApply(e.op, p.value, v)})
+		}
+	}
+	return result, nil
+}
+
+func metricsDropName(labels map[string]string) map[string]string {
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if k != "__name__" {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// metricsLabelsKey returns a key identifying a label set
+func metricsLabelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteString("=")
+		sb.WriteString(strconv.Quote(labels[k]))
+		sb.WriteString(",")
+	}
+	return sb.String()
+}
+
+var (
+	metricsAggregations = map[string]bool{"sum": true, "min": true, "max": true, "avg": true, "count": true, "topk": true, "bottomk": true}
+	metricsFunctions    = map[string]bool{"rate": true, "irate": true, "increase": true}
+)
+
+type metricsTokenKind int
+
+const (
+	metricsTokenEOF metricsTokenKind = iota
+	metricsTokenIdent
+	metricsTokenNumber
+	metricsTokenString
+	metricsTokenRange
+	metricsTokenPunct
+)
+
+type metricsToken struct {
+	kind metricsTokenKind
+	text string
+}
+
+func isMetricsIdentChar(c byte, first bool) bool {
+	return c == '_' || c == ':' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (!first && c >= '0' && c <= '9')
+}
+
+func lexMetricsQuery(query string) ([]metricsToken, error) {
+	var tokens []metricsToken
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isMetricsIdentChar(c, true):
+			j := i
+			for j < len(query) && isMetricsIdentChar(query[j], false) {
+				j++
+			}
+			tokens = append(tokens, metricsToken{kind: metricsTokenIdent, text: query[i:j]})
+			i = j
+		case (c >= '0' && c <= '9') || c == '.':
+			j := i
+			for j < len(query) && (query[j] >= '0' && query[j] <= '9' || query[j] == '.' || query[j] == 'e') {
+				j++
+			}
+			tokens = append(tokens, metricsToken{kind: metricsTokenNumber, text: query[i:j]})
+			i = j
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(query) && query[j] != c {
+				if query[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(query) {
+				return nil, fmt.Errorf("unterminated string in %q", query)
+			}
+			raw := query[i+1 : j]
+			if c == '\'' {
+				raw = strings.ReplaceAll(raw, `"`, `\"`)
+			}
+			value, err := strconv.Unquote(`"` + raw + `"`)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, metricsToken{kind: metricsTokenString, text: value})
+			i = j + 1
+		case c == '[':
+			j := strings.IndexByte(query[i:], ']')
+			if j < 0 {
+				return nil, fmt.Errorf("unterminated range in %q", query)
+			}
+			tokens = append(tokens, metricsToken{kind: metricsTokenRange, text: strings.TrimSpace(query[i+1 : i+j])})
+			i += j + 1
+		case strings.HasPrefix(query[i:], "!=") || strings.HasPrefix(query[i:], "=~") || strings.HasPrefix(query[i:], "!~"):
+			tokens = append(tokens, metricsToken{kind: metricsTokenPunct, text: query[i : i+2]})
+			i += 2
+		case strings.ContainsRune("(){},=+-*/", rune(c)):
+			tokens = append(tokens, metricsToken{kind: metricsTokenPunct, text: string(c)})
+			i++
+		default:
+			return nil, fmt.Errorf("unexpected character %q in %q", c, query)
+		}
+	}
+	return append(tokens, metricsToken{kind: metricsTokenEOF}), nil
+}
+
+// parseMetricsDuration parses PromQL durations, which also accept days and weeks
+func parseMetricsDuration(s string) (time.Duration, error) {
+	for unit, d := range map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour} {
+		if strings.HasSuffix(s, unit) {
+			n, err := strconv.Atoi(strings.TrimSuffix(s, unit))
+			if err != nil {
+				return 0, err
+			}
+			return time.Duration(n) * d, nil
+		}
+	}
+	return time.ParseDuration(s)
+}
+
+type metricsParser struct {
+	tokens []metricsToken
+	pos    int
+}
+
+// parseMetricsQuery parses a PromQL expression
+func parseMetricsQuery(query string) (metricsExpr, error) {
+	tokens, err := lexMetricsQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &metricsParser{tokens: tokens}
+	expr, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != metricsTokenEOF {
+		return nil, fmt.Errorf("unexpected %q in %q", p.peek().text, query)
+	}
+	return expr, nil
+}
+
+func (p *metricsParser) peek() metricsToken {
+	return p.tokens[p.pos]
+}
+
+func (p *metricsParser) next() metricsToken {
+	t := p.tokens[p.pos]
+	if t.kind != metricsTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *metricsParser) isPunct(text string) bool {
+	t := p.peek()
+	return t.kind == metricsTokenPunct && t.text == text
+}
+
+func (p *metricsParser) expect(text string) error {
+	if t := p.next(); t.kind != metricsTokenPunct || t.text != text {
+		return fmt.Errorf("expected %q, found %q", text, t.text)
+	}
+	return nil
+}
+
+func (p *metricsParser) parseAdditive() (metricsExpr, error) {
+	lhs, err := p.parseMultiplicative()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("+") || p.isPunct("-") {
+		op := p.next().text
+		rhs, err := p.parseMultiplicative()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &metricsBinaryExpr{op: op, lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *metricsParser) parseMultiplicative() (metricsExpr, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isPunct("*") || p.isPunct("/") {
+		op := p.next().text
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &metricsBinaryExpr{op: op, lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *metricsParser) parseUnary() (metricsExpr, error) {
+	if p.isPunct("-") {
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &metricsBinaryExpr{op: "-", lhs: &metricsNumberExpr{}, rhs: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *metricsParser) parsePrimary() (metricsExpr, error) {
+	t := p.peek()
+	switch {
+	case t.kind == metricsTokenNumber:
+		p.next()
+		value, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &metricsNumberExpr{value: value}, nil
+	case t.kind == metricsTokenPunct && t.text == "(":
+		p.next()
+		expr, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		return expr, p.expect(")")
+	case t.kind == metricsTokenPunct && t.text == "{":
+		return p.parseSelector("")
+	case t.kind == metricsTokenIdent:
+		p.next()
+		switch {
+		case metricsAggregations[t.text]:
+			return p.parseAggregation(t.text)
+		case t.text == "time" && p.isPunct("("):
+			p.next()
+			return &metricsTimeExpr{}, p.expect(")")
+		case metricsFunctions[t.text] && p.isPunct("("):
+			return p.parseFunction(t.text)
+		}
+		return p.parseSelector(t.text)
+	}
+	return nil, fmt.Errorf("unexpected %q", t.text)
+}
+
+func (p *metricsParser) parseSelector(name string) (metricsExpr, error) {
+	selector := &metricsSelectorExpr{name: name}
+	if p.isPunct("{") {
+		p.next()
+		for !p.isPunct("}") {
+			label := p.next()
+			if label.kind != metricsTokenIdent {
+				return nil, fmt.Errorf("expected label name, found %q", label.text)
+			}
+			op := p.next()
+			if op.kind != metricsTokenPunct || (op.text != "=" && op.text != "!=" && op.text != "=~" && op.text != "!~") {
+				return nil, fmt.Errorf("expected label matcher, found %q", op.text)
+			}
+			value := p.next()
+			if value.kind != metricsTokenString {
+				return nil, fmt.Errorf("expected label value, found %q", value.text)
+			}
+			matcher := &metricsMatcher{name: label.text, op: op.text, value: value.text}
+			if op.text == "=~" || op.text == "!~" {
+				re, err := regexp.Compile("^(?:" + value.text + ")$")
+				if err != nil {
+					return nil, err
+				}
+				matcher.re = re
+			}
+			selector.matchers = append(selector.matchers, matcher)
+			if !p.isPunct(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect("}"); err != nil {
+			return nil, err
+		}
+	}
+	if selector.name == "" && len(selector.matchers) == 0 {
+		return nil, fmt.Errorf("empty selector")
+	}
+	if p.peek().kind == metricsTokenRange {
+		rng, err := parseMetricsDuration(p.next().text)
+		if err != nil {
+			return nil, err
+		}
+		selector.rng = rng
+	}
+	return selector, nil
+}
+
+func (p *metricsParser) parseFunction(name string) (metricsExpr, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	arg, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	selector, ok := arg.(*metricsSelectorExpr)
+	if !ok || selector.rng == 0 {
+		return nil, fmt.Errorf("%s expects a range vector", name)
+	}
+	return &metricsFuncExpr{name: name, arg: selector}, p.expect(")")
+}
+
+func (p *metricsParser) parseGrouping(aggr *metricsAggregateExpr) error {
+	t := p.peek()
+	if t.kind != metricsTokenIdent || (t.text != "by" && t.text != "without") {
+		return nil
+	}
+	p.next()
+	aggr.without = t.text == "without"
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.isPunct(")") {
+		label := p.next()
+		if label.kind != metricsTokenIdent {
+			return fmt.Errorf("expected label name, found %q", label.text)
+		}
+		aggr.grouping = append(aggr.grouping, label.text)
+		if !p.isPunct(",") {
+			break
+		}
+		p.next()
+	}
+	return p.expect(")")
+}
+
+func (p *metricsParser) parseAggregation(op string) (metricsExpr, error) {
+	aggr := &metricsAggregateExpr{op: op}
+	if err := p.parseGrouping(aggr); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	if op == "topk" || op == "bottomk" {
+		k := p.next()
+		if k.kind != metricsTokenNumber {
+			return nil, fmt.Errorf("%s expects a number, found %q", op, k.text)
+		}
+		param, err := strconv.Atoi(k.text)
+		if err != nil {
+			return nil, err
+		}
+		aggr.param = param
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+	expr, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	aggr.expr = expr
+	if err = p.expect(")"); err != nil {
+		return nil, err
+	}
+	if len(aggr.grouping) == 0 && !aggr.without {
+		if err = p.parseGrouping(aggr); err != nil {
+			return nil, err
+		}
+	}
+	return aggr, nil
+}
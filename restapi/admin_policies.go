@@ -508,13 +508,11 @@ func getListGroupsForPolicyResponse(session *models.Principal, params policyApi.
 	return filteredGroups, nil
 }
 
-// removePolicy() calls MinIO server to remove a policy based on name.
-func removePolicy(ctx context.Context, client MinioAdmin, name string) error {
-	err := client.removePolicy(ctx, name)
-	if err != nil {
-		return err
-	}
-	return nil
+// removePolicy() calls MinIO server to remove a policy based on name, the removal is
+// recorded in the policy history.
+func removePolicy(ctx context.Context, client MinioAdmin, name, author string) error {
+	_, err := savePolicy(ctx, client, getPolicyHistoryDir(), name, author, "", 0, nil)
+	return err
 }
 
 // getRemovePolicyResponse() performs removePolicy() and serializes it to the handler's output
@@ -536,7 +534,7 @@ func getRemovePolicyResponse(session *models.Principal, params policyApi.RemoveP
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
-	if err := removePolicy(ctx, adminClient, policyName); err != nil {
+	if err := removePolicy(ctx, adminClient, policyName, session.AccountAccessKey); err != nil {
 		return ErrorWithContext(ctx, err)
 	}
 	return nil
@@ -546,12 +544,13 @@ func getRemovePolicyResponse(session *models.Principal, params policyApi.RemoveP
 // addPolicy() takes name and policy in string format, policy
 // policy must be string in json format, in the future this will change
 // to a Policy struct{} - https://github.com/minio/minio/issues/9171
-func addPolicy(ctx context.Context, client MinioAdmin, name, policy string) (*models.Policy, error) {
+// The change is recorded in the policy history with `author`.
+func addPolicy(ctx context.Context, client MinioAdmin, name, policy, author string) (*models.Policy, error) {
 	iamp, err := iampolicy.ParseConfig(bytes.NewReader([]byte(policy)))
 	if err != nil {
 		return nil, err
 	}
-	if err := client.addPolicy(ctx, name, iamp); err != nil {
+	if _, err := savePolicy(ctx, client, getPolicyHistoryDir(), name, author, "", 0, iamp); err != nil {
 		return nil, err
 	}
 	policyObject, err := policyInfo(ctx, client, name)
@@ -578,7 +577,7 @@ func getAddPolicyResponse(session *models.Principal, params policyApi.AddPolicyP
 	// create a MinIO Admin Client interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
-	policy, err := addPolicy(ctx, adminClient, *params.Body.Name, *params.Body.Policy)
+	policy, err := addPolicy(ctx, adminClient, *params.Body.Name, *params.Body.Policy, session.AccountAccessKey)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
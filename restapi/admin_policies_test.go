@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
 	iampolicy "github.com/minio/pkg/iam/policy"
 	"github.com/stretchr/testify/assert"
 )
@@ -111,25 +112,40 @@ func TestListPolicies(t *testing.T) {
 	}
 }
 
+// mockPolicyAttachments mocks a deployment where no policy is attached, for the policy history
+func mockPolicyAttachments() {
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{}, nil
+	}
+}
+
 func TestRemovePolicy(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	funcAssert := assert.New(t)
 	adminClient := adminClientMock{}
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	mockPolicyAttachments()
 	// Test-1 : removePolicy() remove an existing policy
 	policyToRemove := "console-policy"
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		return iampolicy.ParseConfig(bytes.NewReader([]byte(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::*"]}]}`)))
+	}
 	minioRemovePolicyMock = func(name string) error {
 		return nil
 	}
 	function := "removePolicy()"
-	if err := removePolicy(ctx, adminClient, policyToRemove); err != nil {
+	if err := removePolicy(ctx, adminClient, policyToRemove, "admin"); err != nil {
 		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
 	}
 	// Test-2 : removePolicy() Return error and see that the error is handled correctly and returned
 	minioRemovePolicyMock = func(name string) error {
 		return errors.New("error")
 	}
-	if err := removePolicy(ctx, adminClient, policyToRemove); funcAssert.Error(err) {
+	if err := removePolicy(ctx, adminClient, policyToRemove, "admin"); funcAssert.Error(err) {
 		funcAssert.Equal("error", err.Error())
 	}
 }
@@ -139,6 +155,8 @@ func TestAddPolicy(t *testing.T) {
 	defer cancel()
 	funcAssert := assert.New(t)
 	adminClient := adminClientMock{}
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	mockPolicyAttachments()
 	policyName := "new-policy"
 	policyDefinition := "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Action\":[\"s3:GetBucketLocation\",\"s3:GetObject\",\"s3:ListAllMyBuckets\"],\"Resource\":[\"arn:aws:s3:::*\"]}]}"
 	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
@@ -158,7 +176,7 @@ func TestAddPolicy(t *testing.T) {
 	}
 	// Test-1 : addPolicy() adds a new policy
 	function := "addPolicy()"
-	policy, err := addPolicy(ctx, adminClient, policyName, policyDefinition)
+	policy, err := addPolicy(ctx, adminClient, policyName, policyDefinition, "admin")
 	if err != nil {
 		t.Errorf("Failed on %s:, error occurred: %s", function, err.Error())
 	} else {
@@ -176,7 +194,7 @@ func TestAddPolicy(t *testing.T) {
 	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
 		return errors.New("error")
 	}
-	if _, err := addPolicy(ctx, adminClient, policyName, policyDefinition); funcAssert.Error(err) {
+	if _, err := addPolicy(ctx, adminClient, policyName, policyDefinition, "admin"); funcAssert.Error(err) {
 		funcAssert.Equal("error", err.Error())
 	}
 	// Test-3 : addPolicy() got an error while retrieving policy
@@ -186,7 +204,7 @@ func TestAddPolicy(t *testing.T) {
 	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
 		return nil, errors.New("error")
 	}
-	if _, err := addPolicy(ctx, adminClient, policyName, policyDefinition); funcAssert.Error(err) {
+	if _, err := addPolicy(ctx, adminClient, policyName, policyDefinition, "admin"); funcAssert.Error(err) {
 		funcAssert.Equal("error", err.Error())
 	}
 }
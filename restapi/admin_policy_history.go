@@ -0,0 +1,423 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/pkg/utils"
+	"github.com/GuinsooLab/console/restapi/operations"
+	policyApi "github.com/GuinsooLab/console/restapi/operations/policy"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// policyHistoryMu serializes the updates of the policy history files
+var policyHistoryMu sync.Mutex
+
+func registerPolicyHistoryHandlers(api *operations.ConsoleAPI) {
+	// list the revisions of a policy
+	api.PolicyListPolicyRevisionsHandler = policyApi.ListPolicyRevisionsHandlerFunc(func(params policyApi.ListPolicyRevisionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListPolicyRevisionsResponse(session, params)
+		if err != nil {
+			return policyApi.NewListPolicyRevisionsDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewListPolicyRevisionsOK().WithPayload(resp)
+	})
+	// compare two revisions of a policy
+	api.PolicyDiffPolicyRevisionsHandler = policyApi.DiffPolicyRevisionsHandlerFunc(func(params policyApi.DiffPolicyRevisionsParams, session *models.Principal) middleware.Responder {
+		resp, err := getDiffPolicyRevisionsResponse(session, params)
+		if err != nil {
+			return policyApi.NewDiffPolicyRevisionsDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewDiffPolicyRevisionsOK().WithPayload(resp)
+	})
+	// restore a revision of a policy
+	api.PolicyRollbackPolicyHandler = policyApi.RollbackPolicyHandlerFunc(func(params policyApi.RollbackPolicyParams, session *models.Principal) middleware.Responder {
+		resp, err := getRollbackPolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewRollbackPolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewRollbackPolicyOK().WithPayload(resp)
+	})
+}
+
+// getPolicyHistoryDir returns the directory keeping the revisions of the policies
+func getPolicyHistoryDir() string {
+	return filepath.Join(getDataDir(), "policy-history")
+}
+
+// policyHistoryFile returns the file keeping the revisions of a policy, named after a hash of the
+// policy name so any name is a valid file name
+func policyHistoryFile(dir, name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return filepath.Join(dir, "policy-"+hex.EncodeToString(sum[:8])+".json")
+}
+
+func readPolicyHistory(dir, name string) (*models.PolicyHistory, error) {
+	history := &models.PolicyHistory{Name: name, Revisions: []*models.PolicyRevision{}}
+	b, err := os.ReadFile(policyHistoryFile(dir, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return history, nil
+		}
+		return nil, err
+	}
+	if err = json.Unmarshal(b, history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func writePolicyHistory(dir string, history *models.PolicyHistory) error {
+	return writeDataFile(policyHistoryFile(dir, history.Name), history)
+}
+
+// formatPolicyDocument returns the indented policy document so revisions can be compared line by line
+func formatPolicyDocument(p *iampolicy.Policy) (string, error) {
+	if p == nil {
+		return "", nil
+	}
+	b, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// diffPolicyDocuments returns the lines removed from `from`, prefixed with "- ", and the lines added
+// in `to`, prefixed with "+ ", in document order
+func diffPolicyDocuments(from, to string) []string {
+	split := func(s string) []string {
+		if s == "" {
+			return nil
+		}
+		return strings.Split(s, "\n")
+	}
+	a, b := split(from), split(to)
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	diff := []string{}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			diff = append(diff, "- "+a[i])
+			i++
+		default:
+			diff = append(diff, "+ "+b[j])
+			j++
+		}
+	}
+	return diff
+}
+
+// getPolicyAttachments returns the users and groups a policy is attached to
+func getPolicyAttachments(ctx context.Context, client MinioAdmin, name string) ([]string, []string, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	attachedUsers := []string{}
+	for accessKey, user := range users {
+		if containsName(splitPolicyNames(user.PolicyName), name) {
+			attachedUsers = append(attachedUsers, accessKey)
+		}
+	}
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	attachedGroups := []string{}
+	for _, group := range groups {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return nil, nil, err
+		}
+		if containsName(splitPolicyNames(desc.Policy), name) {
+			attachedGroups = append(attachedGroups, group)
+		}
+	}
+	sort.Strings(attachedUsers)
+	sort.Strings(attachedGroups)
+	return attachedUsers, attachedGroups, nil
+}
+
+// getCurrentPolicy returns the policy stored in MinIO, nil when it doesn't exist
+func getCurrentPolicy(ctx context.Context, client MinioAdmin, name string) (*iampolicy.Policy, error) {
+	p, err := client.getPolicy(ctx, name)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code == "XMinioAdminNoSuchPolicy" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// savePolicy writes the policy to MinIO, or removes it when p is nil, and records the change in the
+// policy history. Every policy change made from the console goes through it so the history is complete.
+// Recording is best effort: a session that can't read the replaced policy still saves it without a
+// revision, and a failure to record the revision is logged and returns a nil revision
+func savePolicy(ctx context.Context, client MinioAdmin, dir, name, author, action string, restored int32, p *iampolicy.Policy) (*models.PolicyRevision, error) {
+	previous, previousErr := getCurrentPolicy(ctx, client, name)
+	if previousErr != nil {
+		LogError("Unable to read policy %s, the change won't be recorded in its history: %v", name, previousErr)
+	}
+	if p == nil {
+		if err := client.removePolicy(ctx, name); err != nil {
+			return nil, err
+		}
+		action = models.PolicyRevisionActionDelete
+	} else if err := client.addPolicy(ctx, name, p); err != nil {
+		return nil, err
+	}
+	if previousErr != nil {
+		return nil, nil
+	}
+	revision, err := recordPolicyRevision(ctx, client, dir, name, author, action, restored, previous, p)
+	if err != nil {
+		LogError("Unable to record the revision of policy %s: %v", name, err)
+		return nil, nil
+	}
+	return revision, nil
+}
+
+// recordPolicyRevision appends the saved policy to its history, when the policy that was replaced is
+// not the last recorded revision it was changed outside of the console and is recorded first as a
+// baseline so it can be rolled back to. The attachments are gathered before the history is locked so
+// other policy changes don't wait on the admin calls
+func recordPolicyRevision(ctx context.Context, client MinioAdmin, dir, name, author, action string, restored int32, previous, saved *iampolicy.Policy) (*models.PolicyRevision, error) {
+	users, groups, err := getPolicyAttachments(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	previousDoc, err := formatPolicyDocument(previous)
+	if err != nil {
+		return nil, err
+	}
+	savedDoc, err := formatPolicyDocument(saved)
+	if err != nil {
+		return nil, err
+	}
+	policyHistoryMu.Lock()
+	defer policyHistoryMu.Unlock()
+	history, err := readPolicyHistory(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	lastDoc := ""
+	if n := len(history.Revisions); n > 0 {
+		lastDoc = history.Revisions[n-1].Policy
+	}
+	if previous != nil && previousDoc != lastDoc {
+		history.Revisions = append(history.Revisions, &models.PolicyRevision{
+			Revision:  int32(len(history.Revisions) + 1),
+			Timestamp: now,
+			Action:    models.PolicyRevisionActionBaseline,
+			Policy:    previousDoc,
+			Diff:      diffPolicyDocuments(lastDoc, previousDoc),
+			Users:     users,
+			Groups:    groups,
+		})
+	}
+	if action == "" {
+		action = models.PolicyRevisionActionUpdate
+		if previous == nil {
+			action = models.PolicyRevisionActionCreate
+		}
+	}
+	revision := &models.PolicyRevision{
+		Revision:         int32(len(history.Revisions) + 1),
+		Author:           author,
+		Timestamp:        now,
+		Action:           action,
+		RestoredRevision: restored,
+		Policy:           savedDoc,
+		Diff:             diffPolicyDocuments(previousDoc, savedDoc),
+		Users:            users,
+		Groups:           groups,
+	}
+	history.Revisions = append(history.Revisions, revision)
+	if err = writePolicyHistory(dir, history); err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// findPolicyRevision returns a revision of the history, the last one when revision is 0
+func findPolicyRevision(history *models.PolicyHistory, revision int32) (*models.PolicyRevision, error) {
+	if revision == 0 && len(history.Revisions) > 0 {
+		return history.Revisions[len(history.Revisions)-1], nil
+	}
+	for _, r := range history.Revisions {
+		if r.Revision == revision {
+			return r, nil
+		}
+	}
+	return nil, ErrPolicyRevisionNotFound
+}
+
+// diffPolicyRevisions compares two revisions of a policy, to defaults to the last revision
+func diffPolicyRevisions(dir, name string, from, to int32) (*models.PolicyRevisionDiff, error) {
+	history, err := readPolicyHistory(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	fromRevision, err := findPolicyRevision(history, from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision, err := findPolicyRevision(history, to)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PolicyRevisionDiff{
+		Name:          name,
+		From:          fromRevision.Revision,
+		To:            toRevision.Revision,
+		Diff:          diffPolicyDocuments(fromRevision.Policy, toRevision.Policy),
+		AddedUsers:    DifferenceArrays(toRevision.Users, fromRevision.Users),
+		RemovedUsers:  DifferenceArrays(fromRevision.Users, toRevision.Users),
+		AddedGroups:   DifferenceArrays(toRevision.Groups, fromRevision.Groups),
+		RemovedGroups: DifferenceArrays(fromRevision.Groups, toRevision.Groups),
+	}, nil
+}
+
+// rollbackPolicy saves the document of a previous revision as a new revision of the policy
+func rollbackPolicy(ctx context.Context, client MinioAdmin, dir, name string, revision int32, author string) (*models.PolicyRevision, error) {
+	history, err := readPolicyHistory(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	if revision == 0 {
+		return nil, ErrPolicyRevisionNotFound
+	}
+	target, err := findPolicyRevision(history, revision)
+	if err != nil {
+		return nil, err
+	}
+	if target.Action == models.PolicyRevisionActionDelete {
+		return nil, fmt.Errorf("%w: revision %d removed the policy", ErrPolicyRevisionNotFound, revision)
+	}
+	restored, err := iampolicy.ParseConfig(bytes.NewReader([]byte(target.Policy)))
+	if err != nil {
+		return nil, err
+	}
+	saved, err := savePolicy(ctx, client, dir, name, author, models.PolicyRevisionActionRollback, revision, restored)
+	if err != nil {
+		return nil, err
+	}
+	if saved == nil {
+		return nil, fmt.Errorf("policy %s was restored but its revision could not be recorded", name)
+	}
+	return saved, nil
+}
+
+func getListPolicyRevisionsResponse(session *models.Principal, params policyApi.ListPolicyRevisionsParams) (*models.PolicyHistory, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validatePolicyHistoryAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	name, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	history, err := readPolicyHistory(getPolicyHistoryDir(), name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return history, nil
+}
+
+func getDiffPolicyRevisionsResponse(session *models.Principal, params policyApi.DiffPolicyRevisionsParams) (*models.PolicyRevisionDiff, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validatePolicyHistoryAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	name, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	var to int32
+	if params.To != nil {
+		to = *params.To
+	}
+	diff, err := diffPolicyRevisions(getPolicyHistoryDir(), name, params.From, to)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return diff, nil
+}
+
+func getRollbackPolicyResponse(session *models.Principal, params policyApi.RollbackPolicyParams) (*models.PolicyRevision, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	name, err := utils.DecodeBase64(params.Name)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	revision, err := rollbackPolicy(ctx, adminClient, getPolicyHistoryDir(), name, params.Revision, session.AccountAccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return revision, nil
+}
+
+// validatePolicyHistoryAccess verifies the session is allowed to read the policy documents
+func validatePolicyHistoryAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.GetPolicyAdminAction, "Policy history not available.")
+}
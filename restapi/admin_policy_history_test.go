@@ -0,0 +1,158 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffPolicyDocuments(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal([]string{}, diffPolicyDocuments("a\nb", "a\nb"))
+	assert.Equal([]string{"+ a", "+ b"}, diffPolicyDocuments("", "a\nb"))
+	assert.Equal([]string{"- b", "+ x", "+ d"}, diffPolicyDocuments("a\nb\nc", "a\nx\nc\nd"))
+}
+
+func TestPolicyHistory(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	parse := func(action string) *iampolicy.Policy {
+		p, err := iampolicy.ParseConfig(strings.NewReader(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["` + action + `"],"Resource":["arn:aws:s3:::photos/*"]}]}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	stored := map[string]*iampolicy.Policy{}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if p, ok := stored[name]; ok {
+			return p, nil
+		}
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+	}
+	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
+		stored[name] = policy
+		return nil
+	}
+	userPolicy := "photos"
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"alice": {PolicyName: userPolicy}, "bob": {PolicyName: "other"}}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"editors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: "other,photos"}, nil
+	}
+	save := func(p *iampolicy.Policy, author string) *models.PolicyRevision {
+		previous, err := getCurrentPolicy(ctx, adminClientMock{}, "photos")
+		assert.Nil(err)
+		stored["photos"] = p
+		revision, err := recordPolicyRevision(ctx, adminClientMock{}, dir, "photos", author, "", 0, previous, p)
+		assert.Nil(err)
+		return revision
+	}
+
+	// Test-1: creating and updating a policy records the author, diff and attachments
+	created := save(parse("s3:GetObject"), "admin")
+	assert.Equal(int32(1), created.Revision)
+	assert.Equal(models.PolicyRevisionActionCreate, created.Action)
+	assert.Equal([]string{"alice"}, created.Users)
+	assert.Equal([]string{"editors"}, created.Groups)
+	userPolicy = "other"
+	updated := save(parse("s3:PutObject"), "ops")
+	assert.Equal(models.PolicyRevisionActionUpdate, updated.Action)
+	assert.Equal("ops", updated.Author)
+	assert.Equal([]string{`-         "s3:GetObject"`, `+         "s3:PutObject"`}, updated.Diff)
+	assert.Empty(updated.Users)
+
+	// Test-2: changes made outside of the console are recorded as a baseline first
+	stored["photos"] = parse("s3:DeleteObject")
+	latest := save(parse("s3:ListBucket"), "admin")
+	assert.Equal(int32(4), latest.Revision)
+	history, err := readPolicyHistory(dir, "photos")
+	assert.Nil(err)
+	if assert.Len(history.Revisions, 4) {
+		assert.Equal(models.PolicyRevisionActionBaseline, history.Revisions[2].Action)
+		assert.Empty(history.Revisions[2].Author)
+	}
+
+	// Test-3: diff of two revisions, the latest one by default
+	diff, err := diffPolicyRevisions(dir, "photos", 1, 0)
+	assert.Nil(err)
+	assert.Equal(int32(4), diff.To)
+	assert.Equal([]string{`-         "s3:GetObject"`, `+         "s3:ListBucket"`}, diff.Diff)
+	assert.Equal([]string{"alice"}, diff.RemovedUsers)
+	assert.Empty(diff.AddedGroups)
+	_, err = diffPolicyRevisions(dir, "photos", 9, 0)
+	assert.Equal(ErrPolicyRevisionNotFound, err)
+
+	// Test-4: a rollback saves the old document as a new revision
+	restored, err := rollbackPolicy(ctx, adminClientMock{}, dir, "photos", 1, "admin")
+	if assert.Nil(err) {
+		assert.Equal(int32(5), restored.Revision)
+		assert.Equal(models.PolicyRevisionActionRollback, restored.Action)
+		assert.Equal(int32(1), restored.RestoredRevision)
+		assert.Equal(created.Policy, restored.Policy)
+		assert.True(stored["photos"].Equals(*parse("s3:GetObject")))
+	}
+	_, err = rollbackPolicy(ctx, adminClientMock{}, dir, "missing", 1, "admin")
+	assert.Equal(ErrPolicyRevisionNotFound, err)
+
+	// Test-5: a removal is recorded and can't be restored, the revision before it can
+	minioRemovePolicyMock = func(name string) error {
+		delete(stored, name)
+		return nil
+	}
+	deleted, err := savePolicy(ctx, adminClientMock{}, dir, "photos", "admin", "", 0, nil)
+	if assert.Nil(err) {
+		assert.Equal(int32(6), deleted.Revision)
+		assert.Equal(models.PolicyRevisionActionDelete, deleted.Action)
+		assert.Empty(deleted.Policy)
+		assert.Equal(`-   "Version": "2012-10-17",`, deleted.Diff[1])
+	}
+	assert.NotContains(stored, "photos")
+	_, err = rollbackPolicy(ctx, adminClientMock{}, dir, "photos", 6, "admin")
+	assert.True(errors.Is(err, ErrPolicyRevisionNotFound))
+	restored, err = rollbackPolicy(ctx, adminClientMock{}, dir, "photos", 5, "admin")
+	if assert.Nil(err) {
+		assert.Equal(models.PolicyRevisionActionRollback, restored.Action)
+		assert.Len(restored.Diff, len(strings.Split(restored.Policy, "\n")))
+	}
+	assert.Contains(stored, "photos")
+
+	// Test-6: a session that can't read policies still saves them, without recording a revision
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		return nil, madmin.ErrorResponse{Code: "AccessDenied"}
+	}
+	skipped, err := savePolicy(ctx, adminClientMock{}, dir, "photos", "admin", "", 0, parse("s3:GetBucketLocation"))
+	assert.Nil(err)
+	assert.Nil(skipped)
+	assert.True(stored["photos"].Equals(*parse("s3:GetBucketLocation")))
+	history, err = readPolicyHistory(dir, "photos")
+	assert.Nil(err)
+	assert.Len(history.Revisions, 7)
+}
@@ -0,0 +1,444 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	policyApi "github.com/GuinsooLab/console/restapi/operations/policy"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/pkg/bucket/policy/condition"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/minio/pkg/wildcard"
+)
+
+func registerPolicyLintHandler(api *operations.ConsoleAPI) {
+	// lint a policy before it is saved
+	api.PolicyLintPolicyHandler = policyApi.LintPolicyHandlerFunc(func(params policyApi.LintPolicyParams, session *models.Principal) middleware.Responder {
+		resp, err := getLintPolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewLintPolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewLintPolicyOK().WithPayload(resp)
+	})
+}
+
+// lintStrings decodes the policy fields accepting either a single string or a list of strings
+type lintStrings []string
+
+func (s *lintStrings) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = lintStrings{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// lintStatement is a policy statement decoded without validation, so every problem can be reported
+// instead of the first one
+type lintStatement struct {
+	Sid       string                                `json:"Sid"`
+	Effect    string                                `json:"Effect"`
+	Action    lintStrings                           `json:"Action"`
+	Resource  lintStrings                           `json:"Resource"`
+	Condition map[string]map[string]json.RawMessage `json:"Condition"`
+}
+
+// lintDocument is a policy decoded without validation
+type lintDocument struct {
+	Version   string            `json:"Version"`
+	Statement []json.RawMessage `json:"Statement"`
+}
+
+// policyLinter checks a policy against the buckets of the deployment
+type policyLinter struct {
+	buckets []string
+	issues  []*models.PolicyLintIssue
+}
+
+func (l *policyLinter) report(severity, code string, statement int, value, message, suggestion string) {
+	l.issues = append(l.issues, &models.PolicyLintIssue{
+		Severity:   severity,
+		Code:       code,
+		Statement:  int32(statement),
+		Value:      value,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+// errors returns the number of errors reported so far
+func (l *policyLinter) errors() int {
+	count := 0
+	for _, issue := range l.issues {
+		if issue.Severity == models.PolicyLintIssueSeverityError {
+			count++
+		}
+	}
+	return count
+}
+
+// lint parses the policy and reports its issues, statements are numbered from 1
+func (l *policyLinter) lint(document string) {
+	var doc lintDocument
+	if err := json.Unmarshal([]byte(document), &doc); err != nil {
+		// a single statement doesn't have to be in a list
+		var single struct {
+			Version   string          `json:"Version"`
+			Statement json.RawMessage `json:"Statement"`
+		}
+		if err2 := json.Unmarshal([]byte(document), &single); err2 != nil || len(single.Statement) == 0 || single.Statement[0] != '{' {
+			l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidJSON, 0, "", err.Error(), "")
+			return
+		}
+		doc = lintDocument{Version: single.Version, Statement: []json.RawMessage{single.Statement}}
+	}
+	if doc.Version != "" && doc.Version != iampolicy.DefaultVersion {
+		l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidVersion, 0, doc.Version,
+			fmt.Sprintf("unsupported policy version %s", doc.Version), iampolicy.DefaultVersion)
+	}
+
+	var statements []lintStatement
+	for i, raw := range doc.Statement {
+		var statement lintStatement
+		if err := json.Unmarshal(raw, &statement); err != nil {
+			l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidJSON, i+1, "", err.Error(), "")
+			statements = append(statements, lintStatement{})
+			continue
+		}
+		statements = append(statements, statement)
+		before := l.errors()
+		l.lintStatement(i+1, statement)
+		// report what the policy engine refuses when none of the checks above explain it
+		if l.errors() == before {
+			var parsed iampolicy.Statement
+			err := json.Unmarshal(raw, &parsed)
+			if err == nil {
+				err = parsed.Validate()
+			}
+			if err != nil {
+				l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidStatement, i+1, "", err.Error(), "")
+			}
+		}
+	}
+	l.lintShadowed(statements)
+}
+
+func (l *policyLinter) lintStatement(n int, statement lintStatement) {
+	if statement.Effect != "Allow" && statement.Effect != "Deny" {
+		l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidEffect, n, statement.Effect,
+			fmt.Sprintf("invalid effect %q, must be Allow or Deny", statement.Effect), "")
+	}
+	if len(statement.Action) == 0 {
+		l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeEmptyAction, n, "", "the statement has no action", "")
+	}
+	isAdmin := false
+	for _, action := range statement.Action {
+		if iampolicy.AdminAction(action).IsValid() {
+			isAdmin = true
+			continue
+		}
+		if !iampolicy.Action(action).IsValid() {
+			l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeUnknownAction, n, action,
+				fmt.Sprintf("unknown action %s", action), closestLintAction(action))
+		}
+	}
+	for _, resource := range statement.Resource {
+		if !strings.HasPrefix(resource, iampolicy.ResourceARNPrefix) {
+			l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidResource, n, resource,
+				fmt.Sprintf("resource %s must start with %s", resource, iampolicy.ResourceARNPrefix), iampolicy.ResourceARNPrefix+resource)
+			continue
+		}
+		bucket, _ := parsePolicyResource(resource)
+		if bucket == "" {
+			l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidResource, n, resource, "the resource has no bucket", "")
+			continue
+		}
+		if strings.ContainsAny(bucket, "*?$") || containsName(l.buckets, bucket) {
+			continue
+		}
+		message := fmt.Sprintf("bucket %s does not exist", bucket)
+		suggestion := closestLintName(bucket, l.buckets)
+		if suggestion != "" {
+			message = fmt.Sprintf("bucket %s does not exist, did you mean %s?", bucket, suggestion)
+			suggestion = strings.Replace(resource, bucket, suggestion, 1)
+		}
+		l.report(models.PolicyLintIssueSeverityWarning, models.PolicyLintIssueCodeUnknownBucket, n, resource, message, suggestion)
+	}
+	if len(statement.Resource) == 0 && !isAdmin && len(statement.Action) > 0 {
+		l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidResource, n, "", "the statement has no resource", "")
+	}
+
+	conditionKeys := make([]string, 0, len(statement.Condition))
+	for operator := range statement.Condition {
+		conditionKeys = append(conditionKeys, operator)
+	}
+	sort.Strings(conditionKeys)
+	for _, operator := range conditionKeys {
+		for key, values := range statement.Condition[operator] {
+			var k condition.Key
+			if err := k.UnmarshalJSON([]byte(fmt.Sprintf("%q", key))); err != nil {
+				l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidConditionKey, n, key,
+					fmt.Sprintf("unknown condition key %s", key), "")
+				continue
+			}
+			var functions condition.Functions
+			single, _ := json.Marshal(map[string]map[string]json.RawMessage{operator: {key: values}})
+			if err := functions.UnmarshalJSON(single); err != nil {
+				l.report(models.PolicyLintIssueSeverityError, models.PolicyLintIssueCodeInvalidCondition, n, operator, err.Error(), "")
+			}
+		}
+	}
+
+	if statement.Effect == "Allow" && len(statement.Condition) == 0 {
+		for _, action := range statement.Action {
+			if action != "*" && action != iampolicy.AllActions {
+				continue
+			}
+			for _, resource := range statement.Resource {
+				if resource == iampolicy.ResourceARNPrefix+"*" || resource == iampolicy.ResourceARNPrefix+"*/*" {
+					l.report(models.PolicyLintIssueSeverityWarning, models.PolicyLintIssueCodeBroadPermission, n, resource,
+						fmt.Sprintf("%s on %s grants full access to every bucket", action, resource), "limit the actions or the buckets")
+				}
+			}
+		}
+	}
+}
+
+// lintShadowed reports the allow statements whose actions and resources are all denied by a single
+// unconditional deny statement, they never grant anything
+func (l *policyLinter) lintShadowed(statements []lintStatement) {
+	covers := func(patterns, values []string) bool {
+		if len(values) == 0 {
+			return len(patterns) == 0
+		}
+		for _, value := range values {
+			covered := false
+			for _, pattern := range patterns {
+				if pattern == "*" || wildcard.Match(pattern, value) {
+					covered = true
+					break
+				}
+			}
+			if !covered {
+				return false
+			}
+		}
+		return true
+	}
+	for i, allow := range statements {
+		if allow.Effect != "Allow" || len(allow.Action) == 0 {
+			continue
+		}
+		for j, deny := range statements {
+			if deny.Effect != "Deny" || len(deny.Condition) > 0 {
+				continue
+			}
+			if covers(deny.Action, allow.Action) && covers(deny.Resource, allow.Resource) {
+				l.report(models.PolicyLintIssueSeverityWarning, models.PolicyLintIssueCodeShadowedStatement, i+1, "",
+					fmt.Sprintf("the statement is fully denied by statement %d", j+1), "")
+				break
+			}
+		}
+	}
+}
+
+// closestLintName returns the candidate closest to the name when it is likely a typo
+func closestLintName(name string, candidates []string) string {
+	best, bestDistance := "", 3
+	for _, candidate := range candidates {
+		if d := levenshtein(strings.ToLower(name), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// closestLintAction returns the supported action closest to an unknown one
+func closestLintAction(action string) string {
+	candidates := make([]string, 0, len(lintKnownActions))
+	for _, a := range lintKnownActions {
+		candidates = append(candidates, string(a))
+	}
+	return closestLintName(action, candidates)
+}
+
+// lintKnownActions are the actions suggested when an unknown action looks like a typo
+var lintKnownActions = []iampolicy.Action{
+	iampolicy.AbortMultipartUploadAction,
+	iampolicy.CreateBucketAction,
+	iampolicy.DeleteBucketAction,
+	iampolicy.DeleteBucketPolicyAction,
+	iampolicy.DeleteObjectAction,
+	iampolicy.GetBucketLocationAction,
+	iampolicy.GetBucketNotificationAction,
+	iampolicy.GetBucketPolicyAction,
+	iampolicy.GetObjectAction,
+	iampolicy.HeadBucketAction,
+	iampolicy.ListAllMyBucketsAction,
+	iampolicy.ListBucketAction,
+	iampolicy.ListBucketMultipartUploadsAction,
+	iampolicy.ListMultipartUploadPartsAction,
+	iampolicy.PutBucketNotificationAction,
+	iampolicy.PutBucketPolicyAction,
+	iampolicy.PutObjectAction,
+	iampolicy.GetBucketLifecycleAction,
+	iampolicy.PutBucketLifecycleAction,
+	iampolicy.GetObjectTaggingAction,
+	iampolicy.PutObjectTaggingAction,
+	iampolicy.DeleteObjectTaggingAction,
+	iampolicy.GetBucketVersioningAction,
+	iampolicy.PutBucketVersioningAction,
+	iampolicy.GetObjectVersionAction,
+	iampolicy.DeleteObjectVersionAction,
+	iampolicy.GetReplicationConfigurationAction,
+	iampolicy.PutReplicationConfigurationAction,
+	iampolicy.GetBucketEncryptionAction,
+	iampolicy.PutBucketEncryptionAction,
+	iampolicy.GetBucketObjectLockConfigurationAction,
+	iampolicy.PutBucketObjectLockConfigurationAction,
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr := make([]int, len(b)+1)
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev = curr
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// getUnusedPolicies returns the policies attached to no user or group
+func getUnusedPolicies(ctx context.Context, client MinioAdmin) ([]string, error) {
+	allPolicies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	used := map[string]bool{}
+	markUsed := func(names string) {
+		for _, name := range strings.Split(names, ",") {
+			used[strings.TrimSpace(name)] = true
+		}
+	}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		markUsed(user.PolicyName)
+	}
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return nil, err
+		}
+		markUsed(desc.Policy)
+	}
+	unused := []string{}
+	for name := range allPolicies {
+		if !used[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	return unused, nil
+}
+
+// lintPolicy checks a policy before it is saved, the policy is valid when no error is reported
+func lintPolicy(ctx context.Context, client MinioAdmin, req *models.PolicyLintRequest) (*models.PolicyLintResult, error) {
+	info, err := client.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	linter := &policyLinter{}
+	for _, bucket := range info.Buckets {
+		linter.buckets = append(linter.buckets, bucket.Name)
+	}
+	linter.lint(*req.Policy)
+
+	unused, err := getUnusedPolicies(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != "" && containsName(unused, req.Name) {
+		linter.report(models.PolicyLintIssueSeverityInfo, models.PolicyLintIssueCodeNotAttached, 0, req.Name,
+			fmt.Sprintf("policy %s is not attached to any user or group", req.Name), "")
+	}
+
+	result := &models.PolicyLintResult{Valid: true, Issues: []*models.PolicyLintIssue{}, UnusedPolicies: unused}
+	for _, issue := range linter.issues {
+		if issue.Severity == models.PolicyLintIssueSeverityError {
+			result.Valid = false
+		}
+		result.Issues = append(result.Issues, issue)
+	}
+	return result, nil
+}
+
+func getLintPolicyResponse(session *models.Principal, params policyApi.LintPolicyParams) (*models.PolicyLintResult, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := lintPolicy(ctx, adminClient, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,118 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyLinter(t *testing.T) {
+	assert := assert.New(t)
+	lint := func(document string) []*models.PolicyLintIssue {
+		linter := &policyLinter{buckets: []string{"photos", "logs"}}
+		linter.lint(document)
+		return linter.issues
+	}
+	codes := func(issues []*models.PolicyLintIssue) []string {
+		var result []string
+		for _, issue := range issues {
+			result = append(result, issue.Code)
+		}
+		return result
+	}
+
+	// Test-1: a valid policy has no issues
+	assert.Empty(lint(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`))
+
+	// Test-2: unknown actions and typos in bucket names come with a suggestion
+	issues := lint(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObjet"],"Resource":["arn:aws:s3:::photo/*"]}]}`)
+	if assert.Len(issues, 2) {
+		assert.Equal(models.PolicyLintIssueCodeUnknownAction, issues[0].Code)
+		assert.Equal("s3:GetObject", issues[0].Suggestion)
+		assert.Equal(int32(1), issues[0].Statement)
+		assert.Equal(models.PolicyLintIssueCodeUnknownBucket, issues[1].Code)
+		assert.Equal(models.PolicyLintIssueSeverityWarning, issues[1].Severity)
+		assert.Equal("arn:aws:s3:::photos/*", issues[1].Suggestion)
+	}
+
+	// Test-3: broad permissions and statements shadowed by a deny
+	issues = lint(`{"Version":"2012-10-17","Statement":[
+		{"Effect":"Allow","Action":"s3:*","Resource":"arn:aws:s3:::*"},
+		{"Effect":"Allow","Action":["s3:PutObject"],"Resource":["arn:aws:s3:::logs/app/*"]},
+		{"Effect":"Deny","Action":["s3:Put*"],"Resource":["arn:aws:s3:::logs/*"]}]}`)
+	assert.Equal([]string{models.PolicyLintIssueCodeBroadPermission, models.PolicyLintIssueCodeShadowedStatement}, codes(issues))
+	assert.Equal(int32(2), issues[1].Statement)
+	assert.Equal("the statement is fully denied by statement 3", issues[1].Message)
+
+	// Test-4: invalid condition keys and operators
+	issues = lint(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"],
+		"Condition":{"IpAddress":{"aws:SourceIpp":["10.0.0.0/8"]},"StringEqualz":{"s3:prefix":["a"]}}}]}`)
+	assert.Equal([]string{models.PolicyLintIssueCodeInvalidConditionKey, models.PolicyLintIssueCodeInvalidCondition}, codes(issues))
+
+	// Test-5: document level errors
+	assert.Equal([]string{models.PolicyLintIssueCodeInvalidJSON}, codes(lint(`{"Version":`)))
+	issues = lint(`{"Version":"2008-10-17","Statement":{"Effect":"Permit","Action":[],"Resource":["photos"]}}`)
+	assert.Equal([]string{models.PolicyLintIssueCodeInvalidVersion, models.PolicyLintIssueCodeInvalidEffect,
+		models.PolicyLintIssueCodeEmptyAction, models.PolicyLintIssueCodeInvalidResource}, codes(issues))
+	assert.Equal(int32(0), issues[0].Statement)
+
+	// Test-6: admin statements don't need a resource
+	assert.Empty(lint(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["admin:ServerInfo"]}]}`))
+}
+
+func TestLintPolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "photos"}}}, nil
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readphotos": {}, "old": {}, "team": {}, "draft": {}}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"alice": {PolicyName: "readphotos, team"}}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"editors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group, Policy: "team"}, nil
+	}
+
+	result, err := lintPolicy(ctx, adminClientMock{}, &models.PolicyLintRequest{
+		Name:   "draft",
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::photos/*"]}]}`),
+	})
+	if assert.Nil(err) {
+		assert.True(result.Valid)
+		assert.Equal([]string{"draft", "old"}, result.UnusedPolicies)
+		if assert.Len(result.Issues, 1) {
+			assert.Equal(models.PolicyLintIssueCodeNotAttached, result.Issues[0].Code)
+		}
+	}
+
+	result, err = lintPolicy(ctx, adminClientMock{}, &models.PolicyLintRequest{Policy: swag.String(`{"Statement":[{"Effect":"Allow","Action":["s3:Fly"],"Resource":["arn:aws:s3:::photos/*"]}]}`)})
+	assert.Nil(err)
+	assert.False(result.Valid)
+}
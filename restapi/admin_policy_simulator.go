@@ -0,0 +1,358 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	policyApi "github.com/GuinsooLab/console/restapi/operations/policy"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/madmin-go"
+	"github.com/minio/pkg/bucket/policy"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerPolicySimulatorHandler(api *operations.ConsoleAPI) {
+	// evaluate the policies of a user or service account for an action on a resource
+	api.PolicySimulatePolicyHandler = policyApi.SimulatePolicyHandlerFunc(func(params policyApi.SimulatePolicyParams, session *models.Principal) middleware.Responder {
+		resp, err := getSimulatePolicyResponse(session, params)
+		if err != nil {
+			return policyApi.NewSimulatePolicyDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewSimulatePolicyOK().WithPayload(resp)
+	})
+}
+
+// simulatedPolicy is a policy attached to the principal along with where it comes from
+type simulatedPolicy struct {
+	name   string
+	source string
+	group  string
+	policy *iampolicy.Policy
+}
+
+// simulatedLayer is a set of policies evaluated together, a request has to be allowed by every layer:
+// the policies of the user and its groups, the embedded policy of a service account and the session
+// policy
+type simulatedLayer struct {
+	description string
+	policies    []simulatedPolicy
+}
+
+// simulatedMatch is a statement of a layer applying to the request
+type simulatedMatch struct {
+	policy    simulatedPolicy
+	index     int
+	statement iampolicy.Statement
+}
+
+// parsePolicyResource splits a resource ARN into its bucket and object name, the ARN prefix is optional
+func parsePolicyResource(resource string) (bucket, object string) {
+	resource = strings.TrimPrefix(resource, iampolicy.ResourceARNPrefix)
+	bucket, object, _ = strings.Cut(resource, "/")
+	return bucket, object
+}
+
+// validatePolicySimulation checks the action to simulate is a single supported S3 or admin action on
+// a resource and that the session policy is valid
+func validatePolicySimulation(req *models.PolicySimulationRequest) error {
+	action := *req.Action
+	if strings.ContainsAny(action, "*?") {
+		return fmt.Errorf("action %s must not contain wildcards", action)
+	}
+	isAdmin := iampolicy.AdminAction(action).IsValid()
+	if !isAdmin && !iampolicy.Action(action).IsValid() {
+		return fmt.Errorf("unsupported action %s", action)
+	}
+	if bucket, _ := parsePolicyResource(req.Resource); bucket == "" && !isAdmin {
+		return errors.New("a resource is required for S3 actions")
+	}
+	if req.SessionPolicy != "" {
+		if _, err := iampolicy.ParseConfig(bytes.NewReader([]byte(req.SessionPolicy))); err != nil {
+			return fmt.Errorf("invalid session policy: %v", err)
+		}
+	}
+	return nil
+}
+
+// getSimulatedPolicies fetches the named comma separated policies, policies that don't exist are kept
+// without a document so they can be reported
+func getSimulatedPolicies(ctx context.Context, client MinioAdmin, names, source, group string) ([]simulatedPolicy, error) {
+	var policies []simulatedPolicy
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		p, err := client.getPolicy(ctx, name)
+		if err != nil {
+			if madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchPolicy" {
+				return nil, err
+			}
+			p = nil
+		}
+		policies = append(policies, simulatedPolicy{name: name, source: source, group: group, policy: p})
+	}
+	return policies, nil
+}
+
+// getUserSimulatedPolicies returns the policies of a user and of its enabled groups
+func getUserSimulatedPolicies(ctx context.Context, client MinioAdmin, user madmin.UserInfo) (simulatedLayer, error) {
+	layer := simulatedLayer{description: "the user and group policies"}
+	policies, err := getSimulatedPolicies(ctx, client, user.PolicyName, models.PolicySimulationPolicySourceUser, "")
+	if err != nil {
+		return layer, err
+	}
+	layer.policies = policies
+	for _, group := range user.MemberOf {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return layer, err
+		}
+		if desc.Status == string(madmin.GroupDisabled) {
+			continue
+		}
+		policies, err := getSimulatedPolicies(ctx, client, desc.Policy, models.PolicySimulationPolicySourceGroup, group)
+		if err != nil {
+			return layer, err
+		}
+		layer.policies = append(layer.policies, policies...)
+	}
+	return layer, nil
+}
+
+// evaluate returns the statements of the layer applying to the request, a matching deny statement
+// always wins over the allow statements
+func (layer simulatedLayer) evaluate(args iampolicy.Args) (allowed bool, deny *simulatedMatch, matches []simulatedMatch) {
+	for _, p := range layer.policies {
+		if p.policy == nil {
+			continue
+		}
+		for i, statement := range p.policy.Statements {
+			// a deny statement applies when it refuses the request
+			applies := statement.IsAllowed(args)
+			if statement.Effect == policy.Deny {
+				applies = !applies
+			}
+			if !applies {
+				continue
+			}
+			match := simulatedMatch{policy: p, index: i, statement: statement}
+			matches = append(matches, match)
+			if statement.Effect == policy.Deny && deny == nil {
+				deny = &match
+			}
+		}
+	}
+	if deny != nil {
+		return false, deny, matches
+	}
+	return len(matches) > 0, nil, matches
+}
+
+func newSimulationStatement(match simulatedMatch) *models.PolicySimulationStatement {
+	statement, _ := json.Marshal(match.statement)
+	return &models.PolicySimulationStatement{
+		Policy:    match.policy.name,
+		Source:    match.policy.source,
+		Group:     match.policy.group,
+		Index:     int32(match.index),
+		Sid:       string(match.statement.SID),
+		Effect:    string(match.statement.Effect),
+		Statement: string(statement),
+	}
+}
+
+// simulatePolicy evaluates every policy applying to a user or service account with the IAM policy engine
+// and explains the decision
+func simulatePolicy(ctx context.Context, client MinioAdmin, req *models.PolicySimulationRequest) (*models.PolicySimulationResult, error) {
+	principal, action := *req.Principal, *req.Action
+	bucket, object := parsePolicyResource(req.Resource)
+	result := &models.PolicySimulationResult{
+		Principal:         principal,
+		PrincipalType:     models.PolicySimulationResultPrincipalTypeUser,
+		Action:            action,
+		Bucket:            bucket,
+		Object:            object,
+		Policies:          []*models.PolicySimulationPolicy{},
+		MatchedStatements: []*models.PolicySimulationStatement{},
+	}
+
+	var layers []simulatedLayer
+	user, err := client.getUserInfo(ctx, principal)
+	if err != nil {
+		if madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchUser" {
+			return nil, err
+		}
+		account, err := client.infoServiceAccount(ctx, principal)
+		if err != nil {
+			if madmin.ToErrorResponse(err).Code == "XMinioAdminServiceAccountNotFound" {
+				return nil, ErrPrincipalNotFound
+			}
+			return nil, err
+		}
+		result.PrincipalType = models.PolicySimulationResultPrincipalTypeServiceAccount
+		result.ParentUser = account.ParentUser
+		if account.AccountStatus == "off" {
+			result.Decision = models.PolicySimulationResultDecisionImplicitDeny
+			result.Reason = "the service account is disabled"
+			return result, nil
+		}
+		// the service account inherits the policies of its parent user
+		if user, err = client.getUserInfo(ctx, account.ParentUser); err != nil {
+			return nil, err
+		}
+		// unless implied, the embedded policy further restricts what the parent user can do
+		if !account.ImpliedPolicy && account.Policy != "" {
+			p, err := iampolicy.ParseConfig(bytes.NewReader([]byte(account.Policy)))
+			if err != nil {
+				return nil, err
+			}
+			layers = append(layers, simulatedLayer{
+				description: "the service account policy",
+				policies:    []simulatedPolicy{{name: principal, source: models.PolicySimulationPolicySourceServiceAccount, policy: p}},
+			})
+		}
+	}
+	if user.Status == madmin.AccountDisabled {
+		result.Decision = models.PolicySimulationResultDecisionImplicitDeny
+		result.Reason = "the user is disabled"
+		return result, nil
+	}
+	userLayer, err := getUserSimulatedPolicies(ctx, client, user)
+	if err != nil {
+		return nil, err
+	}
+	layers = append([]simulatedLayer{userLayer}, layers...)
+	if req.SessionPolicy != "" {
+		p, err := iampolicy.ParseConfig(bytes.NewReader([]byte(req.SessionPolicy)))
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, simulatedLayer{
+			description: "the session policy",
+			policies:    []simulatedPolicy{{name: "session", source: models.PolicySimulationPolicySourceSession, policy: p}},
+		})
+	}
+
+	conditions := map[string][]string{}
+	for key, values := range req.Conditions {
+		conditions[key] = values
+	}
+	// the server sets these for every request, they are used by policy variables such as ${aws:username}
+	accountName := principal
+	if result.ParentUser != "" {
+		accountName = result.ParentUser
+	}
+	for _, key := range []string{"username", "userid"} {
+		if _, ok := conditions[key]; !ok {
+			conditions[key] = []string{accountName}
+		}
+	}
+	args := iampolicy.Args{
+		AccountName:     accountName,
+		Groups:          user.MemberOf,
+		Action:          iampolicy.Action(action),
+		BucketName:      bucket,
+		ObjectName:      object,
+		ConditionValues: conditions,
+	}
+	evaluateSimulatedLayers(result, layers, args)
+	return result, nil
+}
+
+// evaluateSimulatedLayers sets the decision of the simulation, the request is allowed only when every
+// layer allows it and none of them denies it explicitly
+func evaluateSimulatedLayers(result *models.PolicySimulationResult, layers []simulatedLayer, args iampolicy.Args) {
+	var deny, allow *simulatedMatch
+	var refusedBy string
+	for _, layer := range layers {
+		for _, p := range layer.policies {
+			result.Policies = append(result.Policies, &models.PolicySimulationPolicy{Name: p.name, Source: p.source, Group: p.group, Missing: p.policy == nil})
+		}
+		allowed, layerDeny, matches := layer.evaluate(args)
+		for _, match := range matches {
+			result.MatchedStatements = append(result.MatchedStatements, newSimulationStatement(match))
+			if allow == nil && match.statement.Effect == policy.Allow {
+				m := match
+				allow = &m
+			}
+		}
+		if deny == nil && layerDeny != nil {
+			deny = layerDeny
+		}
+		if !allowed && refusedBy == "" {
+			refusedBy = layer.description
+		}
+	}
+	switch {
+	case deny != nil:
+		result.Decision = models.PolicySimulationResultDecisionExplicitDeny
+		result.DecidingStatement = newSimulationStatement(*deny)
+		result.Reason = fmt.Sprintf("denied by %s", describeSimulatedMatch(*deny))
+	case refusedBy != "":
+		result.Decision = models.PolicySimulationResultDecisionImplicitDeny
+		result.Reason = fmt.Sprintf("no statement of %s allows the action", refusedBy)
+	default:
+		result.Allowed = true
+		result.Decision = models.PolicySimulationResultDecisionAllowed
+		result.DecidingStatement = newSimulationStatement(*allow)
+		result.Reason = fmt.Sprintf("allowed by %s", describeSimulatedMatch(*allow))
+	}
+}
+
+func describeSimulatedMatch(match simulatedMatch) string {
+	desc := fmt.Sprintf("statement %d", match.index)
+	if match.statement.SID != "" {
+		desc = fmt.Sprintf("statement %s", match.statement.SID)
+	}
+	switch match.policy.source {
+	case models.PolicySimulationPolicySourceGroup:
+		return fmt.Sprintf("%s of policy %s attached to group %s", desc, match.policy.name, match.policy.group)
+	case models.PolicySimulationPolicySourceServiceAccount:
+		return fmt.Sprintf("%s of the service account policy", desc)
+	case models.PolicySimulationPolicySourceSession:
+		return fmt.Sprintf("%s of the session policy", desc)
+	}
+	return fmt.Sprintf("%s of policy %s attached to the user", desc, match.policy.name)
+}
+
+func getSimulatePolicyResponse(session *models.Principal, params policyApi.SimulatePolicyParams) (*models.PolicySimulationResult, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validatePolicySimulation(params.Body); err != nil {
+		return nil, ErrorWithContext(ctx, ErrBadRequest, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := simulatePolicy(ctx, adminClient, params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,142 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatePolicy(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	policies := map[string]string{
+		"readphotos": `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["arn:aws:s3:::photos/*"]}]}`,
+		"home":       `{"Version":"2012-10-17","Statement":[{"Action":["s3:*"],"Effect":"Allow","Resource":["arn:aws:s3:::home/${aws:username}/*"]}]}`,
+		"denyprivate": `{"Version":"2012-10-17","Statement":[{"Sid":"private","Action":["s3:*"],"Effect":"Deny","Resource":["arn:aws:s3:::photos/private/*"]},
+			{"Action":["s3:PutObject"],"Effect":"Allow","Resource":["arn:aws:s3:::photos/*"],"Condition":{"IpAddress":{"aws:SourceIp":["10.0.0.0/8"]}}}]}`,
+	}
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		if p, ok := policies[name]; ok {
+			return iampolicy.ParseConfig(bytes.NewReader([]byte(p)))
+		}
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		switch accessKey {
+		case "alice":
+			return madmin.UserInfo{PolicyName: "readphotos,home,gone", Status: madmin.AccountEnabled, MemberOf: []string{"editors", "archived"}}, nil
+		case "bob":
+			return madmin.UserInfo{PolicyName: "readphotos", Status: madmin.AccountDisabled}, nil
+		}
+		return madmin.UserInfo{}, madmin.ErrorResponse{Code: "XMinioAdminNoSuchUser"}
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		if group == "archived" {
+			return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupDisabled), Policy: "consoleAdmin"}, nil
+		}
+		return &madmin.GroupDesc{Name: group, Status: string(madmin.GroupEnabled), Policy: "denyprivate"}, nil
+	}
+	minioInfoServiceAccountMock = func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error) {
+		if serviceAccount == "alice-sa" {
+			return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: "on",
+				Policy: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["arn:aws:s3:::home/*"]}]}`}, nil
+		}
+		return madmin.InfoServiceAccountResp{}, madmin.ErrorResponse{Code: "XMinioAdminServiceAccountNotFound"}
+	}
+	simulate := func(principal, action, resource string, conditions map[string][]string) *models.PolicySimulationResult {
+		result, err := simulatePolicy(ctx, adminClientMock{}, &models.PolicySimulationRequest{
+			Principal: swag.String(principal), Action: swag.String(action), Resource: resource, Conditions: conditions,
+		})
+		assert.Nil(err)
+		return result
+	}
+
+	// Test-1: allowed by a user policy, disabled groups are ignored and missing policies reported
+	result := simulate("alice", "s3:GetObject", "arn:aws:s3:::photos/2022/cat.png", nil)
+	assert.True(result.Allowed)
+	assert.Equal(models.PolicySimulationResultDecisionAllowed, result.Decision)
+	assert.Equal("readphotos", result.DecidingStatement.Policy)
+	assert.Equal("allowed by statement 0 of policy readphotos attached to the user", result.Reason)
+	assert.Len(result.Policies, 4)
+	assert.True(result.Policies[2].Missing)
+	assert.Equal("editors", result.Policies[3].Group)
+
+	// Test-2: an explicit deny of a group policy wins over the user policies
+	result = simulate("alice", "s3:GetObject", "photos/private/me.png", nil)
+	assert.False(result.Allowed)
+	assert.Equal(models.PolicySimulationResultDecisionExplicitDeny, result.Decision)
+	assert.Equal("private", result.DecidingStatement.Sid)
+	assert.Equal(models.PolicySimulationStatementSourceGroup, result.DecidingStatement.Source)
+	assert.Len(result.MatchedStatements, 2)
+
+	// Test-3: conditions and policy variables are evaluated
+	result = simulate("alice", "s3:PutObject", "arn:aws:s3:::photos/new.png", nil)
+	assert.Equal(models.PolicySimulationResultDecisionImplicitDeny, result.Decision)
+	assert.Equal("no statement of the user and group policies allows the action", result.Reason)
+	result = simulate("alice", "s3:PutObject", "arn:aws:s3:::photos/new.png", map[string][]string{"SourceIp": {"10.1.2.3"}})
+	assert.True(result.Allowed)
+	result = simulate("alice", "s3:PutObject", "arn:aws:s3:::home/alice/notes.txt", nil)
+	assert.True(result.Allowed)
+	result = simulate("alice", "s3:PutObject", "arn:aws:s3:::home/bob/notes.txt", nil)
+	assert.False(result.Allowed)
+
+	// Test-4: a service account is limited by both its parent and its own policy
+	result = simulate("alice-sa", "s3:GetObject", "arn:aws:s3:::home/alice/notes.txt", nil)
+	assert.True(result.Allowed)
+	assert.Equal("alice", result.ParentUser)
+	assert.Equal(models.PolicySimulationResultPrincipalTypeServiceAccount, result.PrincipalType)
+	result = simulate("alice-sa", "s3:PutObject", "arn:aws:s3:::home/alice/notes.txt", nil)
+	assert.False(result.Allowed)
+	assert.Equal("no statement of the service account policy allows the action", result.Reason)
+
+	// Test-5: disabled and unknown principals
+	result = simulate("bob", "s3:GetObject", "arn:aws:s3:::photos/cat.png", nil)
+	assert.False(result.Allowed)
+	assert.Equal("the user is disabled", result.Reason)
+	_, err := simulatePolicy(ctx, adminClientMock{}, &models.PolicySimulationRequest{Principal: swag.String("nobody"), Action: swag.String("s3:GetObject"), Resource: "photos"})
+	assert.True(errors.Is(err, ErrPrincipalNotFound))
+
+	// Test-6: the session policy restricts the principal further
+	result, err = simulatePolicy(ctx, adminClientMock{}, &models.PolicySimulationRequest{
+		Principal: swag.String("alice"), Action: swag.String("s3:GetObject"), Resource: "photos/cat.png",
+		SessionPolicy: `{"Version":"2012-10-17","Statement":[{"Action":["s3:GetObject"],"Effect":"Allow","Resource":["arn:aws:s3:::home/*"]}]}`,
+	})
+	assert.Nil(err)
+	assert.Equal("no statement of the session policy allows the action", result.Reason)
+}
+
+func TestValidatePolicySimulation(t *testing.T) {
+	assert := assert.New(t)
+	request := func(action, resource, session string) *models.PolicySimulationRequest {
+		return &models.PolicySimulationRequest{Principal: swag.String("alice"), Action: swag.String(action), Resource: resource, SessionPolicy: session}
+	}
+	assert.Nil(validatePolicySimulation(request("s3:GetObject", "arn:aws:s3:::photos/cat.png", "")))
+	assert.Nil(validatePolicySimulation(request("admin:ServerInfo", "", "")))
+	assert.NotNil(validatePolicySimulation(request("s3:Get*", "photos", "")))
+	assert.NotNil(validatePolicySimulation(request("s3:Fly", "photos", "")))
+	assert.NotNil(validatePolicySimulation(request("s3:GetObject", "", "")))
+	assert.NotNil(validatePolicySimulation(request("s3:GetObject", "photos", "{")))
+}
@@ -0,0 +1,498 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	policyApi "github.com/GuinsooLab/console/restapi/operations/policy"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+var (
+	policyTemplateIDRegexp          = regexp.MustCompile(`^[a-z0-9-]+$`)
+	policyTemplateParameterRegexp   = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	policyTemplatePlaceholderRegexp = regexp.MustCompile(`{{\s*([A-Za-z0-9_]+)\s*}}`)
+)
+
+// policyTemplatesMu serializes the updates of the custom policy templates file
+var policyTemplatesMu sync.Mutex
+
+func newTemplateParameter(name, kind, description string, required bool) *models.PolicyTemplateParameter {
+	return &models.PolicyTemplateParameter{Name: swag.String(name), Type: kind, Description: description, Required: required}
+}
+
+// builtinPolicyTemplates are the templates shipped with the console, they can't be changed or deleted
+var builtinPolicyTemplates = []*models.PolicyTemplate{
+	{
+		ID:          swag.String("bucket-read-only"),
+		Name:        swag.String("Read-only bucket"),
+		Description: "List and download the objects of a bucket.",
+		Builtin:     true,
+		Parameters:  []*models.PolicyTemplateParameter{newTemplateParameter("bucket", models.PolicyTemplateParameterTypeBucket, "bucket to grant access to", true)},
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[
+			{"Effect":"Allow","Action":["s3:GetBucketLocation","s3:ListBucket"],"Resource":["arn:aws:s3:::{{bucket}}"]},
+			{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::{{bucket}}/*"]}]}`),
+	},
+	{
+		ID:          swag.String("bucket-read-write"),
+		Name:        swag.String("Read-write bucket"),
+		Description: "List, download, upload and delete the objects of a bucket.",
+		Builtin:     true,
+		Parameters:  []*models.PolicyTemplateParameter{newTemplateParameter("bucket", models.PolicyTemplateParameterTypeBucket, "bucket to grant access to", true)},
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[
+			{"Effect":"Allow","Action":["s3:GetBucketLocation","s3:ListBucket","s3:ListBucketMultipartUploads"],"Resource":["arn:aws:s3:::{{bucket}}"]},
+			{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject","s3:DeleteObject","s3:AbortMultipartUpload","s3:ListMultipartUploadParts"],"Resource":["arn:aws:s3:::{{bucket}}/*"]}]}`),
+	},
+	{
+		ID:          swag.String("prefix-write-only"),
+		Name:        swag.String("Write-only prefix"),
+		Description: "Upload objects under a prefix of a bucket without being able to list or download them.",
+		Builtin:     true,
+		Parameters: []*models.PolicyTemplateParameter{
+			newTemplateParameter("bucket", models.PolicyTemplateParameterTypeBucket, "bucket to grant access to", true),
+			newTemplateParameter("prefix", models.PolicyTemplateParameterTypePrefix, "prefix the objects are uploaded under", true),
+		},
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[
+			{"Effect":"Allow","Action":["s3:PutObject","s3:AbortMultipartUpload","s3:ListMultipartUploadParts"],"Resource":["arn:aws:s3:::{{bucket}}/{{prefix}}*"]}]}`),
+	},
+	{
+		ID:          swag.String("home-directory"),
+		Name:        swag.String("Home directory"),
+		Description: "Full access to the objects under a prefix named after the user, so one policy serves every user.",
+		Builtin:     true,
+		Parameters:  []*models.PolicyTemplateParameter{newTemplateParameter("bucket", models.PolicyTemplateParameterTypeBucket, "bucket keeping the home directories", true)},
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[
+			{"Effect":"Allow","Action":["s3:GetBucketLocation"],"Resource":["arn:aws:s3:::{{bucket}}"]},
+			{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::{{bucket}}"],"Condition":{"StringLike":{"s3:prefix":["${aws:username}/*"]}}},
+			{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::{{bucket}}/${aws:username}/*"]}]}`),
+	},
+	{
+		ID:          swag.String("replication-admin"),
+		Name:        swag.String("Replication admin"),
+		Description: "Configure the replication of a bucket and its remote targets.",
+		Builtin:     true,
+		Parameters:  []*models.PolicyTemplateParameter{newTemplateParameter("bucket", models.PolicyTemplateParameterTypeBucket, "bucket to replicate", true)},
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[
+			{"Effect":"Allow","Action":["admin:SetBucketTarget","admin:GetBucketTarget"]},
+			{"Effect":"Allow","Action":["s3:GetBucketLocation","s3:ListBucket","s3:ListBucketMultipartUploads","s3:GetBucketVersioning","s3:PutBucketVersioning",
+				"s3:GetReplicationConfiguration","s3:PutReplicationConfiguration","s3:GetBucketObjectLockConfiguration"],"Resource":["arn:aws:s3:::{{bucket}}"]},
+			{"Effect":"Allow","Action":["s3:GetObject","s3:GetObjectVersion","s3:GetObjectVersionTagging","s3:GetObjectRetention","s3:GetObjectLegalHold",
+				"s3:ReplicateObject","s3:ReplicateDelete","s3:ReplicateTags","s3:GetObjectVersionForReplication"],"Resource":["arn:aws:s3:::{{bucket}}/*"]}]}`),
+	},
+}
+
+func registerPolicyTemplatesHandlers(api *operations.ConsoleAPI) {
+	// list policy templates
+	api.PolicyListPolicyTemplatesHandler = policyApi.ListPolicyTemplatesHandlerFunc(func(params policyApi.ListPolicyTemplatesParams, session *models.Principal) middleware.Responder {
+		resp, err := getListPolicyTemplatesResponse(session, params)
+		if err != nil {
+			return policyApi.NewListPolicyTemplatesDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewListPolicyTemplatesOK().WithPayload(resp)
+	})
+	// register a custom policy template
+	api.PolicyCreatePolicyTemplateHandler = policyApi.CreatePolicyTemplateHandlerFunc(func(params policyApi.CreatePolicyTemplateParams, session *models.Principal) middleware.Responder {
+		resp, err := getCreatePolicyTemplateResponse(session, params)
+		if err != nil {
+			return policyApi.NewCreatePolicyTemplateDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewCreatePolicyTemplateCreated().WithPayload(resp)
+	})
+	// delete a custom policy template
+	api.PolicyDeletePolicyTemplateHandler = policyApi.DeletePolicyTemplateHandlerFunc(func(params policyApi.DeletePolicyTemplateParams, session *models.Principal) middleware.Responder {
+		if err := getDeletePolicyTemplateResponse(session, params); err != nil {
+			return policyApi.NewDeletePolicyTemplateDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewDeletePolicyTemplateNoContent()
+	})
+	// render a policy template
+	api.PolicyRenderPolicyTemplateHandler = policyApi.RenderPolicyTemplateHandlerFunc(func(params policyApi.RenderPolicyTemplateParams, session *models.Principal) middleware.Responder {
+		resp, err := getRenderPolicyTemplateResponse(session, params)
+		if err != nil {
+			return policyApi.NewRenderPolicyTemplateDefault(int(err.Code)).WithPayload(err)
+		}
+		return policyApi.NewRenderPolicyTemplateOK().WithPayload(resp)
+	})
+}
+
+// getPolicyTemplatesFile returns the file keeping the custom policy templates
+func getPolicyTemplatesFile() string {
+	return filepath.Join(getDataDir(), "policy-templates.json")
+}
+
+func readPolicyTemplates(file string) ([]*models.PolicyTemplate, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var templates []*models.PolicyTemplate
+	if err = json.Unmarshal(b, &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+func writePolicyTemplates(file string, templates []*models.PolicyTemplate) error {
+	return writeDataFile(file, templates)
+}
+
+// listPolicyTemplates returns the built-in templates followed by the custom ones sorted by name
+func listPolicyTemplates(file string) ([]*models.PolicyTemplate, error) {
+	custom, err := readPolicyTemplates(file)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(custom, func(i, j int) bool { return swag.StringValue(custom[i].Name) < swag.StringValue(custom[j].Name) })
+	return append(append([]*models.PolicyTemplate{}, builtinPolicyTemplates...), custom...), nil
+}
+
+func findPolicyTemplate(file, id string) (*models.PolicyTemplate, error) {
+	templates, err := listPolicyTemplates(file)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range templates {
+		if swag.StringValue(t.ID) == id {
+			return t, nil
+		}
+	}
+	return nil, ErrPolicyTemplateNotFound
+}
+
+// normalizeTemplateValue validates a parameter value, prefixes are returned without leading and
+// with trailing slash so templates can append a wildcard to them
+func normalizeTemplateValue(param *models.PolicyTemplateParameter, value string) (string, error) {
+	name := swag.StringValue(param.Name)
+	switch param.Type {
+	case models.PolicyTemplateParameterTypeBucket:
+		if err := s3utils.CheckValidBucketNameStrict(value); err != nil {
+			return "", fmt.Errorf("%w: parameter %s: %v", ErrInvalidPolicyTemplate, name, err)
+		}
+	case models.PolicyTemplateParameterTypePrefix:
+		if strings.ContainsAny(value, "*?") {
+			return "", fmt.Errorf("%w: parameter %s can't contain wildcards", ErrInvalidPolicyTemplate, name)
+		}
+		value = strings.TrimLeft(value, "/")
+		if value != "" && !strings.HasSuffix(value, "/") {
+			value += "/"
+		}
+	}
+	return value, nil
+}
+
+// renderPolicyTemplate replaces the placeholders of the template with the values, or the defaults of
+// the parameters, and returns the resulting policy
+func renderPolicyTemplate(t *models.PolicyTemplate, values map[string]string) (*iampolicy.Policy, error) {
+	params := map[string]*models.PolicyTemplateParameter{}
+	for _, p := range t.Parameters {
+		params[swag.StringValue(p.Name)] = p
+	}
+	for name := range values {
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("%w: unknown parameter %s", ErrInvalidPolicyTemplate, name)
+		}
+	}
+	resolved := map[string]string{}
+	for name, p := range params {
+		value := values[name]
+		if value == "" {
+			value = p.Default
+		}
+		if value == "" {
+			if p.Required {
+				return nil, fmt.Errorf("%w: parameter %s is required", ErrInvalidPolicyTemplate, name)
+			}
+		} else {
+			var err error
+			if value, err = normalizeTemplateValue(p, value); err != nil {
+				return nil, err
+			}
+		}
+		resolved[name] = value
+	}
+	var renderErr error
+	document := policyTemplatePlaceholderRegexp.ReplaceAllStringFunc(swag.StringValue(t.Policy), func(placeholder string) string {
+		name := policyTemplatePlaceholderRegexp.FindStringSubmatch(placeholder)[1]
+		value, ok := resolved[name]
+		if !ok {
+			renderErr = fmt.Errorf("%w: placeholder %s is not a parameter", ErrInvalidPolicyTemplate, placeholder)
+			return placeholder
+		}
+		// the placeholders are inside JSON strings, escape the value the same way
+		b, _ := json.Marshal(value)
+		return string(b[1 : len(b)-1])
+	})
+	if renderErr != nil {
+		return nil, renderErr
+	}
+	p, err := iampolicy.ParseConfig(bytes.NewReader([]byte(document)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPolicyTemplate, err)
+	}
+	return p, nil
+}
+
+// validatePolicyTemplate checks the template renders into a valid policy with sample values
+func validatePolicyTemplate(t *models.PolicyTemplate) error {
+	id := swag.StringValue(t.ID)
+	if !policyTemplateIDRegexp.MatchString(id) {
+		return fmt.Errorf("%w: the id can only contain lowercase letters, digits and dashes", ErrInvalidPolicyTemplate)
+	}
+	if strings.TrimSpace(swag.StringValue(t.Name)) == "" {
+		return fmt.Errorf("%w: the name is required", ErrInvalidPolicyTemplate)
+	}
+	samples := map[string]string{}
+	for _, p := range t.Parameters {
+		name := swag.StringValue(p.Name)
+		if !policyTemplateParameterRegexp.MatchString(name) {
+			return fmt.Errorf("%w: invalid parameter name %q", ErrInvalidPolicyTemplate, name)
+		}
+		if _, ok := samples[name]; ok {
+			return fmt.Errorf("%w: duplicated parameter %s", ErrInvalidPolicyTemplate, name)
+		}
+		switch p.Type {
+		case "":
+			p.Type = models.PolicyTemplateParameterTypeString
+			samples[name] = "value"
+		case models.PolicyTemplateParameterTypeBucket:
+			samples[name] = "bucket"
+		case models.PolicyTemplateParameterTypePrefix:
+			samples[name] = "prefix/"
+		case models.PolicyTemplateParameterTypeString:
+			samples[name] = "value"
+		default:
+			return fmt.Errorf("%w: invalid type %s of parameter %s", ErrInvalidPolicyTemplate, p.Type, name)
+		}
+		if p.Default != "" {
+			if _, err := normalizeTemplateValue(p, p.Default); err != nil {
+				return err
+			}
+		}
+	}
+	_, err := renderPolicyTemplate(t, samples)
+	return err
+}
+
+func createPolicyTemplate(file string, t *models.PolicyTemplate) (*models.PolicyTemplate, error) {
+	t.Builtin = false
+	if err := validatePolicyTemplate(t); err != nil {
+		return nil, err
+	}
+	policyTemplatesMu.Lock()
+	defer policyTemplatesMu.Unlock()
+	if _, err := findPolicyTemplate(file, swag.StringValue(t.ID)); err == nil {
+		return nil, ErrPolicyTemplateExists
+	} else if err != ErrPolicyTemplateNotFound {
+		return nil, err
+	}
+	custom, err := readPolicyTemplates(file)
+	if err != nil {
+		return nil, err
+	}
+	if err = writePolicyTemplates(file, append(custom, t)); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func deletePolicyTemplate(file, id string) error {
+	policyTemplatesMu.Lock()
+	defer policyTemplatesMu.Unlock()
+	for _, t := range builtinPolicyTemplates {
+		if swag.StringValue(t.ID) == id {
+			return ErrPolicyTemplateBuiltin
+		}
+	}
+	custom, err := readPolicyTemplates(file)
+	if err != nil {
+		return err
+	}
+	for i, t := range custom {
+		if swag.StringValue(t.ID) == id {
+			return writePolicyTemplates(file, append(custom[:i], custom[i+1:]...))
+		}
+	}
+	return ErrPolicyTemplateNotFound
+}
+
+// validatePolicyTargets checks the users and groups exist, returning the users
+func validatePolicyTargets(ctx context.Context, client MinioAdmin, users, groups []string) (map[string]madmin.UserInfo, error) {
+	allUsers, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if _, ok := allUsers[user]; !ok {
+			return nil, fmt.Errorf("%w: user %s does not exist", ErrInvalidPolicyTemplate, user)
+		}
+	}
+	if len(groups) > 0 {
+		allGroups, err := client.listGroups(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			if !containsName(allGroups, group) {
+				return nil, fmt.Errorf("%w: group %s does not exist", ErrInvalidPolicyTemplate, group)
+			}
+		}
+	}
+	return allUsers, nil
+}
+
+// attachPolicy adds the policy to the ones already attached to the users and groups
+func attachPolicy(ctx context.Context, client MinioAdmin, name string, allUsers map[string]madmin.UserInfo, users, groups []string) error {
+	for _, user := range users {
+		policies := splitPolicyNames(allUsers[user].PolicyName)
+		if !containsName(policies, name) {
+			if err := client.setPolicy(ctx, strings.Join(append(policies, name), ","), user, false); err != nil {
+				return err
+			}
+		}
+	}
+	for _, group := range groups {
+		desc, err := client.getGroupDescription(ctx, group)
+		if err != nil {
+			return err
+		}
+		policies := splitPolicyNames(desc.Policy)
+		if !containsName(policies, name) {
+			if err := client.setPolicy(ctx, strings.Join(append(policies, name), ","), group, true); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyPolicyTemplate renders the template and, when a name is given, saves the policy recording its
+// revision and attaches it to the users and groups
+func applyPolicyTemplate(ctx context.Context, client MinioAdmin, t *models.PolicyTemplate, req *models.PolicyTemplateRenderRequest, author string) (*models.PolicyTemplateRenderResult, error) {
+	p, err := renderPolicyTemplate(t, req.Values)
+	if err != nil {
+		return nil, err
+	}
+	document, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.PolicyTemplateRenderResult{Policy: string(document), Name: req.Name, Users: []string{}, Groups: []string{}}
+	if req.Name == "" {
+		if len(req.Users) > 0 || len(req.Groups) > 0 {
+			return nil, fmt.Errorf("%w: a name is required to attach the policy", ErrInvalidPolicyTemplate)
+		}
+		return result, nil
+	}
+	if strings.Contains(req.Name, " ") {
+		return nil, ErrPolicyNameContainsSpace
+	}
+	allUsers, err := validatePolicyTargets(ctx, client, req.Users, req.Groups)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = savePolicy(ctx, client, getPolicyHistoryDir(), req.Name, author, "", 0, p); err != nil {
+		return nil, err
+	}
+	result.Saved = true
+	if err = attachPolicy(ctx, client, req.Name, allUsers, req.Users, req.Groups); err != nil {
+		return nil, err
+	}
+	result.Users = append(result.Users, req.Users...)
+	result.Groups = append(result.Groups, req.Groups...)
+	return result, nil
+}
+
+func getListPolicyTemplatesResponse(session *models.Principal, params policyApi.ListPolicyTemplatesParams) (*models.PolicyTemplateList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateSessionAdminAction(ctx, session, iampolicy.GetPolicyAdminAction, "Policy templates not available."); err != nil {
+		return nil, err
+	}
+	templates, err := listPolicyTemplates(getPolicyTemplatesFile())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.PolicyTemplateList{Templates: templates}, nil
+}
+
+func getCreatePolicyTemplateResponse(session *models.Principal, params policyApi.CreatePolicyTemplateParams) (*models.PolicyTemplate, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validatePolicyTemplatesWriteAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	t, err := createPolicyTemplate(getPolicyTemplatesFile(), params.Body)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return t, nil
+}
+
+func getDeletePolicyTemplateResponse(session *models.Principal, params policyApi.DeletePolicyTemplateParams) *models.Error {
+	ctx := params.HTTPRequest.Context()
+	if err := validatePolicyTemplatesWriteAccess(ctx, session); err != nil {
+		return err
+	}
+	if err := deletePolicyTemplate(getPolicyTemplatesFile(), params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getRenderPolicyTemplateResponse(session *models.Principal, params policyApi.RenderPolicyTemplateParams) (*models.PolicyTemplateRenderResult, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	t, err := findPolicyTemplate(getPolicyTemplatesFile(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := applyPolicyTemplate(ctx, adminClient, t, params.Body, session.AccountAccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
+
+// validatePolicyTemplatesWriteAccess verifies the session is allowed to create policies, custom
+// templates are shared by every console user
+func validatePolicyTemplatesWriteAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.CreatePolicyAdminAction, "Policy templates can't be changed.")
+}
@@ -0,0 +1,159 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderPolicyTemplate(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: every built-in template renders into a valid policy
+	for _, tpl := range builtinPolicyTemplates {
+		assert.Nil(validatePolicyTemplate(tpl), swag.StringValue(tpl.ID))
+	}
+
+	// Test-2: prefixes are normalized and policy variables kept
+	tpl, err := findPolicyTemplate(filepath.Join(t.TempDir(), "templates.json"), "prefix-write-only")
+	if !assert.Nil(err) {
+		return
+	}
+	p, err := renderPolicyTemplate(tpl, map[string]string{"bucket": "logs", "prefix": "/app"})
+	if assert.Nil(err) {
+		assert.True(p.IsAllowed(iampolicy.Args{Action: iampolicy.PutObjectAction, BucketName: "logs", ObjectName: "app/today.log"}))
+		assert.False(p.IsAllowed(iampolicy.Args{Action: iampolicy.PutObjectAction, BucketName: "logs", ObjectName: "other/today.log"}))
+	}
+	tpl, _ = findPolicyTemplate("", "home-directory")
+	p, err = renderPolicyTemplate(tpl, map[string]string{"bucket": "home"})
+	if assert.Nil(err) {
+		assert.True(p.IsAllowed(iampolicy.Args{Action: iampolicy.GetObjectAction, BucketName: "home", ObjectName: "alice/notes.txt",
+			ConditionValues: map[string][]string{"username": {"alice"}}}))
+	}
+
+	// Test-3: invalid values
+	_, err = renderPolicyTemplate(tpl, map[string]string{})
+	assert.Equal("invalid policy template: parameter bucket is required", err.Error())
+	_, err = renderPolicyTemplate(tpl, map[string]string{"bucket": "Not_A_Bucket"})
+	assert.True(errors.Is(err, ErrInvalidPolicyTemplate))
+	_, err = renderPolicyTemplate(tpl, map[string]string{"bucket": "home", "owner": "x"})
+	assert.Equal("invalid policy template: unknown parameter owner", err.Error())
+}
+
+func TestCustomPolicyTemplates(t *testing.T) {
+	assert := assert.New(t)
+	file := filepath.Join(t.TempDir(), "templates.json")
+	custom := &models.PolicyTemplate{
+		ID:   swag.String("tagging"),
+		Name: swag.String("Object tagging"),
+		Parameters: []*models.PolicyTemplateParameter{
+			{Name: swag.String("bucket"), Type: models.PolicyTemplateParameterTypeBucket, Required: true},
+		},
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObjectTagging"],"Resource":["arn:aws:s3:::{{bucket}}/*"]}]}`),
+	}
+
+	// Test-1: custom templates are listed after the built-in ones
+	_, err := createPolicyTemplate(file, custom)
+	assert.Nil(err)
+	templates, err := listPolicyTemplates(file)
+	assert.Nil(err)
+	if assert.Len(templates, len(builtinPolicyTemplates)+1) {
+		assert.Equal("tagging", swag.StringValue(templates[len(templates)-1].ID))
+		assert.False(templates[len(templates)-1].Builtin)
+	}
+
+	// Test-2: ids are unique and placeholders must be declared parameters
+	_, err = createPolicyTemplate(file, custom)
+	assert.Equal(ErrPolicyTemplateExists, err)
+	_, err = createPolicyTemplate(file, &models.PolicyTemplate{ID: swag.String("broken"), Name: swag.String("Broken"),
+		Policy: swag.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::{{bucket}}/*"]}]}`)})
+	assert.Equal("invalid policy template: placeholder {{bucket}} is not a parameter", err.Error())
+
+	// Test-3: only custom templates can be deleted
+	assert.Equal(ErrPolicyTemplateBuiltin, deletePolicyTemplate(file, "bucket-read-only"))
+	assert.Nil(deletePolicyTemplate(file, "tagging"))
+	assert.Equal(ErrPolicyTemplateNotFound, deletePolicyTemplate(file, "tagging"))
+}
+
+func TestApplyPolicyTemplate(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	saved := map[string]*iampolicy.Policy{}
+	var attached []string
+	minioGetPolicyMock = func(name string) (*iampolicy.Policy, error) {
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchPolicy"}
+	}
+	minioAddPolicyMock = func(name string, policy *iampolicy.Policy) error {
+		saved[name] = policy
+		return nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		attached = append(attached, entityName+"="+policyName)
+		return nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"alice": {PolicyName: "readwrite"}}, nil
+	}
+	minioListGroupsMock = func() ([]string, error) {
+		return []string{"editors"}, nil
+	}
+	minioGetGroupDescriptionMock = func(group string) (*madmin.GroupDesc, error) {
+		return &madmin.GroupDesc{Name: group}, nil
+	}
+	tpl, _ := findPolicyTemplate(getPolicyTemplatesFile(), "bucket-read-only")
+
+	// Test-1: without a name the policy is only rendered
+	result, err := applyPolicyTemplate(ctx, adminClientMock{}, tpl, &models.PolicyTemplateRenderRequest{Values: map[string]string{"bucket": "photos"}}, "admin")
+	if assert.Nil(err) {
+		assert.False(result.Saved)
+		assert.Contains(result.Policy, "arn:aws:s3:::photos/*")
+	}
+	assert.Empty(saved)
+
+	// Test-2: the policy is saved, recorded and added to the policies of the users and groups
+	result, err = applyPolicyTemplate(ctx, adminClientMock{}, tpl, &models.PolicyTemplateRenderRequest{
+		Name: "readphotos", Values: map[string]string{"bucket": "photos"}, Users: []string{"alice"}, Groups: []string{"editors"},
+	}, "admin")
+	if assert.Nil(err) {
+		assert.True(result.Saved)
+		assert.NotNil(saved["readphotos"])
+		assert.Equal([]string{"alice=readwrite,readphotos", "editors=readphotos"}, attached)
+	}
+	history, err := readPolicyHistory(getPolicyHistoryDir(), "readphotos")
+	assert.Nil(err)
+	if assert.Len(history.Revisions, 1) {
+		assert.Equal("admin", history.Revisions[0].Author)
+	}
+
+	// Test-3: unknown users are rejected before saving
+	delete(saved, "readphotos")
+	_, err = applyPolicyTemplate(ctx, adminClientMock{}, tpl, &models.PolicyTemplateRenderRequest{
+		Name: "readphotos", Values: map[string]string{"bucket": "photos"}, Users: []string{"bob"},
+	}, "admin")
+	assert.Equal("invalid policy template: user bob does not exist", err.Error())
+	assert.Empty(saved)
+}
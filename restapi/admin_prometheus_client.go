@@ -0,0 +1,265 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	systemApi "github.com/GuinsooLab/console/restapi/operations/system"
+	"github.com/go-openapi/runtime/middleware"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerPrometheusCheckHandler(api *operations.ConsoleAPI) {
+	// check the connectivity with prometheus
+	api.SystemCheckPrometheusHandler = systemApi.CheckPrometheusHandlerFunc(func(params systemApi.CheckPrometheusParams, session *models.Principal) middleware.Responder {
+		resp, err := getCheckPrometheusResponse(session, params)
+		if err != nil {
+			return systemApi.NewCheckPrometheusDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewCheckPrometheusOK().WithPayload(resp)
+	})
+}
+
+// prometheusClientConfig holds the authentication and TLS options of the Prometheus queries
+type prometheusClientConfig struct {
+	username        string
+	password        string
+	bearerToken     string
+	bearerTokenFile string
+	caFile          string
+	clientCert      string
+	clientKey       string
+	insecure        bool
+}
+
+func getPrometheusClientConfig() prometheusClientConfig {
+	conf := prometheusClientConfig{
+		bearerToken:     getPrometheusBearerToken(),
+		bearerTokenFile: getPrometheusBearerTokenFile(),
+		caFile:          getPrometheusCAFile(),
+		insecure:        getPrometheusInsecure(),
+	}
+	conf.username, conf.password = getPrometheusBasicAuth()
+	conf.clientCert, conf.clientKey = getPrometheusClientCert()
+	return conf
+}
+
+// authType returns the kind of authentication used on the queries
+func (c prometheusClientConfig) authType() string {
+	switch {
+	case c.username != "":
+		return "basic"
+	case c.bearerToken != "" || c.bearerTokenFile != "":
+		return "bearer"
+	}
+	return "none"
+}
+
+// prometheusClient is the http client used for the Prometheus queries
+type prometheusClient struct {
+	conf   prometheusClientConfig
+	files  prometheusCertFiles
+	client *http.Client
+}
+
+// prometheusCertFiles are the modification times of the CA and client certificate files
+type prometheusCertFiles [3]time.Time
+
+// getPrometheusCertFiles returns the modification times of the certificate files of conf, missing
+// files are reported when the client is built
+func getPrometheusCertFiles(conf prometheusClientConfig) prometheusCertFiles {
+	var files prometheusCertFiles
+	for i, file := range []string{conf.caFile, conf.clientCert, conf.clientKey} {
+		if file == "" {
+			continue
+		}
+		if info, err := os.Stat(file); err == nil {
+			files[i] = info.ModTime()
+		}
+	}
+	return files
+}
+
+var (
+	prometheusClientMu     sync.Mutex
+	globalPrometheusClient *prometheusClient
+)
+
+// getPrometheusClient returns the client for the Prometheus queries, it is only built again when the
+// configuration or the certificate files change so connections are reused and rotated certificates
+// are picked up
+func getPrometheusClient() (*prometheusClient, error) {
+	prometheusClientMu.Lock()
+	defer prometheusClientMu.Unlock()
+	conf := getPrometheusClientConfig()
+	files := getPrometheusCertFiles(conf)
+	if globalPrometheusClient != nil && globalPrometheusClient.conf == conf && globalPrometheusClient.files == files {
+		return globalPrometheusClient, nil
+	}
+	c, err := newPrometheusClient(conf)
+	if err != nil {
+		return nil, err
+	}
+	c.files = files
+	if globalPrometheusClient != nil && globalPrometheusClient.client != c.client {
+		globalPrometheusClient.client.CloseIdleConnections()
+	}
+	globalPrometheusClient = c
+	return c, nil
+}
+
+func newPrometheusClient(conf prometheusClientConfig) (*prometheusClient, error) {
+	if conf.caFile == "" && conf.clientCert == "" && !conf.insecure {
+		return &prometheusClient{conf: conf, client: GetConsoleHTTPClient()}, nil
+	}
+	if (conf.clientCert == "") != (conf.clientKey == "") {
+		return nil, fmt.Errorf("%s and %s must be set together", PrometheusClientCert, PrometheusClientKey)
+	}
+	transport := PrepareSTSClientTransport(conf.insecure)
+	if conf.caFile != "" {
+		pem, err := os.ReadFile(conf.caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := GlobalRootCAs
+		if pool == nil {
+			if pool, err = x509.SystemCertPool(); err != nil {
+				pool = x509.NewCertPool()
+			}
+		}
+		pool = pool.Clone()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found on %s", conf.caFile)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	if conf.clientCert != "" {
+		cert, err := tls.LoadX509KeyPair(conf.clientCert, conf.clientKey)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig.Certificates = []tls.Certificate{cert}
+	}
+	return &prometheusClient{conf: conf, client: &http.Client{Transport: transport}}, nil
+}
+
+// token returns the bearer token, the token file is read every time so rotated tokens are picked up
+func (c *prometheusClient) token() (string, error) {
+	if c.conf.bearerTokenFile == "" {
+		return c.conf.bearerToken, nil
+	}
+	b, err := os.ReadFile(c.conf.bearerTokenFile)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("bearer token file %s is empty", c.conf.bearerTokenFile)
+	}
+	return token, nil
+}
+
+// do sends a request to Prometheus with the configured credentials
+func (c *prometheusClient) do(req *http.Request) (*http.Response, error) {
+	switch c.conf.authType() {
+	case "basic":
+		req.SetBasicAuth(c.conf.username, c.conf.password)
+	case "bearer":
+		token, err := c.token()
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return c.client.Do(req)
+}
+
+func (c *prometheusClient) get(ctx context.Context, endpoint string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+// checkPrometheus checks Prometheus is reachable and the credentials are accepted by its API
+func checkPrometheus(ctx context.Context, prometheusURL string) *models.PrometheusCheck {
+	conf := getPrometheusClientConfig()
+	check := &models.PrometheusCheck{
+		URL:               prometheusURL,
+		Configured:        prometheusURL != "",
+		AuthType:          conf.authType(),
+		TLS:               strings.HasPrefix(prometheusURL, "https://"),
+		ClientCertificate: conf.clientCert != "",
+	}
+	if prometheusURL == "" {
+		check.Error = fmt.Sprintf("%s is not set", PrometheusURL)
+		return check
+	}
+	c, err := getPrometheusClient()
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	resp, err := c.get(ctx, prometheusURL+"/api/v1/status/buildinfo")
+	check.Latency = time.Since(start).Milliseconds()
+	if err != nil {
+		check.Error = err.Error()
+		return check
+	}
+	defer resp.Body.Close()
+	check.Reachable = true
+	check.StatusCode = int32(resp.StatusCode)
+	if resp.StatusCode != http.StatusOK {
+		check.Error = fmt.Sprintf("unexpected response from prometheus (%s)", resp.Status)
+		return check
+	}
+	var buildInfo struct {
+		Data struct {
+			Version string `json:"version"`
+		} `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&buildInfo); err != nil {
+		check.Error = "unexpected response from prometheus, the url must point to the prometheus server"
+		return check
+	}
+	check.Version = buildInfo.Data.Version
+	return check
+}
+
+func getCheckPrometheusResponse(session *models.Principal, params systemApi.CheckPrometheusParams) (*models.PrometheusCheck, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateSessionAdminAction(ctx, session, iampolicy.ServerInfoAdminAction, "Dashboard not available."); err != nil {
+		return nil, err
+	}
+	return checkPrometheus(ctx, getPrometheusURL()), nil
+}
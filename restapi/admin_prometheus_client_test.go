@@ -0,0 +1,203 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestPrometheusServer returns a TLS server answering as Prometheus when authorized
+func newTestPrometheusServer(t *testing.T, authorized func(r *http.Request) bool) (*httptest.Server, string) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/status/buildinfo":
+			w.Write([]byte(`{"status":"success","data":{"version":"2.36.0"}}`))
+		case "/api/v1/label/server/values":
+			w.Write([]byte(`{"status":"success","data":["node1:9000"]}`))
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequestClientCert}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	assert.Nil(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+	return server, caFile
+}
+
+// newTestClientCertificate writes a self-signed client certificate and its key
+func newTestClientCertificate(t *testing.T, commonName string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	assert.Nil(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	assert.Nil(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile
+}
+
+func TestPrometheusBasicAuth(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	server, caFile := newTestPrometheusServer(t, func(r *http.Request) bool {
+		username, password, ok := r.BasicAuth()
+		return ok && username == "console" && password == "secret"
+	})
+
+	// Test-1: the server certificate isn't trusted without the CA
+	check := checkPrometheus(ctx, server.URL)
+	assert.True(check.Configured)
+	assert.True(check.TLS)
+	assert.False(check.Reachable)
+	assert.Contains(check.Error, "certificate")
+
+	// Test-2: with the CA and credentials the API is reachable
+	t.Setenv(PrometheusCAFile, caFile)
+	t.Setenv(PrometheusUsername, "console")
+	t.Setenv(PrometheusPassword, "secret")
+	check = checkPrometheus(ctx, server.URL)
+	assert.Empty(check.Error)
+	assert.True(check.Reachable)
+	assert.Equal("basic", check.AuthType)
+	assert.Equal(int32(http.StatusOK), check.StatusCode)
+	assert.Equal("2.36.0", check.Version)
+	assert.True(testPrometheusURL(ctx, server.URL))
+	values, err := prometheusQuerier{url: server.URL}.labelValues(ctx, "server")
+	assert.Nil(err)
+	assert.Equal([]string{"node1:9000"}, values)
+
+	// Test-3: rejected credentials are reported
+	t.Setenv(PrometheusPassword, "wrong")
+	check = checkPrometheus(ctx, server.URL)
+	assert.True(check.Reachable)
+	assert.Equal(int32(http.StatusUnauthorized), check.StatusCode)
+	assert.NotEmpty(check.Error)
+	assert.False(testPrometheusURL(ctx, server.URL))
+	_, err = prometheusQuerier{url: server.URL}.labelValues(ctx, "server")
+	assert.Error(err)
+
+	// Test-4: missing url
+	check = checkPrometheus(ctx, "")
+	assert.False(check.Configured)
+	assert.Contains(check.Error, PrometheusURL)
+}
+
+func TestPrometheusBearerTokenFile(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	expected := "token-1"
+	server, caFile := newTestPrometheusServer(t, func(r *http.Request) bool {
+		return r.Header.Get("Authorization") == "Bearer "+expected
+	})
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	assert.Nil(os.WriteFile(tokenFile, []byte("token-1\n"), 0o600))
+	t.Setenv(PrometheusCAFile, caFile)
+	t.Setenv(PrometheusBearerTokenFile, tokenFile)
+
+	check := checkPrometheus(ctx, server.URL)
+	assert.Equal("bearer", check.AuthType)
+	assert.Empty(check.Error)
+
+	// the token file is read again on every query
+	expected = "token-2"
+	assert.Nil(os.WriteFile(tokenFile, []byte("token-2"), 0o600))
+	check = checkPrometheus(ctx, server.URL)
+	assert.Empty(check.Error)
+
+	assert.Nil(os.WriteFile(tokenFile, []byte(""), 0o600))
+	check = checkPrometheus(ctx, server.URL)
+	assert.False(check.Reachable)
+	assert.Contains(check.Error, "empty")
+}
+
+func TestPrometheusClientCertificate(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	expected := "console"
+	server, caFile := newTestPrometheusServer(t, func(r *http.Request) bool {
+		return r.TLS != nil && len(r.TLS.PeerCertificates) > 0 && r.TLS.PeerCertificates[0].Subject.CommonName == expected
+	})
+	certFile, keyFile := newTestClientCertificate(t, "console")
+	t.Setenv(PrometheusCAFile, caFile)
+
+	// Test-1: without client certificate the request is rejected
+	check := checkPrometheus(ctx, server.URL)
+	assert.Equal(int32(http.StatusUnauthorized), check.StatusCode)
+
+	// Test-2: the client certificate is presented
+	t.Setenv(PrometheusClientCert, certFile)
+	t.Setenv(PrometheusClientKey, keyFile)
+	check = checkPrometheus(ctx, server.URL)
+	assert.Empty(check.Error)
+	assert.True(check.ClientCertificate)
+	assert.Equal("none", check.AuthType)
+
+	// Test-3: a certificate rotated at the same path is picked up
+	expected = "rotated"
+	rotatedCert, rotatedKey := newTestClientCertificate(t, "rotated")
+	later := time.Now().Add(time.Minute)
+	for src, dst := range map[string]string{rotatedCert: certFile, rotatedKey: keyFile} {
+		b, err := os.ReadFile(src)
+		assert.Nil(err)
+		assert.Nil(os.WriteFile(dst, b, 0o600))
+		assert.Nil(os.Chtimes(dst, later, later))
+	}
+	check = checkPrometheus(ctx, server.URL)
+	assert.Empty(check.Error)
+
+	// Test-4: invalid configurations are reported
+	t.Setenv(PrometheusClientKey, "")
+	check = checkPrometheus(ctx, server.URL)
+	assert.Contains(check.Error, PrometheusClientKey)
+	t.Setenv(PrometheusClientKey, keyFile)
+	t.Setenv(PrometheusCAFile, keyFile)
+	check = checkPrometheus(ctx, server.URL)
+	assert.Contains(check.Error, "no certificates found")
+}
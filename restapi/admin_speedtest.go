@@ -29,14 +29,53 @@ import (
 	"github.com/minio/madmin-go"
 )
 
-// getSpeedtesthOptionsFromReq gets duration, size & concurrent requests from a websocket
-// path come as : `/speedtest?duration=2h&size=12MiB&concurrent=10`
-func getSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error) {
-	optionsSet := madmin.SpeedtestOpts{}
+const (
+	speedtestModeObject = "object"
+	speedtestModeDrive  = "drive"
+	speedtestModeNet    = "net"
+)
 
-	queryPairs := req.URL.Query()
+// speedtestRequest options of a speedtest run, only the options of `mode` are used
+type speedtestRequest struct {
+	mode        string
+	object      madmin.SpeedtestOpts
+	drive       madmin.DriveSpeedTestOpts
+	netDuration time.Duration
+}
 
-	paramDuration := queryPairs.Get("duration")
+// getSpeedtestOptionsFromReq gets the speedtest mode and its options from a websocket
+// path come as : `/speedtest?mode=object&duration=2h&size=12MiB&concurrent=10`,
+// `/speedtest?mode=drive&blocksize=4MiB&filesize=1GiB&serial=true` or `/speedtest?mode=net&duration=10s`
+func getSpeedtestOptionsFromReq(req *http.Request) (*speedtestRequest, error) {
+	opts := speedtestRequest{mode: req.URL.Query().Get("mode")}
+	switch opts.mode {
+	case "", speedtestModeObject:
+		opts.mode = speedtestModeObject
+		objectOpts, err := getObjectSpeedtestOptionsFromReq(req)
+		if err != nil {
+			return nil, err
+		}
+		opts.object = *objectOpts
+	case speedtestModeDrive:
+		driveOpts, err := getDriveSpeedtestOptionsFromReq(req)
+		if err != nil {
+			return nil, err
+		}
+		opts.drive = *driveOpts
+	case speedtestModeNet:
+		duration, err := getSpeedtestDurationFromReq(req)
+		if err != nil {
+			return nil, err
+		}
+		opts.netDuration = duration
+	default:
+		return nil, fmt.Errorf("invalid speedtest mode: %s", opts.mode)
+	}
+	return &opts, nil
+}
+
+func getSpeedtestDurationFromReq(req *http.Request) (time.Duration, error) {
+	paramDuration := req.URL.Query().Get("duration")
 
 	if paramDuration == "" {
 		paramDuration = "10s"
@@ -44,11 +83,25 @@ func getSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error
 
 	duration, err := time.ParseDuration(paramDuration)
 	if err != nil {
-		return nil, fmt.Errorf("unable to parse duration: %s", paramDuration)
+		return 0, fmt.Errorf("unable to parse duration: %s", paramDuration)
 	}
 
 	if duration <= 0 {
-		return nil, fmt.Errorf("duration cannot be 0 or negative")
+		return 0, fmt.Errorf("duration cannot be 0 or negative")
+	}
+	return duration, nil
+}
+
+// getObjectSpeedtestOptionsFromReq gets duration, size & concurrent requests from a websocket
+// path come as : `/speedtest?duration=2h&size=12MiB&concurrent=10`
+func getObjectSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error) {
+	optionsSet := madmin.SpeedtestOpts{}
+
+	queryPairs := req.URL.Query()
+
+	duration, err := getSpeedtestDurationFromReq(req)
+	if err != nil {
+		return nil, err
 	}
 
 	optionsSet.Duration = duration
@@ -96,27 +149,120 @@ func getSpeedtestOptionsFromReq(req *http.Request) (*madmin.SpeedtestOpts, error
 	return &optionsSet, nil
 }
 
-func startSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, speedtestOpts *madmin.SpeedtestOpts) error {
-	speedtestRes, err := client.speedtest(ctx, *speedtestOpts)
+// getDriveSpeedtestOptionsFromReq gets block size, file size & serial mode from a websocket
+// path come as : `/speedtest?mode=drive&blocksize=4MiB&filesize=1GiB&serial=true`
+func getDriveSpeedtestOptionsFromReq(req *http.Request) (*madmin.DriveSpeedTestOpts, error) {
+	optionsSet := madmin.DriveSpeedTestOpts{}
+
+	queryPairs := req.URL.Query()
+
+	paramBlockSize := queryPairs.Get("blocksize")
+	if paramBlockSize == "" {
+		paramBlockSize = "4MiB"
+	}
+	blockSize, err := humanize.ParseBytes(paramBlockSize)
+	if err != nil || blockSize == 0 {
+		return nil, fmt.Errorf("unable to parse block size: %s", paramBlockSize)
+	}
+	optionsSet.BlockSize = blockSize
+
+	paramFileSize := queryPairs.Get("filesize")
+	if paramFileSize == "" {
+		paramFileSize = "1GiB"
+	}
+	fileSize, err := humanize.ParseBytes(paramFileSize)
+	if err != nil || fileSize == 0 {
+		return nil, fmt.Errorf("unable to parse file size: %s", paramFileSize)
+	}
+	if fileSize < blockSize {
+		return nil, fmt.Errorf("file size cannot be smaller than the block size")
+	}
+	optionsSet.FileSize = fileSize
+
+	if queryPairs.Get("serial") == "true" {
+		optionsSet.Serial = true
+	}
+
+	return &optionsSet, nil
+}
+
+// startSpeedtest runs the requested speedtest sending every result through the websocket,
+// the results are saved once the run is done so it can be compared with other runs
+func startSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, opts *speedtestRequest) error {
+	recorder := newSpeedtestRecorder(opts)
+	var err error
+	switch opts.mode {
+	case speedtestModeDrive:
+		err = startDriveSpeedtest(ctx, conn, client, opts.drive, recorder)
+	case speedtestModeNet:
+		err = startNetperf(ctx, conn, client, opts.netDuration, recorder)
+	default:
+		err = startObjectSpeedtest(ctx, conn, client, opts.object, recorder)
+	}
+	if saveErr := recorder.save(getSpeedtestResultsDir(), err); saveErr != nil {
+		LogError("error saving speedtest result: %v", saveErr)
+	}
+	return err
+}
+
+func startObjectSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, speedtestOpts madmin.SpeedtestOpts, recorder *speedtestRecorder) error {
+	speedtestRes, err := client.speedtest(ctx, speedtestOpts)
 	if err != nil {
 		LogError("error initializing speedtest: %v", err)
 		return err
 	}
 
 	for result := range speedtestRes {
-		// Serializing message
-		bytes, err := json.Marshal(result)
-		if err != nil {
-			LogError("error serializing json: %v", err)
+		// autotune sends partial results, only the last one is kept
+		recorder.setObjectResult(result)
+		if err = writeSpeedtestResult(conn, result); err != nil {
 			return err
 		}
-		// Send Message through websocket connection
-		err = conn.writeMessage(websocket.TextMessage, bytes)
-		if err != nil {
-			LogError("error writing speedtest response: %v", err)
+	}
+
+	return nil
+}
+
+func startDriveSpeedtest(ctx context.Context, conn WSConn, client MinioAdmin, driveOpts madmin.DriveSpeedTestOpts, recorder *speedtestRecorder) error {
+	speedtestRes, err := client.driveSpeedtest(ctx, driveOpts)
+	if err != nil {
+		LogError("error initializing drive speedtest: %v", err)
+		return err
+	}
+
+	// a result is sent per server
+	for result := range speedtestRes {
+		recorder.addDriveResult(result)
+		if err = writeSpeedtestResult(conn, result); err != nil {
 			return err
 		}
 	}
 
 	return nil
 }
+
+func startNetperf(ctx context.Context, conn WSConn, client MinioAdmin, duration time.Duration, recorder *speedtestRecorder) error {
+	result, err := client.netperf(ctx, duration)
+	if err != nil {
+		LogError("error on netperf: %v", err)
+		return err
+	}
+	recorder.setNetResult(result)
+	return writeSpeedtestResult(conn, result)
+}
+
+func writeSpeedtestResult(conn WSConn, result interface{}) error {
+	// Serializing message
+	bytes, err := json.Marshal(result)
+	if err != nil {
+		LogError("error serializing json: %v", err)
+		return err
+	}
+	// Send Message through websocket connection
+	err = conn.writeMessage(websocket.TextMessage, bytes)
+	if err != nil {
+		LogError("error writing speedtest response: %v", err)
+		return err
+	}
+	return nil
+}
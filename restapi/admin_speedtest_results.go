@@ -0,0 +1,365 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	speedtestApi "github.com/GuinsooLab/console/restapi/operations/speedtest"
+	"github.com/dustin/go-humanize"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	speedtestUnitThroughput = "B/s"
+	speedtestUnitObjects    = "objects/s"
+)
+
+func registerSpeedtestResultsHandlers(api *operations.ConsoleAPI) {
+	// list speedtest results
+	api.SpeedtestListSpeedtestResultsHandler = speedtestApi.ListSpeedtestResultsHandlerFunc(func(params speedtestApi.ListSpeedtestResultsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListSpeedtestResultsResponse(session, params)
+		if err != nil {
+			return speedtestApi.NewListSpeedtestResultsDefault(int(err.Code)).WithPayload(err)
+		}
+		return speedtestApi.NewListSpeedtestResultsOK().WithPayload(resp)
+	})
+	// get speedtest result
+	api.SpeedtestGetSpeedtestResultHandler = speedtestApi.GetSpeedtestResultHandlerFunc(func(params speedtestApi.GetSpeedtestResultParams, session *models.Principal) middleware.Responder {
+		resp, err := getSpeedtestResultResponse(session, params)
+		if err != nil {
+			return speedtestApi.NewGetSpeedtestResultDefault(int(err.Code)).WithPayload(err)
+		}
+		return speedtestApi.NewGetSpeedtestResultOK().WithPayload(resp)
+	})
+	// delete speedtest result
+	api.SpeedtestDeleteSpeedtestResultHandler = speedtestApi.DeleteSpeedtestResultHandlerFunc(func(params speedtestApi.DeleteSpeedtestResultParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteSpeedtestResultResponse(session, params); err != nil {
+			return speedtestApi.NewDeleteSpeedtestResultDefault(int(err.Code)).WithPayload(err)
+		}
+		return speedtestApi.NewDeleteSpeedtestResultNoContent()
+	})
+	// compare speedtest results
+	api.SpeedtestCompareSpeedtestResultsHandler = speedtestApi.CompareSpeedtestResultsHandlerFunc(func(params speedtestApi.CompareSpeedtestResultsParams, session *models.Principal) middleware.Responder {
+		resp, err := getCompareSpeedtestResultsResponse(session, params)
+		if err != nil {
+			return speedtestApi.NewCompareSpeedtestResultsDefault(int(err.Code)).WithPayload(err)
+		}
+		return speedtestApi.NewCompareSpeedtestResultsOK().WithPayload(resp)
+	})
+}
+
+// getSpeedtestResultsDir returns the directory holding the results of the speedtest runs
+func getSpeedtestResultsDir() string {
+	return filepath.Join(getDataDir(), "speedtests")
+}
+
+// speedtestRecorder collects the metrics of a speedtest run
+type speedtestRecorder struct {
+	result   models.SpeedtestResult
+	received bool
+}
+
+func newSpeedtestRecorder(opts *speedtestRequest) *speedtestRecorder {
+	now := time.Now().UTC()
+	r := &speedtestRecorder{result: models.SpeedtestResult{
+		ID:         newDataID(now),
+		Mode:       opts.mode,
+		StartTime:  now.Format(time.RFC3339),
+		Parameters: map[string]string{},
+	}}
+	params := r.result.Parameters
+	switch opts.mode {
+	case speedtestModeDrive:
+		params["blockSize"] = humanize.IBytes(opts.drive.BlockSize)
+		params["fileSize"] = humanize.IBytes(opts.drive.FileSize)
+		params["serial"] = strconv.FormatBool(opts.drive.Serial)
+	case speedtestModeNet:
+		params["duration"] = opts.netDuration.String()
+	default:
+		params["duration"] = opts.object.Duration.String()
+		params["size"] = humanize.IBytes(uint64(opts.object.Size))
+		params["concurrent"] = strconv.Itoa(opts.object.Concurrency)
+		params["autotune"] = strconv.FormatBool(opts.object.Autotune)
+	}
+	return r
+}
+
+// setObjectResult keeps the metrics of the latest object speedtest result
+func (r *speedtestRecorder) setObjectResult(result madmin.SpeedTestResult) {
+	r.received = true
+	// servers and drives are part of the parameters so hardware changes show on comparisons
+	r.result.Parameters["servers"] = strconv.Itoa(result.Servers)
+	r.result.Parameters["drives"] = strconv.Itoa(result.Disks)
+	if r.result.Parameters["autotune"] == "true" {
+		r.result.Parameters["concurrent"] = strconv.Itoa(result.Concurrent)
+	}
+	var metrics []*models.SpeedtestMetric
+	stats := []struct {
+		op    string
+		stats madmin.SpeedTestStats
+	}{{"put", result.PUTStats}, {"get", result.GETStats}}
+	for _, s := range stats {
+		metrics = append(metrics,
+			&models.SpeedtestMetric{Name: s.op + ".throughput", Value: float64(s.stats.ThroughputPerSec), Unit: speedtestUnitThroughput},
+			&models.SpeedtestMetric{Name: s.op + ".objects", Value: float64(s.stats.ObjectsPerSec), Unit: speedtestUnitObjects},
+		)
+		for _, server := range s.stats.Servers {
+			metrics = append(metrics,
+				&models.SpeedtestMetric{Name: s.op + ".throughput", Endpoint: server.Endpoint, Value: float64(server.ThroughputPerSec), Unit: speedtestUnitThroughput, Error: server.Err},
+				&models.SpeedtestMetric{Name: s.op + ".objects", Endpoint: server.Endpoint, Value: float64(server.ObjectsPerSec), Unit: speedtestUnitObjects, Error: server.Err},
+			)
+		}
+	}
+	r.result.Metrics = metrics
+}
+
+// addDriveResult appends the metrics of every drive of a server
+func (r *speedtestRecorder) addDriveResult(result madmin.DriveSpeedTestResult) {
+	r.received = true
+	if result.Error != "" {
+		r.result.Metrics = append(r.result.Metrics, &models.SpeedtestMetric{Name: "drive", Endpoint: result.Endpoint, Error: result.Error})
+	}
+	for _, drive := range result.DrivePerf {
+		endpoint := strings.TrimSuffix(result.Endpoint, "/") + "/" + strings.TrimPrefix(drive.Path, "/")
+		r.result.Metrics = append(r.result.Metrics,
+			&models.SpeedtestMetric{Name: "drive.read", Endpoint: endpoint, Value: float64(drive.ReadThroughput), Unit: speedtestUnitThroughput, Error: drive.Error},
+			&models.SpeedtestMetric{Name: "drive.write", Endpoint: endpoint, Value: float64(drive.WriteThroughput), Unit: speedtestUnitThroughput, Error: drive.Error},
+		)
+	}
+}
+
+// setNetResult keeps the metrics of every node plus the cluster totals
+func (r *speedtestRecorder) setNetResult(result madmin.NetperfResult) {
+	r.received = true
+	r.result.Parameters["servers"] = strconv.Itoa(len(result.NodeResults))
+	var tx, rx uint64
+	var metrics []*models.SpeedtestMetric
+	for _, node := range result.NodeResults {
+		if node.Error == "" {
+			tx += node.TX
+			rx += node.RX
+		}
+		metrics = append(metrics,
+			&models.SpeedtestMetric{Name: "net.tx", Endpoint: node.Endpoint, Value: float64(node.TX), Unit: speedtestUnitThroughput, Error: node.Error},
+			&models.SpeedtestMetric{Name: "net.rx", Endpoint: node.Endpoint, Value: float64(node.RX), Unit: speedtestUnitThroughput, Error: node.Error},
+		)
+	}
+	r.result.Metrics = append([]*models.SpeedtestMetric{
+		{Name: "net.tx", Value: float64(tx), Unit: speedtestUnitThroughput},
+		{Name: "net.rx", Value: float64(rx), Unit: speedtestUnitThroughput},
+	}, metrics...)
+}
+
+// save persists the run, runs that didn't produce any result are not kept
+func (r *speedtestRecorder) save(dir string, runErr error) error {
+	if !r.received {
+		return nil
+	}
+	r.result.EndTime = time.Now().UTC().Format(time.RFC3339)
+	if runErr != nil {
+		r.result.Error = runErr.Error()
+	}
+	return writeDataFile(filepath.Join(dir, r.result.ID+".json"), r.result)
+}
+
+// listSpeedtestResults returns the saved runs, most recent first, optionally of a single mode
+func listSpeedtestResults(dir, mode string) ([]*models.SpeedtestResult, error) {
+	ids, err := listDataIDs(dir, ".json")
+	if err != nil {
+		return nil, err
+	}
+	results := []*models.SpeedtestResult{}
+	for _, id := range ids {
+		result, err := getSpeedtestResult(dir, id)
+		if err != nil {
+			LogError("skipping invalid speedtest result %s: %v", id, err)
+			continue
+		}
+		if mode != "" && result.Mode != mode {
+			continue
+		}
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].StartTime > results[j].StartTime
+	})
+	return results, nil
+}
+
+func getSpeedtestResult(dir, id string) (*models.SpeedtestResult, error) {
+	var result models.SpeedtestResult
+	if err := readDataFile(dir, id, ".json", &result, ErrSpeedtestResultNotFound); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func deleteSpeedtestResult(dir, id string) error {
+	return removeDataFile(dir, id, ".json", ErrSpeedtestResultNotFound)
+}
+
+// compareSpeedtestResults diffs the metrics of two runs of the same mode, metrics are matched by
+// name and endpoint and keep the order of the base run followed by the ones only found on target
+func compareSpeedtestResults(base, target *models.SpeedtestResult) (*models.SpeedtestComparison, error) {
+	if base.Mode != target.Mode {
+		return nil, ErrSpeedtestModeMismatch
+	}
+	comparison := &models.SpeedtestComparison{
+		Base:              base,
+		Target:            target,
+		ParametersChanged: []string{},
+		Metrics:           []*models.SpeedtestMetricDiff{},
+	}
+	params := map[string]bool{}
+	for k := range base.Parameters {
+		params[k] = true
+	}
+	for k := range target.Parameters {
+		params[k] = true
+	}
+	for k := range params {
+		bv, bok := base.Parameters[k]
+		tv, tok := target.Parameters[k]
+		if bv != tv || bok != tok {
+			comparison.ParametersChanged = append(comparison.ParametersChanged, k)
+		}
+	}
+	sort.Strings(comparison.ParametersChanged)
+
+	metricKey := func(m *models.SpeedtestMetric) string {
+		return m.Name + "@" + m.Endpoint
+	}
+	targetMetrics := map[string]*models.SpeedtestMetric{}
+	for _, m := range target.Metrics {
+		targetMetrics[metricKey(m)] = m
+	}
+	seen := map[string]bool{}
+	for _, b := range base.Metrics {
+		key := metricKey(b)
+		seen[key] = true
+		diff := &models.SpeedtestMetricDiff{Name: b.Name, Endpoint: b.Endpoint, Unit: b.Unit, Base: b.Value}
+		t, ok := targetMetrics[key]
+		if !ok {
+			diff.Status = models.SpeedtestMetricDiffStatusRemoved
+			comparison.Metrics = append(comparison.Metrics, diff)
+			continue
+		}
+		diff.Target = t.Value
+		diff.Delta = t.Value - b.Value
+		if b.Value != 0 {
+			diff.DeltaPercent = math.Round(diff.Delta/b.Value*10000) / 100
+		}
+		diff.Status = models.SpeedtestMetricDiffStatusChanged
+		if diff.Delta == 0 {
+			diff.Status = models.SpeedtestMetricDiffStatusUnchanged
+		}
+		comparison.Metrics = append(comparison.Metrics, diff)
+	}
+	for _, t := range target.Metrics {
+		if seen[metricKey(t)] {
+			continue
+		}
+		comparison.Metrics = append(comparison.Metrics, &models.SpeedtestMetricDiff{
+			Name:     t.Name,
+			Endpoint: t.Endpoint,
+			Unit:     t.Unit,
+			Target:   t.Value,
+			Delta:    t.Value,
+			Status:   models.SpeedtestMetricDiffStatusAdded,
+		})
+	}
+	return comparison, nil
+}
+
+func getListSpeedtestResultsResponse(session *models.Principal, params speedtestApi.ListSpeedtestResultsParams) (*models.ListSpeedtestResultsResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateSpeedtestResultsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	mode := ""
+	if params.Mode != nil {
+		mode = *params.Mode
+	}
+	results, err := listSpeedtestResults(getSpeedtestResultsDir(), mode)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.ListSpeedtestResultsResponse{Results: results}, nil
+}
+
+func getSpeedtestResultResponse(session *models.Principal, params speedtestApi.GetSpeedtestResultParams) (*models.SpeedtestResult, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateSpeedtestResultsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	result, err := getSpeedtestResult(getSpeedtestResultsDir(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
+
+func getDeleteSpeedtestResultResponse(session *models.Principal, params speedtestApi.DeleteSpeedtestResultParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateSpeedtestResultsAccess(ctx, session); err != nil {
+		return err
+	}
+	if err := deleteSpeedtestResult(getSpeedtestResultsDir(), params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+func getCompareSpeedtestResultsResponse(session *models.Principal, params speedtestApi.CompareSpeedtestResultsParams) (*models.SpeedtestComparison, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateSpeedtestResultsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	base, err := getSpeedtestResult(getSpeedtestResultsDir(), params.Base)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	target, err := getSpeedtestResult(getSpeedtestResultsDir(), params.Target)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	comparison, err := compareSpeedtestResults(base, target)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return comparison, nil
+}
+
+// validateSpeedtestResultsAccess verifies the session is allowed to run speedtests on the cluster
+func validateSpeedtestResultsAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.HealthInfoAdminAction, "Speedtest results not available.")
+}
@@ -0,0 +1,241 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// assigning mock at runtime instead of compile time
+var (
+	minioSpeedtestMock      func(ctx context.Context, opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error)
+	minioDriveSpeedtestMock func(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error)
+	minioNetperfMock        func(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error)
+)
+
+func (ac adminClientMock) speedtest(ctx context.Context, opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error) {
+	return minioSpeedtestMock(ctx, opts)
+}
+
+func (ac adminClientMock) driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+	return minioDriveSpeedtestMock(ctx, opts)
+}
+
+func (ac adminClientMock) netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+	return minioNetperfMock(ctx, duration)
+}
+
+func TestGetSpeedtestOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: object speedtest is the default
+	req, _ := http.NewRequest(http.MethodGet, "/ws/speedtest?duration=20s&size=1MiB&concurrent=4", nil)
+	opts, err := getSpeedtestOptionsFromReq(req)
+	if assert.Nil(err) {
+		assert.Equal(speedtestModeObject, opts.mode)
+		assert.Equal(20*time.Second, opts.object.Duration)
+		assert.Equal(1<<20, opts.object.Size)
+		assert.Equal(4, opts.object.Concurrency)
+	}
+
+	// Test-2: drive speedtest defaults
+	req, _ = http.NewRequest(http.MethodGet, "/ws/speedtest?mode=drive&serial=true", nil)
+	opts, err = getSpeedtestOptionsFromReq(req)
+	if assert.Nil(err) {
+		assert.Equal(speedtestModeDrive, opts.mode)
+		assert.Equal(uint64(4<<20), opts.drive.BlockSize)
+		assert.Equal(uint64(1<<30), opts.drive.FileSize)
+		assert.True(opts.drive.Serial)
+	}
+
+	// Test-3: netperf
+	req, _ = http.NewRequest(http.MethodGet, "/ws/speedtest?mode=net&duration=5s", nil)
+	opts, err = getSpeedtestOptionsFromReq(req)
+	if assert.Nil(err) {
+		assert.Equal(speedtestModeNet, opts.mode)
+		assert.Equal(5*time.Second, opts.netDuration)
+	}
+
+	// Test-4: invalid options
+	for _, u := range []string{
+		"/ws/speedtest?mode=disk",
+		"/ws/speedtest?mode=drive&blocksize=8MiB&filesize=4MiB",
+		"/ws/speedtest?mode=drive&filesize=abc",
+		"/ws/speedtest?mode=net&duration=-1s",
+		"/ws/speedtest?concurrent=0",
+	} {
+		req, _ = http.NewRequest(http.MethodGet, u, nil)
+		_, err = getSpeedtestOptionsFromReq(req)
+		assert.Error(err, u)
+	}
+}
+
+func TestStartSpeedtest(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	adminClient := adminClientMock{}
+	mockWSConn := mockConn{}
+	ctx := context.Background()
+
+	writes := 0
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		writes++
+		return nil
+	}
+
+	// Test-1: object speedtest, only the last autotune result is saved
+	minioSpeedtestMock = func(ctx context.Context, opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error) {
+		ch := make(chan madmin.SpeedTestResult, 2)
+		ch <- madmin.SpeedTestResult{Servers: 2, Disks: 8, Concurrent: 16, PUTStats: madmin.SpeedTestStats{ThroughputPerSec: 100}}
+		ch <- madmin.SpeedTestResult{
+			Servers: 2, Disks: 8, Concurrent: 32,
+			PUTStats: madmin.SpeedTestStats{ThroughputPerSec: 200, ObjectsPerSec: 20, Servers: []madmin.SpeedTestStatServer{
+				{Endpoint: "node1:9000", ThroughputPerSec: 120, ObjectsPerSec: 12},
+				{Endpoint: "node2:9000", ThroughputPerSec: 80, ObjectsPerSec: 8},
+			}},
+			GETStats: madmin.SpeedTestStats{ThroughputPerSec: 400, ObjectsPerSec: 40},
+		}
+		close(ch)
+		return ch, nil
+	}
+	opts := &speedtestRequest{mode: speedtestModeObject, object: madmin.SpeedtestOpts{Duration: 10 * time.Second, Size: 64 << 20, Concurrency: 32, Autotune: true}}
+	assert.Nil(startSpeedtest(ctx, mockWSConn, adminClient, opts))
+	assert.Equal(2, writes)
+	results, err := listSpeedtestResults(getSpeedtestResultsDir(), "")
+	assert.Nil(err)
+	if assert.Len(results, 1) {
+		result := results[0]
+		assert.Equal(speedtestModeObject, result.Mode)
+		assert.Equal(map[string]string{
+			"duration": "10s", "size": "64 MiB", "concurrent": "32", "autotune": "true", "servers": "2", "drives": "8",
+		}, result.Parameters)
+		assert.Len(result.Metrics, 8)
+		assert.Equal(&models.SpeedtestMetric{Name: "put.throughput", Value: 200, Unit: speedtestUnitThroughput}, result.Metrics[0])
+		assert.Equal(&models.SpeedtestMetric{Name: "put.throughput", Endpoint: "node1:9000", Value: 120, Unit: speedtestUnitThroughput}, result.Metrics[2])
+		assert.NotEmpty(result.EndTime)
+		assert.Empty(result.Error)
+	}
+
+	// Test-2: drive speedtest, a metric per drive
+	minioDriveSpeedtestMock = func(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+		ch := make(chan madmin.DriveSpeedTestResult, 2)
+		ch <- madmin.DriveSpeedTestResult{Endpoint: "node1:9000", DrivePerf: []madmin.DrivePerf{
+			{Path: "/data1", ReadThroughput: 500, WriteThroughput: 300},
+		}}
+		ch <- madmin.DriveSpeedTestResult{Endpoint: "node2:9000", Error: "drive offline"}
+		close(ch)
+		return ch, nil
+	}
+	opts = &speedtestRequest{mode: speedtestModeDrive, drive: madmin.DriveSpeedTestOpts{BlockSize: 4 << 20, FileSize: 1 << 30}}
+	assert.Nil(startSpeedtest(ctx, mockWSConn, adminClient, opts))
+	results, err = listSpeedtestResults(getSpeedtestResultsDir(), speedtestModeDrive)
+	assert.Nil(err)
+	if assert.Len(results, 1) {
+		assert.Equal([]*models.SpeedtestMetric{
+			{Name: "drive.read", Endpoint: "node1:9000/data1", Value: 500, Unit: speedtestUnitThroughput},
+			{Name: "drive.write", Endpoint: "node1:9000/data1", Value: 300, Unit: speedtestUnitThroughput},
+			{Name: "drive", Endpoint: "node2:9000", Error: "drive offline"},
+		}, results[0].Metrics)
+		assert.Equal("4.0 MiB", results[0].Parameters["blockSize"])
+	}
+
+	// Test-3: netperf, node metrics plus the cluster totals
+	minioNetperfMock = func(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+		return madmin.NetperfResult{NodeResults: []madmin.NetperfNodeResult{
+			{Endpoint: "node1:9000", TX: 10, RX: 20},
+			{Endpoint: "node2:9000", TX: 30, RX: 40},
+		}}, nil
+	}
+	opts = &speedtestRequest{mode: speedtestModeNet, netDuration: 10 * time.Second}
+	assert.Nil(startSpeedtest(ctx, mockWSConn, adminClient, opts))
+	results, err = listSpeedtestResults(getSpeedtestResultsDir(), speedtestModeNet)
+	assert.Nil(err)
+	if assert.Len(results, 1) {
+		assert.Len(results[0].Metrics, 6)
+		assert.Equal(&models.SpeedtestMetric{Name: "net.tx", Value: 40, Unit: speedtestUnitThroughput}, results[0].Metrics[0])
+		assert.Equal(&models.SpeedtestMetric{Name: "net.rx", Value: 60, Unit: speedtestUnitThroughput}, results[0].Metrics[1])
+	}
+
+	// Test-4: runs failing before any result are not saved
+	minioNetperfMock = func(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+		return madmin.NetperfResult{}, errors.New("netperf failed")
+	}
+	if err := startSpeedtest(ctx, mockWSConn, adminClient, opts); assert.Error(err) {
+		assert.Equal("netperf failed", err.Error())
+	}
+	results, err = listSpeedtestResults(getSpeedtestResultsDir(), "")
+	assert.Nil(err)
+	assert.Len(results, 3)
+
+	// Test-5: delete a result, ids can't be used to reach files outside the results directory
+	assert.True(errors.Is(deleteSpeedtestResult(getSpeedtestResultsDir(), "../"+results[0].ID), ErrSpeedtestResultNotFound))
+	assert.Nil(deleteSpeedtestResult(getSpeedtestResultsDir(), results[0].ID))
+	_, err = getSpeedtestResult(getSpeedtestResultsDir(), results[0].ID)
+	assert.True(errors.Is(err, ErrSpeedtestResultNotFound))
+}
+
+func TestCompareSpeedtestResults(t *testing.T) {
+	assert := assert.New(t)
+
+	base := &models.SpeedtestResult{
+		ID:         "base",
+		Mode:       speedtestModeObject,
+		Parameters: map[string]string{"size": "64 MiB", "servers": "2"},
+		Metrics: []*models.SpeedtestMetric{
+			{Name: "put.throughput", Value: 200, Unit: speedtestUnitThroughput},
+			{Name: "put.throughput", Endpoint: "node1:9000", Value: 100, Unit: speedtestUnitThroughput},
+			{Name: "put.throughput", Endpoint: "node2:9000", Value: 100, Unit: speedtestUnitThroughput},
+			{Name: "get.objects", Value: 0, Unit: speedtestUnitObjects},
+		},
+	}
+	target := &models.SpeedtestResult{
+		ID:         "target",
+		Mode:       speedtestModeObject,
+		Parameters: map[string]string{"size": "64 MiB", "servers": "3"},
+		Metrics: []*models.SpeedtestMetric{
+			{Name: "put.throughput", Value: 300, Unit: speedtestUnitThroughput},
+			{Name: "put.throughput", Endpoint: "node1:9000", Value: 100, Unit: speedtestUnitThroughput},
+			{Name: "put.throughput", Endpoint: "node3:9000", Value: 200, Unit: speedtestUnitThroughput},
+			{Name: "get.objects", Value: 5, Unit: speedtestUnitObjects},
+		},
+	}
+
+	// Test-1: metrics are matched by name and endpoint
+	comparison, err := compareSpeedtestResults(base, target)
+	assert.Nil(err)
+	assert.Equal([]string{"servers"}, comparison.ParametersChanged)
+	assert.Equal([]*models.SpeedtestMetricDiff{
+		{Name: "put.throughput", Unit: speedtestUnitThroughput, Base: 200, Target: 300, Delta: 100, DeltaPercent: 50, Status: models.SpeedtestMetricDiffStatusChanged},
+		{Name: "put.throughput", Endpoint: "node1:9000", Unit: speedtestUnitThroughput, Base: 100, Target: 100, Status: models.SpeedtestMetricDiffStatusUnchanged},
+		{Name: "put.throughput", Endpoint: "node2:9000", Unit: speedtestUnitThroughput, Base: 100, Status: models.SpeedtestMetricDiffStatusRemoved},
+		{Name: "get.objects", Unit: speedtestUnitObjects, Target: 5, Delta: 5, Status: models.SpeedtestMetricDiffStatusChanged},
+		{Name: "put.throughput", Endpoint: "node3:9000", Unit: speedtestUnitThroughput, Target: 200, Delta: 200, Status: models.SpeedtestMetricDiffStatusAdded},
+	}, comparison.Metrics)
+
+	// Test-2: runs of different modes can't be compared
+	target.Mode = speedtestModeDrive
+	_, err = compareSpeedtestResults(base, target)
+	assert.True(errors.Is(err, ErrSpeedtestModeMismatch))
+}
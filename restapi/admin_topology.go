@@ -0,0 +1,218 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	systemApi "github.com/GuinsooLab/console/restapi/operations/system"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+func registerErasureTopologyHandler(api *operations.ConsoleAPI) {
+	// return the pools, erasure sets and drives of the deployment
+	api.SystemErasureTopologyHandler = systemApi.ErasureTopologyHandlerFunc(func(params systemApi.ErasureTopologyParams, session *models.Principal) middleware.Responder {
+		resp, err := getErasureTopologyResponse(session, params)
+		if err != nil {
+			return systemApi.NewErasureTopologyDefault(int(err.Code)).WithPayload(err)
+		}
+		return systemApi.NewErasureTopologyOK().WithPayload(resp)
+	})
+}
+
+// getErasureTopology groups the drives reported by the servers into pools and erasure sets, drives
+// not assigned to a set yet are returned apart
+func getErasureTopology(ctx context.Context, client MinioAdmin) (*models.ErasureTopology, error) {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	topology := &models.ErasureTopology{
+		Pools:            []*models.TopologyPool{},
+		UnassignedDrives: []*models.TopologyDrive{},
+	}
+	// the backend is only known as an interface, decode it to get the configured parity
+	var backend madmin.ErasureBackend
+	if b, err := json.Marshal(info.Backend); err == nil {
+		_ = json.Unmarshal(b, &backend)
+	}
+	topology.StandardParity = int32(backend.StandardSCParity)
+	topology.ReducedParity = int32(backend.RRSCParity)
+
+	pools := map[int]*models.TopologyPool{}
+	sets := map[int]map[int]*models.TopologySet{}
+	servers := map[int]map[string]bool{}
+	for _, server := range info.Servers {
+		for _, disk := range server.Disks {
+			drive := newTopologyDrive(server.Endpoint, disk)
+			if disk.PoolIndex < 0 || disk.SetIndex < 0 {
+				topology.UnassignedDrives = append(topology.UnassignedDrives, drive)
+				continue
+			}
+			pool, ok := pools[disk.PoolIndex]
+			if !ok {
+				pool = &models.TopologyPool{Index: int32(disk.PoolIndex), Servers: []string{}, Sets: []*models.TopologySet{}}
+				pools[disk.PoolIndex] = pool
+				sets[disk.PoolIndex] = map[int]*models.TopologySet{}
+				servers[disk.PoolIndex] = map[string]bool{}
+			}
+			if !servers[disk.PoolIndex][server.Endpoint] {
+				servers[disk.PoolIndex][server.Endpoint] = true
+				pool.Servers = append(pool.Servers, server.Endpoint)
+			}
+			set, ok := sets[disk.PoolIndex][disk.SetIndex]
+			if !ok {
+				set = &models.TopologySet{Index: int32(disk.SetIndex), Drives: []*models.TopologyDrive{}}
+				sets[disk.PoolIndex][disk.SetIndex] = set
+				pool.Sets = append(pool.Sets, set)
+			}
+			set.Drives = append(set.Drives, drive)
+			switch {
+			case disk.State != madmin.DriveStateOk:
+				set.OfflineDrives++
+				pool.OfflineDrives++
+			case disk.Healing || disk.HealInfo != nil:
+				set.HealingDrives++
+				pool.HealingDrives++
+				set.OnlineDrives++
+				pool.OnlineDrives++
+			default:
+				set.OnlineDrives++
+				pool.OnlineDrives++
+			}
+		}
+	}
+
+	for _, pool := range pools {
+		sort.Strings(pool.Servers)
+		sort.Slice(pool.Sets, func(i, j int) bool { return pool.Sets[i].Index < pool.Sets[j].Index })
+		for _, set := range pool.Sets {
+			sort.Slice(set.Drives, func(i, j int) bool { return set.Drives[i].Index < set.Drives[j].Index })
+			set.Status = topologySetStatus(set, topology.StandardParity)
+			switch set.Status {
+			case models.TopologySetStatusUnavailable:
+				topology.UnavailableSets++
+			case models.TopologySetStatusDegraded:
+				topology.DegradedSets++
+			}
+		}
+		topology.Pools = append(topology.Pools, pool)
+	}
+	sort.Slice(topology.Pools, func(i, j int) bool { return topology.Pools[i].Index < topology.Pools[j].Index })
+	return topology, nil
+}
+
+// topologySetStatus returns the status of an erasure set, a set losing more drives than its parity
+// can no longer serve its objects
+func topologySetStatus(set *models.TopologySet, parity int32) string {
+	switch {
+	case parity > 0 && set.OfflineDrives > int64(parity):
+		return models.TopologySetStatusUnavailable
+	case set.OfflineDrives > 0:
+		return models.TopologySetStatusDegraded
+	case set.HealingDrives > 0:
+		return models.TopologySetStatusHealing
+	default:
+		return models.TopologySetStatusHealthy
+	}
+}
+
+// newTopologyDrive returns the health, usage and healing progress of a drive
+func newTopologyDrive(server string, disk madmin.Disk) *models.TopologyDrive {
+	drive := &models.TopologyDrive{
+		Index:           int32(disk.DiskIndex),
+		Server:          server,
+		Endpoint:        disk.Endpoint,
+		Path:            disk.DrivePath,
+		UUID:            disk.UUID,
+		State:           disk.State,
+		Model:           disk.Model,
+		TotalSpace:      int64(disk.TotalSpace),
+		UsedSpace:       int64(disk.UsedSpace),
+		AvailableSpace:  int64(disk.AvailableSpace),
+		FreeInodes:      int64(disk.FreeInodes),
+		Utilization:     disk.Utilization,
+		ReadLatency:     disk.ReadLatency,
+		WriteLatency:    disk.WriteLatency,
+		ReadThroughput:  disk.ReadThroughput,
+		WriteThroughput: disk.WriteThroughPut,
+		Operations:      []*models.TopologyDriveOperation{},
+	}
+	if disk.Metrics != nil {
+		for name, action := range disk.Metrics.LastMinute {
+			operation := &models.TopologyDriveOperation{Name: name, Count: int64(action.Count)}
+			if action.Count > 0 {
+				// average in milliseconds
+				operation.AvgLatency = float64(action.AccTime) / float64(action.Count) / float64(time.Millisecond)
+			}
+			drive.Operations = append(drive.Operations, operation)
+		}
+		sort.Slice(drive.Operations, func(i, j int) bool { return drive.Operations[i].Name < drive.Operations[j].Name })
+	}
+	if heal := disk.HealInfo; heal != nil {
+		drive.Healing = &models.TopologyDriveHealing{
+			ObjectsTotalCount: int64(heal.ObjectsTotalCount),
+			ObjectsTotalSize:  int64(heal.ObjectsTotalSize),
+			ItemsHealed:       int64(heal.ItemsHealed),
+			ItemsFailed:       int64(heal.ItemsFailed),
+			BytesDone:         int64(heal.BytesDone),
+			BytesFailed:       int64(heal.BytesFailed),
+			CurrentBucket:     heal.Bucket,
+			CurrentObject:     heal.Object,
+			QueuedBuckets:     int64(len(heal.QueuedBuckets)),
+			HealedBuckets:     int64(len(heal.HealedBuckets)),
+		}
+		if !heal.Started.IsZero() {
+			drive.Healing.Started = heal.Started.UTC().Format(time.RFC3339)
+		}
+		if !heal.LastUpdate.IsZero() {
+			drive.Healing.LastUpdate = heal.LastUpdate.UTC().Format(time.RFC3339)
+		}
+		if heal.ObjectsTotalCount > 0 {
+			drive.Healing.Progress = float64(heal.ItemsHealed) / float64(heal.ObjectsTotalCount) * 100
+		}
+	} else if disk.Healing {
+		drive.Healing = &models.TopologyDriveHealing{}
+	}
+	return drive
+}
+
+func getErasureTopologyResponse(session *models.Principal, params systemApi.ErasureTopologyParams) (*models.ErasureTopology, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateSessionAdminAction(ctx, session, iampolicy.ServerInfoAdminAction, "Topology not available."); err != nil {
+		return nil, err
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	topology, err := getErasureTopology(ctx, adminClient)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return topology, nil
+}
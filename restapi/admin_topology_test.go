@@ -0,0 +1,113 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetErasureTopology(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	started := time.Unix(1656000000, 0)
+	drive := func(pool, set, index int, state string) madmin.Disk {
+		return madmin.Disk{PoolIndex: pool, SetIndex: set, DiskIndex: index, State: state}
+	}
+	healing := drive(0, 1, 0, madmin.DriveStateOk)
+	healing.Healing = true
+	healing.HealInfo = &madmin.HealingDisk{Started: started, ObjectsTotalCount: 200, ItemsHealed: 50, Bucket: "photos", QueuedBuckets: []string{"logs", "data"}}
+	healing.Metrics = &madmin.DiskMetrics{LastMinute: map[string]madmin.TimedAction{
+		"WriteAll": {Count: 4, AccTime: uint64(8 * time.Millisecond)},
+		"ReadFile": {Count: 2, AccTime: uint64(3 * time.Millisecond)},
+	}}
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{
+			Backend: madmin.ErasureBackend{Type: madmin.ErasureType, StandardSCParity: 1, RRSCParity: 1},
+			Servers: []madmin.ServerProperties{
+				{Endpoint: "node2:9000", Disks: []madmin.Disk{
+					drive(0, 0, 1, madmin.DriveStateOk),
+					drive(0, 1, 1, madmin.DriveStateOk),
+					drive(1, 0, 1, madmin.DriveStateOffline),
+					drive(-1, -1, -1, madmin.DriveStateUnformatted),
+				}},
+				{Endpoint: "node1:9000", Disks: []madmin.Disk{
+					drive(0, 0, 0, madmin.DriveStateOk),
+					healing,
+					drive(1, 0, 0, madmin.DriveStateOffline),
+				}},
+			},
+		}, nil
+	}
+
+	topology, err := getErasureTopology(ctx, adminClientMock{})
+	if !assert.Nil(err) {
+		return
+	}
+
+	// Test-1: parity comes from the backend and unassigned drives are kept apart
+	assert.Equal(int32(1), topology.StandardParity)
+	assert.Len(topology.UnassignedDrives, 1)
+	assert.Equal(madmin.DriveStateUnformatted, topology.UnassignedDrives[0].State)
+
+	// Test-2: drives are grouped by pool and set, sorted by index
+	if !assert.Len(topology.Pools, 2) {
+		return
+	}
+	pool := topology.Pools[0]
+	assert.Equal([]string{"node1:9000", "node2:9000"}, pool.Servers)
+	if assert.Len(pool.Sets, 2) {
+		assert.Equal(models.TopologySetStatusHealthy, pool.Sets[0].Status)
+		assert.Equal(int32(0), pool.Sets[0].Drives[0].Index)
+		assert.Equal("node1:9000", pool.Sets[0].Drives[0].Server)
+		assert.Equal(models.TopologySetStatusHealing, pool.Sets[1].Status)
+		assert.Equal(int64(1), pool.Sets[1].HealingDrives)
+	}
+	assert.Equal(int64(4), pool.OnlineDrives)
+
+	// Test-3: healing progress and per operation latencies of the drive
+	drv := pool.Sets[1].Drives[0]
+	if assert.NotNil(drv.Healing) {
+		assert.Equal(25.0, drv.Healing.Progress)
+		assert.Equal("photos", drv.Healing.CurrentBucket)
+		assert.Equal(int64(2), drv.Healing.QueuedBuckets)
+		assert.Equal(started.UTC().Format(time.RFC3339), drv.Healing.Started)
+	}
+	if assert.Len(drv.Operations, 2) {
+		assert.Equal("ReadFile", drv.Operations[0].Name)
+		assert.Equal(1.5, drv.Operations[0].AvgLatency)
+		assert.Equal(2.0, drv.Operations[1].AvgLatency)
+	}
+
+	// Test-4: a set losing more drives than its parity is unavailable
+	assert.Equal(models.TopologySetStatusUnavailable, topology.Pools[1].Sets[0].Status)
+	assert.Equal(int64(1), topology.UnavailableSets)
+	assert.Equal(int64(0), topology.DegradedSets)
+
+	// Test-5: errors from the server are returned
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("error")
+	}
+	_, err = getErasureTopology(ctx, adminClientMock{})
+	assert.NotNil(err)
+}
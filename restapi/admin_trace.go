@@ -19,12 +19,15 @@ package restapi
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/minio/madmin-go"
+	"github.com/minio/pkg/wildcard"
 )
 
 // shortTraceMsg Short trace record
@@ -47,52 +50,236 @@ type callStats struct {
 	Ttfb     string `json:"timeToFirstByte"`
 }
 
-// trace filters
-func matchTrace(opts TraceRequest, traceInfo madmin.ServiceTraceInfo) bool {
-	statusCode := int(opts.statusCode)
-	method := opts.method
-	funcName := opts.funcName
-	apiPath := opts.path
+// traceFilter is a filter expression applied to every trace entry. A filter with an `op`
+// combines its nested `filters` with AND/OR semantics, any other filter is a leaf where
+// every condition set must match the trace entry.
+//
+// e.g. {"op":"or","filters":[{"status":"500-599"},{"method":"PUT","latency":"1s"}]}
+type traceFilter struct {
+	Op      string        `json:"op,omitempty"`
+	Filters []traceFilter `json:"filters,omitempty"`
 
-	if statusCode == 0 && method == "" && funcName == "" && apiPath == "" {
-		// no specific filtering found trace all the requests
-		return true
-	}
+	// Path is a glob pattern matched against the request path, case insensitive
+	Path string `json:"path,omitempty"`
+	// Method is the HTTP method of the request
+	Method string `json:"method,omitempty"`
+	// Status is either a single status code (`404`) or an inclusive range (`400-499`)
+	Status string `json:"status,omitempty"`
+	// FuncName matches any function name containing it, case insensitive
+	FuncName string `json:"funcName,omitempty"`
+	// Node is the name of the server node that produced the trace
+	Node string `json:"node,omitempty"`
+	// Latency matches calls that took at least the given duration, e.g. `250ms`
+	Latency string `json:"latency,omitempty"`
+	// Bucket is the bucket addressed by the request
+	Bucket string `json:"bucket,omitempty"`
+
+	statusFrom int
+	statusTo   int
+	latency    time.Duration
+}
 
-	// Filter request path if passed by the user
-	if apiPath != "" {
-		pathToLookup := strings.ToLower(apiPath)
-		pathFromTrace := strings.ToLower(traceInfo.Trace.Path)
+const (
+	traceFilterAnd = "and"
+	traceFilterOr  = "or"
+)
 
-		return strings.Contains(pathFromTrace, pathToLookup)
+// parseTraceFilter decodes and validates a JSON filter expression
+func parseTraceFilter(expression string) (*traceFilter, error) {
+	filter := &traceFilter{}
+	if err := json.Unmarshal([]byte(expression), filter); err != nil {
+		return nil, fmt.Errorf("invalid trace filter: %v", err)
 	}
+	if err := filter.validate(); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
 
-	// Filter response status codes if passed by the user
-	if statusCode > 0 && traceInfo.Trace.HTTP != nil {
-		statusCodeFromTrace := traceInfo.Trace.HTTP.RespInfo.StatusCode
+// validate checks the expression and pre-computes the values used while matching
+func (f *traceFilter) validate() error {
+	if f.Op != "" {
+		f.Op = strings.ToLower(f.Op)
+		if f.Op != traceFilterAnd && f.Op != traceFilterOr {
+			return fmt.Errorf("invalid trace filter operator: %s", f.Op)
+		}
+		if f.Path != "" || f.Method != "" || f.Status != "" || f.FuncName != "" || f.Node != "" || f.Latency != "" || f.Bucket != "" {
+			return fmt.Errorf("trace filter conditions cannot be combined with the %s operator, use nested filters instead", f.Op)
+		}
+		for i := range f.Filters {
+			if err := f.Filters[i].validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if len(f.Filters) > 0 {
+		return fmt.Errorf("nested trace filters require an operator")
+	}
+	if f.Status != "" {
+		from, to, found := strings.Cut(f.Status, "-")
+		if !found {
+			to = from
+		}
+		var err error
+		if f.statusFrom, err = strconv.Atoi(strings.TrimSpace(from)); err != nil {
+			return fmt.Errorf("invalid trace filter status: %s", f.Status)
+		}
+		if f.statusTo, err = strconv.Atoi(strings.TrimSpace(to)); err != nil {
+			return fmt.Errorf("invalid trace filter status: %s", f.Status)
+		}
+		if f.statusFrom > f.statusTo {
+			return fmt.Errorf("invalid trace filter status range: %s", f.Status)
+		}
+	}
+	if f.Latency != "" {
+		latency, err := time.ParseDuration(f.Latency)
+		if err != nil {
+			return fmt.Errorf("invalid trace filter latency: %s", f.Latency)
+		}
+		f.latency = latency
+	}
+	return nil
+}
 
-		return statusCodeFromTrace == statusCode
+// and returns a filter matching only when both filters match
+func (f *traceFilter) and(other *traceFilter) *traceFilter {
+	if f == nil {
+		return other
 	}
+	if other == nil {
+		return f
+	}
+	return &traceFilter{Op: traceFilterAnd, Filters: []traceFilter{*f, *other}}
+}
 
-	// Filter request method if passed by the user
-	if method != "" && traceInfo.Trace.HTTP != nil {
-		methodFromTrace := traceInfo.Trace.HTTP.ReqInfo.Method
+// match evaluates the filter expression against a trace entry, an empty filter matches everything
+func (f *traceFilter) match(trace madmin.TraceInfo) bool {
+	if f == nil {
+		return true
+	}
+	switch f.Op {
+	case traceFilterAnd:
+		for i := range f.Filters {
+			if !f.Filters[i].match(trace) {
+				return false
+			}
+		}
+		return true
+	case traceFilterOr:
+		for i := range f.Filters {
+			if f.Filters[i].match(trace) {
+				return true
+			}
+		}
+		// an empty OR has nothing to reject
+		return len(f.Filters) == 0
+	}
 
-		return methodFromTrace == method
+	if f.Path != "" && !wildcard.Match(strings.ToLower(f.Path), strings.ToLower(trace.Path)) {
+		return false
+	}
+	if f.FuncName != "" && !strings.Contains(strings.ToLower(trace.FuncName), strings.ToLower(f.FuncName)) {
+		return false
+	}
+	if f.Node != "" && trace.NodeName != f.Node {
+		return false
+	}
+	if f.Bucket != "" && traceBucket(trace) != f.Bucket {
+		return false
+	}
+	if f.Latency != "" && trace.Duration < f.latency {
+		return false
+	}
+	if f.Method != "" && (trace.HTTP == nil || !strings.EqualFold(trace.HTTP.ReqInfo.Method, f.Method)) {
+		return false
+	}
+	if f.Status != "" {
+		if trace.HTTP == nil {
+			return false
+		}
+		statusCode := trace.HTTP.RespInfo.StatusCode
+		if statusCode < f.statusFrom || statusCode > f.statusTo {
+			return false
+		}
 	}
+	return true
+}
 
-	if funcName != "" {
-		funcToLookup := strings.ToLower(funcName)
-		funcFromTrace := strings.ToLower(traceInfo.Trace.FuncName)
+// traceBucket returns the bucket addressed by a path style S3 request
+func traceBucket(trace madmin.TraceInfo) string {
+	bucket, _, _ := strings.Cut(strings.TrimPrefix(trace.Path, "/"), "/")
+	return bucket
+}
 
-		return strings.Contains(funcFromTrace, funcToLookup)
+// trace filters
+func matchTrace(opts TraceRequest, traceInfo madmin.ServiceTraceInfo) bool {
+	return opts.filter.match(traceInfo.Trace)
+}
+
+// getTraceOptionsFromReq builds the trace request from the websocket query parameters.
+// `statusCode`, `method`, `funcname` and `path` are kept for compatibility, all of them
+// are combined with the `filter` expression using AND semantics.
+func getTraceOptionsFromReq(req *http.Request) (TraceRequest, error) {
+	query := req.URL.Query()
+	calls := query.Get("calls")
+	threshold, _ := strconv.ParseInt(query.Get("threshold"), 10, 64)
+
+	traceRequestItem := TraceRequest{
+		s3:         strings.Contains(calls, "s3") || strings.Contains(calls, "all"),
+		internal:   strings.Contains(calls, "internal") || strings.Contains(calls, "all"),
+		storage:    strings.Contains(calls, "storage") || strings.Contains(calls, "all"),
+		os:         strings.Contains(calls, "os") || strings.Contains(calls, "all"),
+		onlyErrors: query.Get("onlyErrors") == "yes",
+		threshold:  threshold,
+		record:     query.Get("record") == "yes",
+		query:      req.URL.RawQuery,
 	}
 
-	return true
+	legacy := traceFilter{
+		Method:   query.Get("method"),
+		FuncName: query.Get("funcname"),
+	}
+	if path := query.Get("path"); path != "" {
+		legacy.Path = "*" + path + "*"
+	}
+	if stCode, err := strconv.ParseInt(query.Get("statusCode"), 10, 64); err == nil && stCode > 0 {
+		legacy.Status = strconv.FormatInt(stCode, 10)
+	}
+	if legacy.Path != "" || legacy.Method != "" || legacy.FuncName != "" || legacy.Status != "" {
+		if err := legacy.validate(); err != nil {
+			return traceRequestItem, err
+		}
+		traceRequestItem.filter = &legacy
+	}
+
+	if expression := query.Get("filter"); expression != "" {
+		filter, err := parseTraceFilter(expression)
+		if err != nil {
+			return traceRequestItem, err
+		}
+		traceRequestItem.filter = traceRequestItem.filter.and(filter)
+	}
+	return traceRequestItem, nil
 }
 
 // startTraceInfo starts trace of the servers
 func startTraceInfo(ctx context.Context, conn WSConn, client MinioAdmin, opts TraceRequest) error {
+	// Record the session server side if requested
+	var recorder *traceRecorder
+	if opts.record {
+		var err error
+		recorder, err = newTraceRecorder(getTraceRecordingsDir(), opts.query)
+		if err != nil {
+			LogError("error starting trace recording: %v", err)
+			return err
+		}
+		defer func() {
+			if err := recorder.close(); err != nil {
+				LogError("error closing trace recording: %v", err)
+			}
+		}()
+	}
 	// Start listening on all trace activity.
 	traceCh := client.serviceTrace(ctx, opts.threshold, opts.s3, opts.internal, opts.storage, opts.os, opts.onlyErrors)
 	for {
@@ -109,6 +296,12 @@ func startTraceInfo(ctx context.Context, conn WSConn, client MinioAdmin, opts Tr
 				return traceInfo.Err
 			}
 			if matchTrace(opts, traceInfo) {
+				if recorder != nil {
+					if err := recorder.record(traceInfo); err != nil {
+						LogError("error recording trace: %v", err)
+						return err
+					}
+				}
 				// Serialize message to be sent
 				traceInfoBytes, err := json.Marshal(shortTrace(&traceInfo))
 				if err != nil {
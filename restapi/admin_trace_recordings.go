@@ -0,0 +1,420 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	traceApi "github.com/GuinsooLab/console/restapi/operations/trace"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	traceRecordingExt     = ".ndjson.gz"
+	traceRecordingMetaExt = ".json"
+	// traceSummaryTopClients is the number of clients reported on a recording summary
+	traceSummaryTopClients = 10
+)
+
+func registerTraceRecordingsHandlers(api *operations.ConsoleAPI) {
+	// list trace recordings
+	api.TraceListTraceRecordingsHandler = traceApi.ListTraceRecordingsHandlerFunc(func(params traceApi.ListTraceRecordingsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListTraceRecordingsResponse(session, params)
+		if err != nil {
+			return traceApi.NewListTraceRecordingsDefault(int(err.Code)).WithPayload(err)
+		}
+		return traceApi.NewListTraceRecordingsOK().WithPayload(resp)
+	})
+	// download trace recording
+	api.TraceDownloadTraceRecordingHandler = traceApi.DownloadTraceRecordingHandlerFunc(func(params traceApi.DownloadTraceRecordingParams, session *models.Principal) middleware.Responder {
+		file, err := getDownloadTraceRecordingResponse(session, params)
+		if err != nil {
+			return traceApi.NewDownloadTraceRecordingDefault(int(err.Code)).WithPayload(err)
+		}
+		return middleware.ResponderFunc(processDownloadTraceRecordingResponse(params.ID, file))
+	})
+	// trace recording summary
+	api.TraceTraceRecordingSummaryHandler = traceApi.TraceRecordingSummaryHandlerFunc(func(params traceApi.TraceRecordingSummaryParams, session *models.Principal) middleware.Responder {
+		resp, err := getTraceRecordingSummaryResponse(session, params)
+		if err != nil {
+			return traceApi.NewTraceRecordingSummaryDefault(int(err.Code)).WithPayload(err)
+		}
+		return traceApi.NewTraceRecordingSummaryOK().WithPayload(resp)
+	})
+	// delete trace recording
+	api.TraceDeleteTraceRecordingHandler = traceApi.DeleteTraceRecordingHandlerFunc(func(params traceApi.DeleteTraceRecordingParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteTraceRecordingResponse(session, params); err != nil {
+			return traceApi.NewDeleteTraceRecordingDefault(int(err.Code)).WithPayload(err)
+		}
+		return traceApi.NewDeleteTraceRecordingNoContent()
+	})
+}
+
+// getTraceRecordingsDir returns the directory holding the recorded trace sessions
+func getTraceRecordingsDir() string {
+	return filepath.Join(getDataDir(), "traces")
+}
+
+// traceRecordingMeta is stored next to every recording, `EndTime` is zero while the session is being recorded
+type traceRecordingMeta struct {
+	ID        string    `json:"id"`
+	Query     string    `json:"query"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime,omitempty"`
+	Entries   int64     `json:"entries"`
+}
+
+// activeTraceRecordings keeps the ids of the sessions being recorded by this console, their gzip
+// stream isn't complete until the recorder is closed
+var activeTraceRecordings = struct {
+	sync.Mutex
+	ids map[string]bool
+}{ids: map[string]bool{}}
+
+func setTraceRecordingActive(id string, active bool) {
+	activeTraceRecordings.Lock()
+	defer activeTraceRecordings.Unlock()
+	if active {
+		activeTraceRecordings.ids[id] = true
+	} else {
+		delete(activeTraceRecordings.ids, id)
+	}
+}
+
+func isTraceRecordingActive(id string) bool {
+	activeTraceRecordings.Lock()
+	defer activeTraceRecordings.Unlock()
+	return activeTraceRecordings.ids[id]
+}
+
+// traceRecorder writes every trace entry of a session as a gzip compressed NDJSON file
+type traceRecorder struct {
+	dir  string
+	file *os.File
+	gz   *gzip.Writer
+	enc  *json.Encoder
+	meta traceRecordingMeta
+}
+
+func newTraceRecorder(dir, query string) (*traceRecorder, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	id := newDataID(now)
+	file, err := os.OpenFile(filepath.Join(dir, id+traceRecordingExt), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(file)
+	r := &traceRecorder{
+		dir:  dir,
+		file: file,
+		gz:   gz,
+		enc:  json.NewEncoder(gz),
+		meta: traceRecordingMeta{
+			ID:        id,
+			Query:     query,
+			StartTime: now,
+		},
+	}
+	if err = r.writeMeta(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	setTraceRecordingActive(id, true)
+	return r, nil
+}
+
+// record appends the full trace entry to the recording
+func (r *traceRecorder) record(info madmin.ServiceTraceInfo) error {
+	if err := r.enc.Encode(info); err != nil {
+		return err
+	}
+	r.meta.Entries++
+	return nil
+}
+
+// close flushes the recording to disk and marks the session as finished
+func (r *traceRecorder) close() error {
+	defer setTraceRecordingActive(r.meta.ID, false)
+	if err := r.gz.Close(); err != nil {
+		r.file.Close()
+		return err
+	}
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	r.meta.EndTime = time.Now().UTC()
+	return r.writeMeta()
+}
+
+func (r *traceRecorder) writeMeta() error {
+	return writeDataFile(filepath.Join(r.dir, r.meta.ID+traceRecordingMetaExt), r.meta)
+}
+
+// listTraceRecordings returns the recordings found in dir, most recent first
+func listTraceRecordings(dir string) ([]*models.TraceRecording, error) {
+	ids, err := listDataIDs(dir, traceRecordingMetaExt)
+	if err != nil {
+		return nil, err
+	}
+	recordings := []*models.TraceRecording{}
+	for _, id := range ids {
+		var meta traceRecordingMeta
+		if err = readDataFile(dir, id, traceRecordingMetaExt, &meta, ErrTraceRecordingNotFound); err != nil {
+			LogError("skipping invalid trace recording %s: %v", id, err)
+			continue
+		}
+		recording := &models.TraceRecording{
+			ID:        meta.ID,
+			Query:     meta.Query,
+			StartTime: meta.StartTime.Format(time.RFC3339),
+			Entries:   meta.Entries,
+		}
+		if !meta.EndTime.IsZero() {
+			recording.EndTime = meta.EndTime.Format(time.RFC3339)
+		}
+		if info, err := os.Stat(filepath.Join(dir, meta.ID+traceRecordingExt)); err == nil {
+			recording.Size = info.Size()
+		}
+		recordings = append(recordings, recording)
+	}
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].ID > recordings[j].ID
+	})
+	return recordings, nil
+}
+
+// openTraceRecording opens the compressed recording, sessions still being recorded can't be read
+func openTraceRecording(dir, id string) (*os.File, error) {
+	if isTraceRecordingActive(id) {
+		return nil, ErrTraceRecordingInProgress
+	}
+	return openDataFile(dir, id, traceRecordingExt, ErrTraceRecordingNotFound)
+}
+
+func deleteTraceRecording(dir, id string) error {
+	if err := removeDataFile(dir, id, traceRecordingExt, ErrTraceRecordingNotFound); err != nil {
+		return err
+	}
+	if err := removeDataFile(dir, id, traceRecordingMetaExt, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// summarizeTraceRecording computes per API latency percentiles, error rates and top clients
+// from a recording stream, a recording cut short by a console restart is summarized up to its
+// last complete entry
+func summarizeTraceRecording(id string, r io.Reader) (*models.TraceRecordingSummary, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	type apiAccumulator struct {
+		durations []time.Duration
+		errors    int64
+	}
+	apis := map[string]*apiAccumulator{}
+	clients := map[string]int64{}
+	summary := &models.TraceRecordingSummary{ID: id}
+
+	decoder := json.NewDecoder(gz)
+	for {
+		var info madmin.ServiceTraceInfo
+		if err = decoder.Decode(&info); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, err
+		}
+		trace := info.Trace
+		acc, ok := apis[trace.FuncName]
+		if !ok {
+			acc = &apiAccumulator{}
+			apis[trace.FuncName] = acc
+		}
+		acc.durations = append(acc.durations, trace.Duration)
+		summary.TotalCalls++
+		if trace.HTTP != nil {
+			if trace.HTTP.RespInfo.StatusCode >= http.StatusBadRequest {
+				acc.errors++
+				summary.Errors++
+			}
+			if client := traceClientHost(trace.HTTP.ReqInfo.Client); client != "" {
+				clients[client]++
+			}
+		}
+	}
+	if summary.TotalCalls > 0 {
+		summary.ErrorRate = float64(summary.Errors) / float64(summary.TotalCalls)
+	}
+
+	summary.Apis = []*models.TraceAPIStats{}
+	for name, acc := range apis {
+		sort.Slice(acc.durations, func(i, j int) bool {
+			return acc.durations[i] < acc.durations[j]
+		})
+		count := int64(len(acc.durations))
+		summary.Apis = append(summary.Apis, &models.TraceAPIStats{
+			API:       name,
+			Count:     count,
+			Errors:    acc.errors,
+			ErrorRate: float64(acc.errors) / float64(count),
+			P50:       durationPercentile(acc.durations, 50),
+			P90:       durationPercentile(acc.durations, 90),
+			P99:       durationPercentile(acc.durations, 99),
+		})
+	}
+	sort.Slice(summary.Apis, func(i, j int) bool {
+		if summary.Apis[i].Count != summary.Apis[j].Count {
+			return summary.Apis[i].Count > summary.Apis[j].Count
+		}
+		return summary.Apis[i].API < summary.Apis[j].API
+	})
+
+	summary.TopClients = []*models.TraceClientStats{}
+	for client, count := range clients {
+		summary.TopClients = append(summary.TopClients, &models.TraceClientStats{Client: client, Count: count})
+	}
+	sort.Slice(summary.TopClients, func(i, j int) bool {
+		if summary.TopClients[i].Count != summary.TopClients[j].Count {
+			return summary.TopClients[i].Count > summary.TopClients[j].Count
+		}
+		return summary.TopClients[i].Client < summary.TopClients[j].Client
+	})
+	if len(summary.TopClients) > traceSummaryTopClients {
+		summary.TopClients = summary.TopClients[:traceSummaryTopClients]
+	}
+	return summary, nil
+}
+
+// durationPercentile returns the nearest-rank percentile in milliseconds of sorted durations
+func durationPercentile(sorted []time.Duration, percentile int) float64 {
+	return float64(durationRank(sorted, percentile)) / float64(time.Millisecond)
+}
+
+// durationRank returns the nearest-rank percentile of sorted durations
+func durationRank(sorted []time.Duration, percentile int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (percentile*len(sorted)+99)/100 - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+// traceClientHost removes the port from the client address of a trace entry
+func traceClientHost(client string) string {
+	if host, _, err := net.SplitHostPort(client); err == nil {
+		return host
+	}
+	return client
+}
+
+func getListTraceRecordingsResponse(session *models.Principal, params traceApi.ListTraceRecordingsParams) (*models.ListTraceRecordingsResponse, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateTraceRecordingsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	recordings, err := listTraceRecordings(getTraceRecordingsDir())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return &models.ListTraceRecordingsResponse{Recordings: recordings}, nil
+}
+
+func getDownloadTraceRecordingResponse(session *models.Principal, params traceApi.DownloadTraceRecordingParams) (io.ReadCloser, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateTraceRecordingsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	file, err := openTraceRecording(getTraceRecordingsDir(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return file, nil
+}
+
+func processDownloadTraceRecordingResponse(id string, r io.ReadCloser) func(w http.ResponseWriter, _ runtime.Producer) {
+	return func(w http.ResponseWriter, _ runtime.Producer) {
+		defer r.Close()
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"trace-%s%s\"", id, traceRecordingExt))
+		if _, err := io.Copy(w, r); err != nil {
+			LogError("Unable to write all the trace recording data: %v", err)
+		}
+	}
+}
+
+func getTraceRecordingSummaryResponse(session *models.Principal, params traceApi.TraceRecordingSummaryParams) (*models.TraceRecordingSummary, *models.Error) {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateTraceRecordingsAccess(ctx, session); err != nil {
+		return nil, err
+	}
+	file, err := openTraceRecording(getTraceRecordingsDir(), params.ID)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	defer file.Close()
+	summary, err := summarizeTraceRecording(params.ID, file)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return summary, nil
+}
+
+func getDeleteTraceRecordingResponse(session *models.Principal, params traceApi.DeleteTraceRecordingParams) *models.Error {
+	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
+	defer cancel()
+	if err := validateTraceRecordingsAccess(ctx, session); err != nil {
+		return err
+	}
+	if err := deleteTraceRecording(getTraceRecordingsDir(), params.ID); err != nil {
+		return ErrorWithContext(ctx, err)
+	}
+	return nil
+}
+
+// validateTraceRecordingsAccess verifies the session is allowed to trace the cluster
+func validateTraceRecordingsAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.TraceAdminAction, "Trace recordings not available.")
+}
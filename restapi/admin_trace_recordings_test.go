@@ -0,0 +1,174 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceRecording(t *testing.T) {
+	assert := assert.New(t)
+	t.Setenv(ConsoleDataDir, t.TempDir())
+	adminClient := adminClientMock{}
+	mockWSConn := mockConn{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newTrace := func(funcName, client string, statusCode int, duration time.Duration) madmin.ServiceTraceInfo {
+		return madmin.ServiceTraceInfo{Trace: madmin.TraceInfo{
+			NodeName: "node1:9000",
+			FuncName: funcName,
+			Path:     "/bucket/object",
+			Duration: duration,
+			HTTP: &madmin.TraceHTTPStats{
+				ReqInfo:  madmin.TraceRequestInfo{Method: http.MethodGet, Client: client},
+				RespInfo: madmin.TraceResponseInfo{StatusCode: statusCode},
+			},
+		}}
+	}
+	var traces []madmin.ServiceTraceInfo
+	for i := 1; i <= 10; i++ {
+		statusCode := 200
+		if i > 8 {
+			statusCode = 404
+		}
+		traces = append(traces, newTrace("s3.GetObject", "10.0.0.1:51000", statusCode, time.Duration(i)*time.Millisecond))
+	}
+	traces = append(traces, newTrace("s3.PutObject", "10.0.0.2:51000", 500, 100*time.Millisecond))
+	traces = append(traces, newTrace("s3.PutObject", "10.0.0.2:51001", 200, 300*time.Millisecond))
+
+	minioServiceTraceMock = func(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo {
+		ch := make(chan madmin.ServiceTraceInfo)
+		go func(ch chan<- madmin.ServiceTraceInfo) {
+			defer close(ch)
+			for _, info := range traces {
+				ch <- info
+			}
+		}(ch)
+		return ch
+	}
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		return nil
+	}
+
+	// Test-1: record a trace session, only the entries matching the filters are recorded
+	req, _ := http.NewRequest(http.MethodGet, `/ws/trace?calls=s3&record=yes&filter={"funcName":"s3."}`, nil)
+	opts, err := getTraceOptionsFromReq(req)
+	assert.Nil(err)
+	assert.Nil(startTraceInfo(ctx, mockWSConn, adminClient, opts))
+
+	recordings, err := listTraceRecordings(getTraceRecordingsDir())
+	assert.Nil(err)
+	if assert.Len(recordings, 1) {
+		assert.Equal(int64(len(traces)), recordings[0].Entries)
+		assert.Equal(req.URL.RawQuery, recordings[0].Query)
+		assert.NotEmpty(recordings[0].EndTime)
+		assert.Greater(recordings[0].Size, int64(0))
+	}
+	id := recordings[0].ID
+
+	// Test-2: the recording keeps the full trace entry
+	file, err := openTraceRecording(getTraceRecordingsDir(), id)
+	assert.Nil(err)
+	gz, err := gzip.NewReader(file)
+	assert.Nil(err)
+	var first madmin.ServiceTraceInfo
+	assert.Nil(json.NewDecoder(gz).Decode(&first))
+	assert.Equal(traces[0].Trace.NodeName, first.Trace.NodeName)
+	assert.Equal(traces[0].Trace.HTTP.ReqInfo.Client, first.Trace.HTTP.ReqInfo.Client)
+	file.Close()
+
+	// Test-3: summary statistics
+	file, err = openTraceRecording(getTraceRecordingsDir(), id)
+	assert.Nil(err)
+	summary, err := summarizeTraceRecording(id, file)
+	file.Close()
+	assert.Nil(err)
+	assert.Equal(int64(12), summary.TotalCalls)
+	assert.Equal(int64(3), summary.Errors)
+	assert.Equal(0.25, summary.ErrorRate)
+	if assert.Len(summary.Apis, 2) {
+		getObject := summary.Apis[0]
+		assert.Equal("s3.GetObject", getObject.API)
+		assert.Equal(int64(10), getObject.Count)
+		assert.Equal(int64(2), getObject.Errors)
+		assert.Equal(0.2, getObject.ErrorRate)
+		assert.Equal(float64(5), getObject.P50)
+		assert.Equal(float64(9), getObject.P90)
+		assert.Equal(float64(10), getObject.P99)
+		putObject := summary.Apis[1]
+		assert.Equal("s3.PutObject", putObject.API)
+		assert.Equal(float64(100), putObject.P50)
+		assert.Equal(float64(300), putObject.P99)
+	}
+	if assert.Len(summary.TopClients, 2) {
+		assert.Equal("10.0.0.1", summary.TopClients[0].Client)
+		assert.Equal(int64(10), summary.TopClients[0].Count)
+		assert.Equal("10.0.0.2", summary.TopClients[1].Client)
+		assert.Equal(int64(2), summary.TopClients[1].Count)
+	}
+
+	// Test-4: ids can't be used to reach files outside the recordings directory
+	_, err = openTraceRecording(getTraceRecordingsDir(), "../"+id)
+	assert.True(errors.Is(err, ErrTraceRecordingNotFound))
+
+	// Test-5: delete the recording
+	assert.Nil(deleteTraceRecording(getTraceRecordingsDir(), id))
+	recordings, err = listTraceRecordings(getTraceRecordingsDir())
+	assert.Nil(err)
+	assert.Len(recordings, 0)
+	assert.True(errors.Is(deleteTraceRecording(getTraceRecordingsDir(), id), ErrTraceRecordingNotFound))
+
+	// Test-6: no recording is made unless requested
+	assert.Nil(startTraceInfo(ctx, mockWSConn, adminClient, TraceRequest{s3: true}))
+	entries, err := os.ReadDir(getTraceRecordingsDir())
+	assert.Nil(err)
+	assert.Len(entries, 0)
+
+	// Test-7: a session being recorded can't be read until it ends
+	recorder, err := newTraceRecorder(getTraceRecordingsDir(), "")
+	if !assert.Nil(err) {
+		return
+	}
+	for _, info := range traces[:3] {
+		assert.Nil(recorder.record(info))
+	}
+	_, err = openTraceRecording(getTraceRecordingsDir(), recorder.meta.ID)
+	assert.True(errors.Is(err, ErrTraceRecordingInProgress))
+
+	// Test-8: a recording cut short by a restart is summarized up to its last entry
+	assert.Nil(recorder.gz.Flush())
+	assert.Nil(recorder.file.Close())
+	setTraceRecordingActive(recorder.meta.ID, false)
+	file, err = openTraceRecording(getTraceRecordingsDir(), recorder.meta.ID)
+	if assert.Nil(err) {
+		summary, err = summarizeTraceRecording(recorder.meta.ID, file)
+		file.Close()
+		assert.Nil(err)
+		assert.Equal(int64(3), summary.TotalCalls)
+	}
+}
@@ -20,7 +20,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/minio/madmin-go"
 	"github.com/stretchr/testify/assert"
@@ -125,3 +128,136 @@ func TestAdminTrace(t *testing.T) {
 		assert.Equal("error on trace", err.Error())
 	}
 }
+
+func TestMatchTraceFilters(t *testing.T) {
+	putObject := madmin.TraceInfo{
+		NodeName: "node1:9000",
+		FuncName: "s3.PutObject",
+		Path:     "/photos/2022/beach.jpg",
+		Duration: 300 * time.Millisecond,
+		HTTP: &madmin.TraceHTTPStats{
+			ReqInfo:  madmin.TraceRequestInfo{Method: http.MethodPut},
+			RespInfo: madmin.TraceResponseInfo{StatusCode: 200},
+		},
+	}
+	getObject := madmin.TraceInfo{
+		NodeName: "node2:9000",
+		FuncName: "s3.GetObject",
+		Path:     "/docs/report.pdf",
+		Duration: 20 * time.Millisecond,
+		HTTP: &madmin.TraceHTTPStats{
+			ReqInfo:  madmin.TraceRequestInfo{Method: http.MethodGet},
+			RespInfo: madmin.TraceResponseInfo{StatusCode: 404},
+		},
+	}
+	storageCall := madmin.TraceInfo{
+		NodeName: "node1:9000",
+		FuncName: "storage.ReadAll",
+		Path:     "/photos/2022/beach.jpg",
+		Duration: 2 * time.Second,
+	}
+	traces := []madmin.TraceInfo{putObject, getObject, storageCall}
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		matches []bool
+		wantErr bool
+	}{
+		{
+			name:    "no filters trace everything",
+			query:   url.Values{},
+			matches: []bool{true, true, true},
+		},
+		{
+			name:    "legacy filters are combined",
+			query:   url.Values{"path": []string{"photos"}, "statusCode": []string{"200"}, "method": []string{"PUT"}},
+			matches: []bool{true, false, false},
+		},
+		{
+			name:    "legacy filters do not short circuit",
+			query:   url.Values{"path": []string{"PHOTOS"}, "method": []string{"GET"}},
+			matches: []bool{false, false, false},
+		},
+		{
+			name:    "path glob",
+			query:   url.Values{"filter": []string{`{"path":"/photos/*.jpg"}`}},
+			matches: []bool{true, false, true},
+		},
+		{
+			name:    "status range",
+			query:   url.Values{"filter": []string{`{"status":"400-499"}`}},
+			matches: []bool{false, true, false},
+		},
+		{
+			name:    "leaf conditions are and-ed",
+			query:   url.Values{"filter": []string{`{"bucket":"photos","funcName":"putobject","node":"node1:9000"}`}},
+			matches: []bool{true, false, false},
+		},
+		{
+			name:    "latency threshold",
+			query:   url.Values{"filter": []string{`{"latency":"250ms"}`}},
+			matches: []bool{true, false, true},
+		},
+		{
+			name:    "or of nested filters",
+			query:   url.Values{"filter": []string{`{"op":"or","filters":[{"status":"404"},{"latency":"1s"}]}`}},
+			matches: []bool{false, true, true},
+		},
+		{
+			name:    "and with nested or",
+			query:   url.Values{"filter": []string{`{"op":"and","filters":[{"node":"node1:9000"},{"op":"OR","filters":[{"method":"put"},{"funcName":"storage."}]}]}`}},
+			matches: []bool{true, false, true},
+		},
+		{
+			name:    "expression combined with legacy filters",
+			query:   url.Values{"funcname": []string{"s3."}, "filter": []string{`{"op":"or","filters":[{"bucket":"docs"},{"latency":"1s"}]}`}},
+			matches: []bool{false, true, false},
+		},
+		{
+			name:    "invalid json",
+			query:   url.Values{"filter": []string{`{"path":`}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid operator",
+			query:   url.Values{"filter": []string{`{"op":"xor","filters":[{"node":"node1"}]}`}},
+			wantErr: true,
+		},
+		{
+			name:    "operator mixed with conditions",
+			query:   url.Values{"filter": []string{`{"op":"and","node":"node1"}`}},
+			wantErr: true,
+		},
+		{
+			name:    "nested filters without operator",
+			query:   url.Values{"filter": []string{`{"filters":[{"node":"node1"}]}`}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid status range",
+			query:   url.Values{"filter": []string{`{"status":"499-400"}`}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid latency",
+			query:   url.Values{"filter": []string{`{"op":"or","filters":[{"latency":"fast"}]}`}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/ws/trace?"+tt.query.Encode(), nil)
+			opts, err := getTraceOptionsFromReq(req)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			for i, trace := range traces {
+				assert.Equal(t, tt.matches[i], matchTrace(opts, madmin.ServiceTraceInfo{Trace: trace}), "trace %d", i)
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/restapi/operations"
+	userApi "github.com/GuinsooLab/console/restapi/operations/user"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/minio/madmin-go"
+)
+
+const (
+	// usersImportSecretLength is the length of the secret keys generated for the imported users
+	usersImportSecretLength = 40
+	// usersImportSecretsTTL is how long the generated secret keys can be downloaded
+	usersImportSecretsTTL = 15 * time.Minute
+)
+
+// importedSecrets is a CSV file with the secret keys generated by an import, kept in memory only
+type importedSecrets struct {
+	data []byte
+	// owner is the access key of the session that ran the import, the only one allowed to download it
+	owner   string
+	expires time.Time
+}
+
+// importedUsersSecrets keeps the generated secret keys until they are downloaded or expire
+var importedUsersSecrets = struct {
+	sync.Mutex
+	files map[string]importedSecrets
+}{files: map[string]importedSecrets{}}
+
+// usersImportEntry is a user of the imported data, a missing secret key is generated for new users and
+// left unchanged for existing ones. Groups and Policies are nil when their column or field is missing and
+// an empty Status means it wasn't given, these are left unchanged for existing users
+type usersImportEntry struct {
+	AccessKey string   `json:"accessKey"`
+	SecretKey string   `json:"secretKey"`
+	Groups    []string `json:"groups"`
+	Policies  []string `json:"policies"`
+	Status    string   `json:"status"`
+}
+
+func registerUsersImportHandlers(api *operations.ConsoleAPI) {
+	// import users
+	api.UserImportUsersHandler = userApi.ImportUsersHandlerFunc(func(params userApi.ImportUsersParams, session *models.Principal) middleware.Responder {
+		resp, err := getImportUsersResponse(session, params)
+		if err != nil {
+			return userApi.NewImportUsersDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewImportUsersOK().WithPayload(resp)
+	})
+	// download the generated secret keys
+	api.UserDownloadImportedUsersSecretsHandler = userApi.DownloadImportedUsersSecretsHandlerFunc(func(params userApi.DownloadImportedUsersSecretsParams, session *models.Principal) middleware.Responder {
+		data, err := takeImportedUsersSecrets(params.ID, session.AccountAccessKey, time.Now())
+		if err != nil {
+			apiErr := ErrorWithContext(params.HTTPRequest.Context(), err)
+			return userApi.NewDownloadImportedUsersSecretsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"users-secrets-%s.csv\"", params.ID))
+			if _, err := w.Write(data); err != nil {
+				LogError("Unable to write the generated secret keys: %v", err)
+			}
+		})
+	})
+}
+
+// splitImportList splits the groups or policies of a CSV cell
+func splitImportList(value string) []string {
+	result := []string{}
+	for _, item := range strings.Split(value, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// parseUsersImport reads the users of a CSV with a header row or of a JSON array
+func parseUsersImport(format, data string) ([]usersImportEntry, error) {
+	var entries []usersImportEntry
+	if format == "json" {
+		if err := json.Unmarshal([]byte(data), &entries); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidUsersImport, err)
+		}
+		return entries, nil
+	}
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidUsersImport, err)
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%w: the header row is missing", ErrInvalidUsersImport)
+	}
+	columns := map[string]int{}
+	for i, name := range records[0] {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "access_key", "secret_key", "groups", "policies", "status":
+			columns[name] = i
+		default:
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidUsersImport, name)
+		}
+	}
+	if _, ok := columns["access_key"]; !ok {
+		return nil, fmt.Errorf("%w: the access_key column is required", ErrInvalidUsersImport)
+	}
+	cell := func(record []string, column string) string {
+		if i, ok := columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	list := func(record []string, column string) []string {
+		if _, ok := columns[column]; !ok {
+			return nil
+		}
+		return splitImportList(cell(record, column))
+	}
+	for _, record := range records[1:] {
+		entries = append(entries, usersImportEntry{
+			AccessKey: cell(record, "access_key"),
+			SecretKey: cell(record, "secret_key"),
+			Groups:    list(record, "groups"),
+			Policies:  list(record, "policies"),
+			Status:    strings.ToLower(cell(record, "status")),
+		})
+	}
+	return entries, nil
+}
+
+// validateUsersImportEntry returns the problems of an entry
+func validateUsersImportEntry(entry usersImportEntry, policies map[string]bool) []string {
+	var problems []string
+	if len(entry.AccessKey) < 3 || strings.ContainsAny(entry.AccessKey, " ,=") {
+		problems = append(problems, "the access key must have at least 3 characters and no spaces, commas or equal signs")
+	}
+	if entry.SecretKey != "" && (len(entry.SecretKey) < 8 || len(entry.SecretKey) > 40) {
+		problems = append(problems, "the secret key must have between 8 and 40 characters")
+	}
+	if entry.Status != "" && entry.Status != string(madmin.AccountEnabled) && entry.Status != string(madmin.AccountDisabled) {
+		problems = append(problems, fmt.Sprintf("invalid status %s", entry.Status))
+	}
+	for _, p := range entry.Policies {
+		if !policies[p] {
+			problems = append(problems, fmt.Sprintf("policy %s does not exist", p))
+		}
+	}
+	return problems
+}
+
+// importListValue returns the sorted names without duplicates to compare and report them
+func importListValue(names []string) string {
+	result := UniqueKeys(append([]string{}, names...))
+	if len(result) == 0 {
+		return "none"
+	}
+	sort.Strings(result)
+	return strings.Join(result, ",")
+}
+
+// planUsersImport validates the entries and compares them with the existing users
+func planUsersImport(entries []usersImportEntry, policies map[string]bool, users map[string]madmin.UserInfo) ([]*models.UsersImportRow, bool) {
+	valid := true
+	seen := map[string]bool{}
+	rows := make([]*models.UsersImportRow, 0, len(entries))
+	for i, entry := range entries {
+		row := &models.UsersImportRow{Row: int32(i + 1), AccessKey: entry.AccessKey, Details: []string{}}
+		rows = append(rows, row)
+		problems := validateUsersImportEntry(entry, policies)
+		if seen[entry.AccessKey] {
+			problems = append(problems, "the access key is repeated")
+		}
+		seen[entry.AccessKey] = true
+		if len(problems) > 0 {
+			row.Action = models.UsersImportRowActionInvalid
+			row.Error = strings.Join(problems, "; ")
+			valid = false
+			continue
+		}
+		current, exists := users[entry.AccessKey]
+		if !exists {
+			row.Action = models.UsersImportRowActionCreate
+			row.SecretGenerated = entry.SecretKey == ""
+			continue
+		}
+		if entry.SecretKey != "" {
+			row.Details = append(row.Details, "secret key changed")
+		}
+		if status := madmin.AccountStatus(entry.Status); entry.Status != "" && status != current.Status {
+			row.Details = append(row.Details, fmt.Sprintf("status %s -> %s", current.Status, status))
+		}
+		if from, to := importListValue(splitPolicyNames(current.PolicyName)), importListValue(entry.Policies); entry.Policies != nil && from != to {
+			row.Details = append(row.Details, fmt.Sprintf("policies %s -> %s", from, to))
+		}
+		if from, to := importListValue(current.MemberOf), importListValue(entry.Groups); entry.Groups != nil && from != to {
+			row.Details = append(row.Details, fmt.Sprintf("groups %s -> %s", from, to))
+		}
+		row.Action = models.UsersImportRowActionUpdate
+		if len(row.Details) == 0 {
+			row.Action = models.UsersImportRowActionUnchanged
+		}
+	}
+	return rows, valid
+}
+
+func entryStatus(entry usersImportEntry) madmin.AccountStatus {
+	if entry.Status == "" {
+		return madmin.AccountEnabled
+	}
+	return madmin.AccountStatus(entry.Status)
+}
+
+// applyUsersImportEntry creates or updates a user, returning the generated secret key if any
+func applyUsersImportEntry(ctx context.Context, client MinioAdmin, entry usersImportEntry, current madmin.UserInfo, create bool) (string, error) {
+	if create {
+		status := entryStatus(entry)
+		secretKey, generated := entry.SecretKey, ""
+		if secretKey == "" {
+			secretKey = RandomCharString(usersImportSecretLength)
+			generated = secretKey
+		}
+		if _, err := addUser(ctx, client, swag.String(entry.AccessKey), swag.String(secretKey), entry.Groups, entry.Policies); err != nil {
+			return "", err
+		}
+		if status != madmin.AccountEnabled {
+			if err := client.setUserStatus(ctx, entry.AccessKey, status); err != nil {
+				return generated, err
+			}
+		}
+		return generated, nil
+	}
+	status := current.Status
+	if entry.Status != "" {
+		status = madmin.AccountStatus(entry.Status)
+	}
+	if entry.SecretKey != "" {
+		// setting the secret key enables the user again, the status is set afterwards
+		if err := client.addUser(ctx, entry.AccessKey, entry.SecretKey); err != nil {
+			return "", err
+		}
+		current.Status = madmin.AccountEnabled
+	}
+	if entry.Groups != nil && importListValue(current.MemberOf) != importListValue(entry.Groups) {
+		if _, err := updateUserGroups(ctx, client, entry.AccessKey, entry.Groups); err != nil {
+			return "", err
+		}
+	}
+	if entry.Policies != nil && importListValue(splitPolicyNames(current.PolicyName)) != importListValue(entry.Policies) {
+		if err := client.setPolicy(ctx, strings.Join(entry.Policies, ","), entry.AccessKey, false); err != nil {
+			return "", err
+		}
+	}
+	if status != current.Status {
+		if err := client.setUserStatus(ctx, entry.AccessKey, status); err != nil {
+			return "", err
+		}
+	}
+	return "", nil
+}
+
+// importUsers validates every entry and, unless it is a dry run or an entry is invalid, creates or
+// updates the users, a user failing to be applied doesn't stop the others
+func importUsers(ctx context.Context, client MinioAdmin, entries []usersImportEntry, dryRun bool, owner string, now time.Time) (*models.UsersImportResult, error) {
+	allPolicies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policies := map[string]bool{}
+	for name := range allPolicies {
+		policies[name] = true
+	}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, valid := planUsersImport(entries, policies, users)
+	result := &models.UsersImportResult{DryRun: dryRun, Applied: valid && !dryRun, Rows: rows}
+
+	var secrets bytes.Buffer
+	secretsWriter := csv.NewWriter(&secrets)
+	generated := 0
+	if err = secretsWriter.Write([]string{"access_key", "secret_key"}); err != nil {
+		return nil, err
+	}
+	for i, row := range rows {
+		if result.Applied && (row.Action == models.UsersImportRowActionCreate || row.Action == models.UsersImportRowActionUpdate) {
+			secretKey, err := applyUsersImportEntry(ctx, client, entries[i], users[row.AccessKey], row.Action == models.UsersImportRowActionCreate)
+			if secretKey != "" {
+				if err := secretsWriter.Write([]string{row.AccessKey, secretKey}); err != nil {
+					return nil, err
+				}
+				generated++
+			}
+			if err != nil {
+				row.Action = models.UsersImportRowActionFailed
+				row.Error = err.Error()
+			}
+		}
+		switch row.Action {
+		case models.UsersImportRowActionCreate:
+			result.Created++
+		case models.UsersImportRowActionUpdate:
+			result.Updated++
+		case models.UsersImportRowActionUnchanged:
+			result.Unchanged++
+		default:
+			result.Failed++
+		}
+	}
+	if generated > 0 {
+		secretsWriter.Flush()
+		if err = secretsWriter.Error(); err != nil {
+			return nil, err
+		}
+		result.SecretsID = storeImportedUsersSecrets(secrets.Bytes(), owner, now)
+	}
+	return result, nil
+}
+
+// storeImportedUsersSecrets keeps the generated secret keys until downloaded by owner, dropping the expired ones
+func storeImportedUsersSecrets(data []byte, owner string, now time.Time) string {
+	importedUsersSecrets.Lock()
+	defer importedUsersSecrets.Unlock()
+	for id, secrets := range importedUsersSecrets.files {
+		if now.After(secrets.expires) {
+			delete(importedUsersSecrets.files, id)
+		}
+	}
+	id := uuid.NewString()
+	importedUsersSecrets.files[id] = importedSecrets{data: data, owner: owner, expires: now.Add(usersImportSecretsTTL)}
+	return id
+}
+
+// takeImportedUsersSecrets returns the generated secret keys to their owner and forgets them, other
+// sessions get a not found error and can't consume the download
+func takeImportedUsersSecrets(id, owner string, now time.Time) ([]byte, error) {
+	importedUsersSecrets.Lock()
+	defer importedUsersSecrets.Unlock()
+	secrets, ok := importedUsersSecrets.files[id]
+	if !ok || secrets.owner != owner {
+		return nil, ErrImportedSecretsNotFound
+	}
+	delete(importedUsersSecrets.files, id)
+	if now.After(secrets.expires) {
+		return nil, ErrImportedSecretsNotFound
+	}
+	return secrets.data, nil
+}
+
+func getImportUsersResponse(session *models.Principal, params userApi.ImportUsersParams) (*models.UsersImportResult, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	entries, err := parseUsersImport(params.Body.Format, swag.StringValue(params.Body.Data))
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	result, err := importUsers(ctx, adminClient, entries, params.Body.DryRun, session.AccountAccessKey, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return result, nil
+}
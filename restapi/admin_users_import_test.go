@@ -0,0 +1,237 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUsersImport(t *testing.T) {
+	assert := assert.New(t)
+
+	// Test-1: csv columns are matched by name and lists split by semicolons
+	entries, err := parseUsersImport("csv", "access_key,groups,policies,status\nalice, editors;ops ,readwrite,Disabled\nbob,,,\n")
+	if assert.Nil(err) && assert.Len(entries, 2) {
+		assert.Equal(usersImportEntry{AccessKey: "alice", Groups: []string{"editors", "ops"}, Policies: []string{"readwrite"}, Status: "disabled"}, entries[0])
+		assert.Equal("bob", entries[1].AccessKey)
+		assert.Equal([]string{}, entries[1].Groups)
+	}
+	entries, err = parseUsersImport("csv", "access_key\nalice\n")
+	if assert.Nil(err) && assert.Len(entries, 1) {
+		assert.Nil(entries[0].Groups)
+		assert.Nil(entries[0].Policies)
+	}
+
+	// Test-2: json arrays
+	entries, err = parseUsersImport("json", `[{"accessKey":"carol","secretKey":"carolsecret","policies":["readonly"]}]`)
+	if assert.Nil(err) && assert.Len(entries, 1) {
+		assert.Equal("carolsecret", entries[0].SecretKey)
+	}
+
+	// Test-3: invalid data
+	_, err = parseUsersImport("csv", "user,password\nalice,secret\n")
+	assert.Equal(`invalid users import data: unknown column "user"`, err.Error())
+	_, err = parseUsersImport("csv", "groups\neditors\n")
+	assert.True(errors.Is(err, ErrInvalidUsersImport))
+	_, err = parseUsersImport("json", "{")
+	assert.True(errors.Is(err, ErrInvalidUsersImport))
+}
+
+func TestImportUsers(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	users := map[string]madmin.UserInfo{
+		"alice": {PolicyName: "readonly", Status: madmin.AccountEnabled, MemberOf: []string{"editors"}},
+	}
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{"readonly": {}, "readwrite": {}}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		result := map[string]madmin.UserInfo{}
+		for k, v := range users {
+			result[k] = v
+		}
+		return result, nil
+	}
+	minioGetUserInfoMock = func(accessKey string) (madmin.UserInfo, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return users[accessKey], nil
+	}
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if accessKey == "broken" {
+			return errors.New("unable to add the user")
+		}
+		u := users[accessKey]
+		u.Status = madmin.AccountEnabled
+		users[accessKey] = u
+		return nil
+	}
+	minioSetPolicyMock = func(policyName, entityName string, isGroup bool) error {
+		mu.Lock()
+		defer mu.Unlock()
+		u := users[entityName]
+		u.PolicyName = policyName
+		users[entityName] = u
+		return nil
+	}
+	minioSetUserStatusMock = func(accessKey string, status madmin.AccountStatus) error {
+		mu.Lock()
+		defer mu.Unlock()
+		u := users[accessKey]
+		u.Status = status
+		users[accessKey] = u
+		return nil
+	}
+	minioUpdateGroupMembersMock = func(req madmin.GroupAddRemove) error {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, member := range req.Members {
+			u := users[member]
+			if req.IsRemove {
+				u.MemberOf = DifferenceArrays(u.MemberOf, []string{req.Group})
+			} else {
+				u.MemberOf = append(u.MemberOf, req.Group)
+			}
+			users[member] = u
+		}
+		return nil
+	}
+	entries := []usersImportEntry{
+		{AccessKey: "alice", Groups: []string{}, Policies: []string{"readwrite"}, Status: "disabled"},
+		{AccessKey: "bob", Groups: []string{"editors"}, Policies: []string{"readonly"}},
+		{AccessKey: "carol", SecretKey: "carolsecret"},
+	}
+
+	// Test-1: a dry run reports the changes without applying them
+	result, err := importUsers(ctx, adminClientMock{}, entries, true, "admin", now)
+	if !assert.Nil(err) {
+		return
+	}
+	assert.False(result.Applied)
+	assert.Equal(models.UsersImportRowActionUpdate, result.Rows[0].Action)
+	assert.Equal([]string{"status enabled -> disabled", "policies readonly -> readwrite", "groups editors -> none"}, result.Rows[0].Details)
+	assert.Equal(models.UsersImportRowActionCreate, result.Rows[1].Action)
+	assert.True(result.Rows[1].SecretGenerated)
+	assert.False(result.Rows[2].SecretGenerated)
+	assert.Equal(int64(2), result.Created)
+	assert.Empty(result.SecretsID)
+	assert.Len(users, 1)
+
+	// Test-2: an invalid row stops every change
+	invalid := append([]usersImportEntry{}, entries...)
+	invalid = append(invalid, usersImportEntry{AccessKey: "bob", SecretKey: "short", Policies: []string{"missing"}, Status: "locked"})
+	result, err = importUsers(ctx, adminClientMock{}, invalid, false, "admin", now)
+	assert.Nil(err)
+	assert.False(result.Applied)
+	assert.Equal(int64(1), result.Failed)
+	assert.Equal(models.UsersImportRowActionInvalid, result.Rows[3].Action)
+	assert.Equal("the secret key must have between 8 and 40 characters; invalid status locked; policy missing does not exist; the access key is repeated", result.Rows[3].Error)
+	assert.Len(users, 1)
+
+	// Test-3: the users are applied, a failing row doesn't stop the others
+	applied := append([]usersImportEntry{}, entries...)
+	applied = append(applied, usersImportEntry{AccessKey: "broken"})
+	result, err = importUsers(ctx, adminClientMock{}, applied, false, "admin", now)
+	if !assert.Nil(err) {
+		return
+	}
+	assert.True(result.Applied)
+	assert.Equal(int64(1), result.Updated)
+	assert.Equal(int64(2), result.Created)
+	assert.Equal(int64(1), result.Failed)
+	assert.Equal(models.UsersImportRowActionFailed, result.Rows[3].Action)
+	assert.Equal("readwrite", users["alice"].PolicyName)
+	assert.Equal(madmin.AccountDisabled, users["alice"].Status)
+	assert.Empty(users["alice"].MemberOf)
+	assert.Equal([]string{"editors"}, users["bob"].MemberOf)
+	assert.Equal("readonly", users["bob"].PolicyName)
+
+	// Test-4: importing the same users again changes nothing
+	result, err = importUsers(ctx, adminClientMock{}, entries[:2], false, "admin", now)
+	assert.Nil(err)
+	assert.Equal(int64(2), result.Unchanged)
+
+	// Test-5: a list of access keys only leaves the groups, policies and status of existing users unchanged
+	keysOnly, err := parseUsersImport("csv", "access_key\nalice\nbob\n")
+	assert.Nil(err)
+	result, err = importUsers(ctx, adminClientMock{}, keysOnly, false, "admin", now)
+	if assert.Nil(err) {
+		assert.Equal(int64(2), result.Unchanged)
+		assert.Empty(result.Rows[0].Details)
+	}
+	assert.Equal(madmin.AccountDisabled, users["alice"].Status)
+	assert.Equal("readwrite", users["alice"].PolicyName)
+	assert.Equal([]string{"editors"}, users["bob"].MemberOf)
+	assert.Equal("readonly", users["bob"].PolicyName)
+
+	// Test-6: unknown and expired secret keys can't be downloaded
+	_, err = takeImportedUsersSecrets("unknown", "admin", now)
+	assert.Equal(ErrImportedSecretsNotFound, err)
+	id := storeImportedUsersSecrets([]byte("access_key,secret_key\nbob,secret\n"), "admin", now)
+	_, err = takeImportedUsersSecrets(id, "admin", now.Add(usersImportSecretsTTL+time.Second))
+	assert.Equal(ErrImportedSecretsNotFound, err)
+}
+
+func TestImportUsersSecrets(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	minioListPoliciesMock = func() (map[string]*iampolicy.Policy, error) {
+		return map[string]*iampolicy.Policy{}, nil
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{}, nil
+	}
+	minioAddUserMock = func(accessKey, secretKey string) error {
+		return nil
+	}
+
+	result, err := importUsers(context.Background(), adminClientMock{}, []usersImportEntry{{AccessKey: "dave"}, {AccessKey: "erin", SecretKey: "erinsecret"}}, false, "admin", now)
+	if !assert.Nil(err) || !assert.NotEmpty(result.SecretsID) {
+		return
+	}
+	// only the session that ran the import can download them
+	_, err = takeImportedUsersSecrets(result.SecretsID, "other", now.Add(time.Minute))
+	assert.Equal(ErrImportedSecretsNotFound, err)
+	secrets, err := takeImportedUsersSecrets(result.SecretsID, "admin", now.Add(time.Minute))
+	if assert.Nil(err) {
+		lines := strings.Split(strings.TrimSpace(string(secrets)), "\n")
+		if assert.Len(lines, 2) {
+			assert.Equal("access_key,secret_key", lines[0])
+			assert.True(strings.HasPrefix(lines[1], "dave,"))
+			assert.Len(strings.TrimPrefix(lines[1], "dave,"), usersImportSecretLength)
+		}
+	}
+	_, err = takeImportedUsersSecrets(result.SecretsID, "admin", now.Add(time.Minute))
+	assert.Equal(ErrImportedSecretsNotFound, err)
+}
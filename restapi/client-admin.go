@@ -113,6 +113,8 @@ type MinioAdmin interface {
 	editTierCreds(ctx context.Context, tierName string, creds madmin.TierCreds) error
 	// Speedtest
 	speedtest(ctx context.Context, opts madmin.SpeedtestOpts) (chan madmin.SpeedTestResult, error)
+	driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error)
+	netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error)
 	// Site Relication
 	getSiteReplicationInfo(ctx context.Context) (*madmin.SiteReplicationInfo, error)
 	addSiteReplicationInfo(ctx context.Context, sites []madmin.PeerSite) (*madmin.ReplicateAddStatus, error)
@@ -492,6 +494,14 @@ func (ac AdminClient) speedtest(ctx context.Context, opts madmin.SpeedtestOpts)
 	return ac.Client.Speedtest(ctx, opts)
 }
 
+func (ac AdminClient) driveSpeedtest(ctx context.Context, opts madmin.DriveSpeedTestOpts) (chan madmin.DriveSpeedTestResult, error) {
+	return ac.Client.DriveSpeedtest(ctx, opts)
+}
+
+func (ac AdminClient) netperf(ctx context.Context, duration time.Duration) (madmin.NetperfResult, error) {
+	return ac.Client.Netperf(ctx, duration)
+}
+
 // Site Replication
 func (ac AdminClient) getSiteReplicationInfo(ctx context.Context) (*madmin.SiteReplicationInfo, error) {
 	res, err := ac.Client.SiteReplicationInfo(ctx)
@@ -18,13 +18,23 @@ package restapi
 
 import (
 	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/GuinsooLab/console/pkg/certs"
+	"github.com/google/uuid"
 	xcerts "github.com/minio/pkg/certs"
 	"github.com/minio/pkg/env"
 	xnet "github.com/minio/pkg/net"
+	"github.com/mitchellh/go-homedir"
 )
 
 var (
@@ -235,6 +245,351 @@ func getPrometheusExtraLabels() string {
 	return env.Get(PrometheusExtraLabels, "")
 }
 
+// getPrometheusBasicAuth returns the basic auth credentials used on the Prometheus queries
+func getPrometheusBasicAuth() (username, password string) {
+	return env.Get(PrometheusUsername, ""), env.Get(PrometheusPassword, "")
+}
+
+// getPrometheusBearerToken returns the bearer token used on the Prometheus queries
+func getPrometheusBearerToken() string {
+	return env.Get(PrometheusBearerToken, "")
+}
+
+// getPrometheusBearerTokenFile returns the file holding the bearer token used on the Prometheus queries,
+// it is read on every query so the token can be rotated
+func getPrometheusBearerTokenFile() string {
+	return env.Get(PrometheusBearerTokenFile, "")
+}
+
+// getPrometheusCAFile returns the PEM file with the CAs trusted for the Prometheus server
+func getPrometheusCAFile() string {
+	return env.Get(PrometheusCAFile, "")
+}
+
+// getPrometheusClientCert returns the client certificate and key presented to the Prometheus server
+func getPrometheusClientCert() (certFile, keyFile string) {
+	return env.Get(PrometheusClientCert, ""), env.Get(PrometheusClientKey, "")
+}
+
+// getPrometheusInsecure returns whether the certificate of the Prometheus server is verified, defaults to off
+func getPrometheusInsecure() bool {
+	return strings.ToLower(env.Get(PrometheusInsecure, "off")) == "on"
+}
+
+// getDataDir returns the directory where console keeps its local state,
+// defaults to ${HOME}/.console/data
+func getDataDir() string {
+	if dir := env.Get(ConsoleDataDir, ""); dir != "" {
+		return dir
+	}
+	homeDir, err := homedir.Dir()
+	if err != nil {
+		return filepath.Join(certs.DefaultConsoleConfigDir, "data")
+	}
+	return filepath.Join(homeDir, certs.DefaultConsoleConfigDir, "data")
+}
+
+// dataIDTimeFormat is the time format the ids of the records kept in the data directory start with
+const dataIDTimeFormat = "20060102T150405Z"
+
+// dataIDRegexp matches the ids of the records kept in the data directory, ids are validated
+// before being joined to a path so they can't escape their directory
+var dataIDRegexp = regexp.MustCompile(`^[0-9A-Za-z-]+$`)
+
+// newDataID returns a new record id, ids sort by creation time
+func newDataID(now time.Time) string {
+	return fmt.Sprintf("%s-%s", now.UTC().Format(dataIDTimeFormat), strings.Split(uuid.NewString(), "-")[0])
+}
+
+// isValidDataID reports whether id can be used as a record file name
+func isValidDataID(id string) bool {
+	return dataIDRegexp.MatchString(id)
+}
+
+// listDataIDs returns the ids of the records of dir stored with the `ext` extension, a missing
+// directory has no records
+func listDataIDs(dir, ext string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	ids := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ext) {
+			continue
+		}
+		ids = append(ids, strings.TrimSuffix(entry.Name(), ext))
+	}
+	return ids, nil
+}
+
+// openDataFile opens the record `id` of dir, notFound is returned for invalid or missing ids
+func openDataFile(dir, id, ext string, notFound error) (*os.File, error) {
+	if !isValidDataID(id) {
+		return nil, notFound
+	}
+	file, err := os.Open(filepath.Join(dir, id+ext))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, notFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+// readDataFile decodes the JSON record `id` of dir into v
+func readDataFile(dir, id, ext string, v interface{}, notFound error) error {
+	file, err := openDataFile(dir, id, ext, notFound)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return json.NewDecoder(file).Decode(v)
+}
+
+// removeDataFile removes the record `id` of dir, notFound is returned for invalid or missing ids
+func removeDataFile(dir, id, ext string, notFound error) error {
+	if !isValidDataID(id) {
+		return notFound
+	}
+	err := os.Remove(filepath.Join(dir, id+ext))
+	if errors.Is(err, os.ErrNotExist) {
+		return notFound
+	}
+	return err
+}
+
+// writeDataFile replaces file with the JSON encoding of v, the file is written next to its final
+// location and renamed so readers never see a partial write
+func writeDataFile(file string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeDataFileBytes(file, b)
+}
+
+// writeDataFileBytes atomically replaces file with b, creating its directory if needed
+func writeDataFileBytes(file string, b []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+		return err
+	}
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+// getHealthInfoSchedule returns the interval of the scheduled health info collections,
+// 0 disables them
+func getHealthInfoSchedule() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleHealthInfoSchedule, "0"))
+	if err != nil || interval < 0 {
+		return 0
+	}
+	return interval
+}
+
+// getHealthInfoDeadline returns the deadline of the scheduled health info collections, defaults to 1h
+func getHealthInfoDeadline() time.Duration {
+	deadline, err := time.ParseDuration(env.Get(ConsoleHealthInfoDeadline, "1h"))
+	if err != nil || deadline <= 0 {
+		return time.Hour
+	}
+	return deadline
+}
+
+// getHealthReportsDir returns the directory archiving the health reports,
+// defaults to ${CONSOLE_DATA_DIR}/health
+func getHealthReportsDir() string {
+	if dir := env.Get(ConsoleHealthInfoDir, ""); dir != "" {
+		return dir
+	}
+	return filepath.Join(getDataDir(), "health")
+}
+
+// getHealthInfoBucket returns the bucket the scheduled health reports are also uploaded to
+func getHealthInfoBucket() string {
+	return env.Get(ConsoleHealthInfoBucket, "")
+}
+
+// getHealthInfoRetention returns how long archived health reports are kept, 0 keeps them forever
+func getHealthInfoRetention() time.Duration {
+	retention, err := time.ParseDuration(env.Get(ConsoleHealthInfoRetention, "0"))
+	if err != nil || retention < 0 {
+		return 0
+	}
+	return retention
+}
+
+// getHealthInfoMaxReports returns the number of archived health reports kept, 0 means no limit
+func getHealthInfoMaxReports() int {
+	maxReports, err := strconv.Atoi(env.Get(ConsoleHealthInfoMaxReports, "0"))
+	if err != nil || maxReports < 0 {
+		return 0
+	}
+	return maxReports
+}
+
+// getHealthInfoAnonymize returns whether the health reports uploaded to the bucket are anonymized
+func getHealthInfoAnonymize() bool {
+	return strings.ToLower(env.Get(ConsoleHealthInfoAnonymize, "off")) == "on"
+}
+
+// getHealthInfoCredentials returns the credentials used by the scheduled health info collections
+func getHealthInfoCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleHealthInfoAccessKey, ""), env.Get(ConsoleHealthInfoSecretKey, "")
+}
+
+// getMetricsCollectorEnabled returns whether the built-in metrics collector serves the dashboard
+// when no Prometheus is configured
+func getMetricsCollectorEnabled() bool {
+	return strings.ToLower(env.Get(ConsoleMetricsCollector, "off")) == "on"
+}
+
+// getMetricsCollectorInterval returns the scrape interval of the built-in metrics collector, defaults to 30s
+func getMetricsCollectorInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleMetricsInterval, "30s"))
+	if err != nil || interval <= 0 {
+		return 30 * time.Second
+	}
+	return interval
+}
+
+// getMetricsCollectorRetention returns how long the built-in metrics collector keeps the samples, defaults to 1h
+func getMetricsCollectorRetention() time.Duration {
+	retention, err := time.ParseDuration(env.Get(ConsoleMetricsRetention, "1h"))
+	if err != nil || retention <= 0 {
+		return time.Hour
+	}
+	return retention
+}
+
+// getMetricsCollectorPersist returns whether the collected metrics are persisted to ${CONSOLE_DATA_DIR}/metrics
+func getMetricsCollectorPersist() bool {
+	return strings.ToLower(env.Get(ConsoleMetricsPersist, "off")) == "on"
+}
+
+// getMetricsCollectorCredentials returns the credentials used to sign the metrics bearer token,
+// the endpoint is scraped without token when empty
+func getMetricsCollectorCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleMetricsAccessKey, ""), env.Get(ConsoleMetricsSecretKey, "")
+}
+
+// getAlertsInterval returns the evaluation interval of the alert rules, 0 disables the alert engine
+func getAlertsInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleAlertsInterval, "0"))
+	if err != nil || interval < 0 {
+		return 0
+	}
+	return interval
+}
+
+// getAlertsRepeatInterval returns how often the notifications of an unacknowledged alert are repeated, defaults to 4h
+func getAlertsRepeatInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleAlertsRepeatInterval, "4h"))
+	if err != nil || interval <= 0 {
+		return 4 * time.Hour
+	}
+	return interval
+}
+
+// getAlertsMaxHistory returns the number of alerts kept in the history, defaults to 1000
+func getAlertsMaxHistory() int {
+	maxHistory, err := strconv.Atoi(env.Get(ConsoleAlertsMaxHistory, "1000"))
+	if err != nil || maxHistory <= 0 {
+		return 1000
+	}
+	return maxHistory
+}
+
+// getAlertsCredentials returns the credentials used by the alert engine to fetch the cluster info
+func getAlertsCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleAlertsAccessKey, ""), env.Get(ConsoleAlertsSecretKey, "")
+}
+
+// getAlertsSMTPConfig returns the SMTP server used by the email alert channels, the server is set as host:port
+func getAlertsSMTPConfig() alertSMTPConfig {
+	return alertSMTPConfig{
+		server:   env.Get(ConsoleAlertsSMTPServer, ""),
+		username: env.Get(ConsoleAlertsSMTPUsername, ""),
+		password: env.Get(ConsoleAlertsSMTPPassword, ""),
+		from:     env.Get(ConsoleAlertsSMTPFrom, ""),
+	}
+}
+
+// getCapacitySampleInterval returns the interval of the usage samples used by the capacity forecast, 0 disables the sampling
+func getCapacitySampleInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleCapacitySampleInterval, "0"))
+	if err != nil || interval < 0 {
+		return 0
+	}
+	return interval
+}
+
+// getCapacityRetention returns how long the usage samples are kept, defaults to 90 days
+func getCapacityRetention() time.Duration {
+	retention, err := time.ParseDuration(env.Get(ConsoleCapacityRetention, "2160h"))
+	if err != nil || retention <= 0 {
+		return 90 * 24 * time.Hour
+	}
+	return retention
+}
+
+// getCapacityCredentials returns the credentials used to collect the usage samples
+func getCapacityCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleCapacityAccessKey, ""), env.Get(ConsoleCapacitySecretKey, "")
+}
+
+// getGrantsInterval returns how often the expired access grants are detached, defaults to 1m
+func getGrantsInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleGrantsInterval, "1m"))
+	if err != nil || interval <= 0 {
+		return time.Minute
+	}
+	return interval
+}
+
+// getGrantsMaxDuration returns the longest duration of an access grant, defaults to 7 days
+func getGrantsMaxDuration() time.Duration {
+	maxDuration, err := time.ParseDuration(env.Get(ConsoleGrantsMaxDuration, "168h"))
+	if err != nil || maxDuration <= 0 {
+		return 7 * 24 * time.Hour
+	}
+	return maxDuration
+}
+
+// getGrantsCredentials returns the credentials used to detach the policies of the expired access grants
+func getGrantsCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleGrantsAccessKey, ""), env.Get(ConsoleGrantsSecretKey, "")
+}
+
+// getServiceAccountsInterval returns how often the expired service accounts are disabled and deleted, defaults to 1m
+func getServiceAccountsInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleServiceAccountsInterval, "1m"))
+	if err != nil || interval <= 0 {
+		return time.Minute
+	}
+	return interval
+}
+
+// getServiceAccountsDeleteAfter returns how long an expired service account stays disabled before
+// being deleted, defaults to 7 days
+func getServiceAccountsDeleteAfter() time.Duration {
+	deleteAfter, err := time.ParseDuration(env.Get(ConsoleServiceAccountsDeleteAfter, "168h"))
+	if err != nil || deleteAfter < 0 {
+		return 7 * 24 * time.Hour
+	}
+	return deleteAfter
+}
+
+// getServiceAccountsCredentials returns the credentials used to disable and delete the expired service accounts
+func getServiceAccountsCredentials() (accessKey, secretKey string) {
+	return env.Get(ConsoleServiceAccountsAccessKey, ""), env.Get(ConsoleServiceAccountsSecretKey, "")
+}
+
 var (
 	// GlobalRootCAs is CA root certificates, a nil value means system certs pool will be used
 	GlobalRootCAs *x509.CertPool
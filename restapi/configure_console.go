@@ -106,10 +106,26 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerBucketsHandlers(api)
 	// Register all users handlers
 	registerUsersHandlers(api)
+	// Register bulk users import handlers
+	registerUsersImportHandlers(api)
 	// Register groups handlers
 	registerGroupsHandlers(api)
 	// Register policies handlers
 	registersPoliciesHandler(api)
+	// Register policy simulator handler
+	registerPolicySimulatorHandler(api)
+	// Register effective permissions handlers
+	registerEffectivePermissionsHandlers(api)
+	// Register policy lint handler
+	registerPolicyLintHandler(api)
+	// Register IAM export and import handlers
+	registerIAMBundleHandlers(api)
+	// Register policy history handlers
+	registerPolicyHistoryHandlers(api)
+	// Register policy templates handlers
+	registerPolicyTemplatesHandlers(api)
+	// Register temporary access grants handlers
+	registerAccessGrantsHandlers(api)
 	// Register configurations handlers
 	registerConfigHandlers(api)
 	// Register bucket events handlers
@@ -126,16 +142,40 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerVersionHandlers(api)
 	// Register admin info handlers
 	registerAdminInfoHandlers(api)
+	// Register custom dashboard widgets handlers
+	registerDashboardWidgetsHandlers(api)
+	// Register prometheus connectivity check handler
+	registerPrometheusCheckHandler(api)
+	// Register alerts handlers
+	registerAlertsHandlers(api)
+	// Register capacity forecast handler
+	registerCapacityForecastHandler(api)
+	// Register erasure topology handler
+	registerErasureTopologyHandler(api)
 	// Register admin arns handlers
 	registerAdminArnsHandlers(api)
 	// Register admin notification endpoints handlers
 	registerAdminNotificationEndpointsHandlers(api)
 	// Register admin Service Account Handlers
 	registerServiceAccountsHandlers(api)
+	// Register Service Account expiration and rotation handlers
+	registerServiceAccountExpiryHandlers(api)
+	// Register Service Account usage handlers
+	registerServiceAccountUsageHandlers(api)
 	// Register admin remote buckets
 	registerAdminBucketRemoteHandlers(api)
 	// Register admin log search
 	registerLogSearchHandlers(api)
+	// Register admin console logs
+	registerConsoleLogHandlers(api)
+	// Register admin trace recordings
+	registerTraceRecordingsHandlers(api)
+	// Register admin heal jobs
+	registerHealJobsHandlers(api)
+	// Register admin speedtest results
+	registerSpeedtestResultsHandlers(api)
+	// Register admin health reports
+	registerHealthReportsHandlers(api)
 	// Register admin subnet handlers
 	registerSubnetHandlers(api)
 	// Register Account handlers
@@ -157,9 +197,21 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	// Register Account handlers
 	registerAccountHandlers(api)
 
+	// Background tasks run until the server shuts down
+	bgCtx, bgCancel := context.WithCancel(context.Background())
+	startHealthInfoScheduler(bgCtx)
+	startMetricsCollector(bgCtx)
+	startAlertEngine(bgCtx)
+	startCapacitySampler(bgCtx)
+	startAccessGrantScheduler(bgCtx)
+	startServiceAccountExpiryScheduler(bgCtx)
+	startServiceAccountUsageCollector(bgCtx)
+
 	api.PreServerShutdown = func() {}
 
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = func() {
+		bgCancel()
+	}
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
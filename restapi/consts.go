@@ -49,9 +49,54 @@ const (
 	PrometheusURL                                = "CONSOLE_PROMETHEUS_URL"
 	PrometheusJobID                              = "CONSOLE_PROMETHEUS_JOB_ID"
 	PrometheusExtraLabels                        = "CONSOLE_PROMETHEUS_EXTRA_LABELS"
+	PrometheusUsername                           = "CONSOLE_PROMETHEUS_USERNAME"
+	PrometheusPassword                           = "CONSOLE_PROMETHEUS_PASSWORD"
+	PrometheusBearerToken                        = "CONSOLE_PROMETHEUS_BEARER_TOKEN"
+	PrometheusBearerTokenFile                    = "CONSOLE_PROMETHEUS_BEARER_TOKEN_FILE"
+	PrometheusCAFile                             = "CONSOLE_PROMETHEUS_CA_FILE"
+	PrometheusClientCert                         = "CONSOLE_PROMETHEUS_CLIENT_CERT"
+	PrometheusClientKey                          = "CONSOLE_PROMETHEUS_CLIENT_KEY"
+	PrometheusInsecure                           = "CONSOLE_PROMETHEUS_INSECURE"
 	ConsoleLogQueryURL                           = "CONSOLE_LOG_QUERY_URL"
 	ConsoleLogQueryAuthToken                     = "CONSOLE_LOG_QUERY_AUTH_TOKEN"
 	ConsoleObjectBrowserOnly                     = "CONSOLE_OBJECT_BROWSER_ONLY"
 	LogSearchQueryAuthToken                      = "LOGSEARCH_QUERY_AUTH_TOKEN"
+	ConsoleDataDir                               = "CONSOLE_DATA_DIR"
+	ConsoleHealthInfoSchedule                    = "CONSOLE_HEALTH_INFO_SCHEDULE"
+	ConsoleHealthInfoDeadline                    = "CONSOLE_HEALTH_INFO_DEADLINE"
+	ConsoleHealthInfoDir                         = "CONSOLE_HEALTH_INFO_DIR"
+	ConsoleHealthInfoBucket                      = "CONSOLE_HEALTH_INFO_BUCKET"
+	ConsoleHealthInfoRetention                   = "CONSOLE_HEALTH_INFO_RETENTION"
+	ConsoleHealthInfoMaxReports                  = "CONSOLE_HEALTH_INFO_MAX_REPORTS"
+	ConsoleHealthInfoAnonymize                   = "CONSOLE_HEALTH_INFO_ANONYMIZE"
+	ConsoleHealthInfoAccessKey                   = "CONSOLE_HEALTH_INFO_ACCESS_KEY"
+	ConsoleHealthInfoSecretKey                   = "CONSOLE_HEALTH_INFO_SECRET_KEY"
+	ConsoleMetricsCollector                      = "CONSOLE_METRICS_COLLECTOR"
+	ConsoleMetricsInterval                       = "CONSOLE_METRICS_INTERVAL"
+	ConsoleMetricsRetention                      = "CONSOLE_METRICS_RETENTION"
+	ConsoleMetricsPersist                        = "CONSOLE_METRICS_PERSIST"
+	ConsoleMetricsAccessKey                      = "CONSOLE_METRICS_ACCESS_KEY"
+	ConsoleMetricsSecretKey                      = "CONSOLE_METRICS_SECRET_KEY"
+	ConsoleAlertsInterval                        = "CONSOLE_ALERTS_INTERVAL"
+	ConsoleAlertsRepeatInterval                  = "CONSOLE_ALERTS_REPEAT_INTERVAL"
+	ConsoleAlertsMaxHistory                      = "CONSOLE_ALERTS_MAX_HISTORY"
+	ConsoleAlertsAccessKey                       = "CONSOLE_ALERTS_ACCESS_KEY"
+	ConsoleAlertsSecretKey                       = "CONSOLE_ALERTS_SECRET_KEY"
+	ConsoleAlertsSMTPServer                      = "CONSOLE_ALERTS_SMTP_SERVER"
+	ConsoleAlertsSMTPUsername                    = "CONSOLE_ALERTS_SMTP_USERNAME"
+	ConsoleAlertsSMTPPassword                    = "CONSOLE_ALERTS_SMTP_PASSWORD"
+	ConsoleAlertsSMTPFrom                        = "CONSOLE_ALERTS_SMTP_FROM"
+	ConsoleCapacitySampleInterval                = "CONSOLE_CAPACITY_SAMPLE_INTERVAL"
+	ConsoleCapacityRetention                     = "CONSOLE_CAPACITY_RETENTION"
+	ConsoleCapacityAccessKey                     = "CONSOLE_CAPACITY_ACCESS_KEY"
+	ConsoleCapacitySecretKey                     = "CONSOLE_CAPACITY_SECRET_KEY"
+	ConsoleGrantsInterval                        = "CONSOLE_GRANTS_INTERVAL"
+	ConsoleGrantsMaxDuration                     = "CONSOLE_GRANTS_MAX_DURATION"
+	ConsoleGrantsAccessKey                       = "CONSOLE_GRANTS_ACCESS_KEY"
+	ConsoleGrantsSecretKey                       = "CONSOLE_GRANTS_SECRET_KEY"
+	ConsoleServiceAccountsInterval               = "CONSOLE_SERVICE_ACCOUNTS_INTERVAL"
+	ConsoleServiceAccountsDeleteAfter            = "CONSOLE_SERVICE_ACCOUNTS_DELETE_AFTER"
+	ConsoleServiceAccountsAccessKey              = "CONSOLE_SERVICE_ACCOUNTS_ACCESS_KEY"
+	ConsoleServiceAccountsSecretKey              = "CONSOLE_SERVICE_ACCOUNTS_SECRET_KEY"
 	SlashSeparator                               = "/"
 )
@@ -112,18 +112,18 @@ func init() {
         }
       }
     },
-    "/admin/arns": {
+    "/admin/alerts/channels": {
       "get": {
         "tags": [
           "System"
         ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
+        "summary": "List the alert notification channels",
+        "operationId": "ListAlertChannels",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/arnsResponse"
+              "$ref": "#/definitions/alertChannelList"
             }
           },
           "default": {
@@ -133,28 +133,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/admin/info": {
-      "get": {
+      },
+      "put": {
         "tags": [
           "System"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
+        "summary": "Set the alert notification channels",
+        "operationId": "SetAlertChannels",
         "parameters": [
           {
-            "type": "boolean",
-            "default": false,
-            "name": "defaultOnly",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertChannelList"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
+              "$ref": "#/definitions/alertChannelList"
             }
           },
           "default": {
@@ -166,35 +166,55 @@ func init() {
         }
       }
     },
-    "/admin/info/widgets/{widgetId}": {
-      "get": {
+    "/admin/alerts/channels/{name}/test": {
+      "post": {
         "tags": [
           "System"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "DashboardWidgetDetails",
+        "summary": "Send a test notification to an alert channel",
+        "operationId": "TestAlertChannel",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "widgetId",
+            "type": "string",
+            "name": "name",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/history": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "List the alerts history",
+        "operationId": "ListAlerts",
+        "parameters": [
           {
-            "type": "integer",
-            "name": "start",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "end",
+            "enum": [
+              "firing",
+              "resolved"
+            ],
+            "type": "string",
+            "name": "status",
             "in": "query"
           },
           {
             "type": "integer",
             "format": "int32",
-            "name": "step",
+            "name": "limit",
             "in": "query"
           }
         ],
@@ -202,7 +222,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/widgetDetails"
+              "$ref": "#/definitions/alertList"
             }
           },
           "default": {
@@ -214,40 +234,26 @@ func init() {
         }
       }
     },
-    "/admin/inspect": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/admin/alerts/history/{alertId}/acknowledge": {
+      "post": {
         "tags": [
-          "Inspect"
+          "System"
         ],
-        "summary": "Inspect Files on Drive",
-        "operationId": "Inspect",
+        "summary": "Acknowledge a firing alert",
+        "operationId": "AcknowledgeAlert",
         "parameters": [
           {
             "type": "string",
-            "name": "file",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "volume",
-            "in": "query",
+            "name": "alertId",
+            "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "encrypt",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/alert"
             }
           },
           "default": {
@@ -259,18 +265,18 @@ func init() {
         }
       }
     },
-    "/admin/notification_endpoints": {
+    "/admin/alerts/rules": {
       "get": {
         "tags": [
-          "Configuration"
+          "System"
         ],
-        "summary": "Returns a list of active notification endpoints",
-        "operationId": "NotificationEndpointList",
+        "summary": "List the alert rules",
+        "operationId": "ListAlertRules",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/notifEndpointResponse"
+              "$ref": "#/definitions/alertRuleList"
             }
           },
           "default": {
@@ -283,17 +289,17 @@ func init() {
       },
       "post": {
         "tags": [
-          "Configuration"
+          "System"
         ],
-        "summary": "Allows to configure a new notification endpoint",
-        "operationId": "AddNotificationEndpoint",
+        "summary": "Create an alert rule",
+        "operationId": "CreateAlertRule",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
+              "$ref": "#/definitions/alertRule"
             }
           }
         ],
@@ -301,7 +307,7 @@ func init() {
           "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setNotificationEndpointResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -313,18 +319,34 @@ func init() {
         }
       }
     },
-    "/admin/site-replication": {
-      "get": {
+    "/admin/alerts/rules/{ruleId}": {
+      "put": {
         "tags": [
-          "SiteReplication"
+          "System"
+        ],
+        "summary": "Update an alert rule",
+        "operationId": "UpdateAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          }
         ],
-        "summary": "Get list of Replication Sites",
-        "operationId": "GetSiteReplicationInfo",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationInfoResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -335,28 +357,23 @@ func init() {
           }
         }
       },
-      "put": {
+      "delete": {
         "tags": [
-          "SiteReplication"
+          "System"
         ],
-        "summary": "Edit a Replication Site",
-        "operationId": "SiteReplicationEdit",
+        "summary": "Delete an alert rule",
+        "operationId": "DeleteAlertRule",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/peerInfo"
-            }
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/peerSiteEditResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -365,20 +382,28 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/admin/alerts/rules/{ruleId}/silence": {
       "post": {
         "tags": [
-          "SiteReplication"
+          "System"
         ],
-        "summary": "Add a Replication Site",
-        "operationId": "SiteReplicationInfoAdd",
+        "summary": "Silence the notifications of an alert rule",
+        "operationId": "SilenceAlertRule",
         "parameters": [
+          {
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/siteReplicationAddRequest"
+              "$ref": "#/definitions/alertSilence"
             }
           }
         ],
@@ -386,7 +411,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationAddResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -399,25 +424,23 @@ func init() {
       },
       "delete": {
         "tags": [
-          "SiteReplication"
+          "System"
         ],
-        "summary": "Remove a Replication Site",
-        "operationId": "SiteReplicationRemove",
+        "summary": "Remove the silence of an alert rule",
+        "operationId": "UnsilenceAlertRule",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/peerInfoRemove"
-            }
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/peerSiteRemoveResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -429,60 +452,18 @@ func init() {
         }
       }
     },
-    "/admin/site-replication/status": {
+    "/admin/arns": {
       "get": {
         "tags": [
-          "SiteReplication"
-        ],
-        "summary": "Display overall site replication status",
-        "operationId": "GetSiteReplicationStatus",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Bucket stats",
-            "name": "buckets",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Group stats",
-            "name": "groups",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Policies stats",
-            "name": "policies",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Policies stats",
-            "name": "users",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Entity Type to lookup",
-            "name": "entityType",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Entity Value to lookup",
-            "name": "entityValue",
-            "in": "query"
-          }
+          "System"
         ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationStatusResponse"
+              "$ref": "#/definitions/arnsResponse"
             }
           },
           "default": {
@@ -494,18 +475,27 @@ func init() {
         }
       }
     },
-    "/admin/tiers": {
+    "/admin/capacity/forecast": {
       "get": {
         "tags": [
-          "Tiering"
+          "System"
+        ],
+        "summary": "Forecast when the pools and bucket quotas are full",
+        "operationId": "CapacityForecast",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "days of usage samples the trend is fitted on, defaults to 30",
+            "name": "days",
+            "in": "query"
+          }
         ],
-        "summary": "Returns a list of tiers for ilm",
-        "operationId": "TiersList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tierListResponse"
+              "$ref": "#/definitions/capacityForecast"
             }
           },
           "default": {
@@ -515,26 +505,76 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/admin/console/logs/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Tiering"
+          "Logging"
         ],
-        "summary": "Allows to configure a new tier",
-        "operationId": "AddTier",
+        "summary": "Download the last console log entries of every node",
+        "operationId": "DownloadConsoleLogs",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/tier"
-            }
+            "type": "string",
+            "name": "node",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "minio",
+              "application",
+              "all"
+            ],
+            "type": "string",
+            "default": "all",
+            "name": "logType",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 100,
+            "description": "Number of entries per node",
+            "name": "lineCount",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Regular expression matched against the log message",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "api",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "errKind",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "deploymentId",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -545,37 +585,29 @@ func init() {
         }
       }
     },
-    "/admin/tiers/{type}/{name}": {
+    "/admin/dashboard/export": {
       "get": {
         "tags": [
-          "Tiering"
+          "System"
         ],
-        "summary": "Get Tier",
-        "operationId": "GetTier",
+        "summary": "Export the custom dashboard widgets",
+        "operationId": "ExportDashboard",
         "parameters": [
           {
             "enum": [
-              "s3",
-              "gcs",
-              "azure"
+              "user",
+              "global"
             ],
             "type": "string",
-            "name": "type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "scope",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/dashboardExport"
             }
           },
           "default": {
@@ -587,43 +619,29 @@ func init() {
         }
       }
     },
-    "/admin/tiers/{type}/{name}/credentials": {
-      "put": {
+    "/admin/dashboard/import": {
+      "post": {
         "tags": [
-          "Tiering"
+          "System"
         ],
-        "summary": "Edit Tier Credentials",
-        "operationId": "EditTierCredentials",
+        "summary": "Import custom dashboard widgets",
+        "operationId": "ImportDashboard",
         "parameters": [
-          {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure"
-            ],
-            "type": "string",
-            "name": "type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/tierCredentialsRequest"
+              "$ref": "#/definitions/importDashboardRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidgetList"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -634,38 +652,28 @@ func init() {
         }
       }
     },
-    "/bucket-policy/{bucket}": {
-      "get": {
+    "/admin/dashboard/order": {
+      "put": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Policies With Given Bucket",
-        "operationId": "ListPoliciesWithBucket",
+        "summary": "Order the custom dashboard widgets",
+        "operationId": "OrderDashboardWidgets",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidgetsOrder"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/dashboardWidgetList"
             }
           },
           "default": {
@@ -677,41 +685,18 @@ func init() {
         }
       }
     },
-    "/bucket-users/{bucket}": {
+    "/admin/dashboard/widgets": {
       "get": {
         "tags": [
-          "Bucket"
-        ],
-        "summary": "List Users With Access to a Given Bucket",
-        "operationId": "ListUsersWithAccessToBucket",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
+          "System"
         ],
+        "summary": "List custom dashboard widgets",
+        "operationId": "ListDashboardWidgets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/dashboardWidgetList"
             }
           },
           "default": {
@@ -721,40 +706,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/bucket/{bucket}/access-rules": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Access Rules With Given Bucket",
-        "operationId": "ListAccessRulesWithBucket",
+        "summary": "Create a custom dashboard widget",
+        "operationId": "CreateDashboardWidget",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listAccessRulesResponse"
+              "$ref": "#/definitions/dashboardWidget"
             }
           },
           "default": {
@@ -764,26 +737,29 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/admin/dashboard/widgets/{widgetId}": {
       "put": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "Add Access Rule To Given Bucket",
-        "operationId": "SetAccessRuleWithBucket",
+        "summary": "Update a custom dashboard widget",
+        "operationId": "UpdateDashboardWidget",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
           },
           {
-            "name": "prefixaccess",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/prefixAccessPair"
+              "$ref": "#/definitions/dashboardWidget"
             }
           }
         ],
@@ -791,7 +767,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "boolean"
+              "$ref": "#/definitions/dashboardWidget"
             }
           },
           "default": {
@@ -804,32 +780,22 @@ func init() {
       },
       "delete": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "Delete Access Rule From Given Bucket",
-        "operationId": "DeleteAccessRuleWithBucket",
+        "summary": "Delete a custom dashboard widget",
+        "operationId": "DeleteDashboardWidget",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "prefix",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/prefixWrapper"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "boolean"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -840,18 +806,30 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/admin/grants": {
       "get": {
         "tags": [
-          "Bucket"
+          "Policy"
+        ],
+        "summary": "List the temporary access grants",
+        "operationId": "ListAccessGrants",
+        "parameters": [
+          {
+            "enum": [
+              "active",
+              "expired",
+              "revoked"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          }
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/accessGrantList"
             }
           },
           "default": {
@@ -864,23 +842,26 @@ func init() {
       },
       "post": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "Attach a policy to a user or group for a limited time",
+        "operationId": "CreateAccessGrant",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
+              "$ref": "#/definitions/accessGrantRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/accessGrant"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -891,28 +872,26 @@ func init() {
         }
       }
     },
-    "/buckets-replication": {
-      "post": {
+    "/admin/grants/{id}": {
+      "delete": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Sets Multi Bucket Replication in multiple Buckets",
-        "operationId": "SetMultiBucketReplication",
+        "summary": "Revoke a temporary access grant before it expires",
+        "operationId": "RevokeAccessGrant",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplication"
-            }
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiBucketResponseState"
+              "$ref": "#/definitions/accessGrant"
             }
           },
           "default": {
@@ -924,28 +903,41 @@ func init() {
         }
       }
     },
-    "/buckets/multi-lifecycle": {
-      "post": {
+    "/admin/heal/jobs": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Heal"
         ],
-        "summary": "Add Multi Bucket Lifecycle",
-        "operationId": "AddMultiBucketLifecycle",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "List running and past heal jobs",
+        "operationId": "ListHealJobs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/addMultiBucketLifecycle"
+              "$ref": "#/definitions/listHealJobsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/admin/health/reports": {
+      "get": {
+        "tags": [
+          "System"
         ],
+        "summary": "List archived Health Reports",
+        "operationId": "ListHealthReports",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiLifecycleResult"
+              "$ref": "#/definitions/listHealthReportsResponse"
             }
           },
           "default": {
@@ -957,24 +949,33 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-all-replication-rules": {
-      "delete": {
+    "/admin/health/reports/diff": {
+      "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "Deletes all replication rules from a bucket",
-        "operationId": "DeleteAllReplicationRules",
+        "summary": "Diff two archived Health Reports",
+        "operationId": "DiffHealthReports",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
+            "name": "base",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/healthReportDiff"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -985,40 +986,37 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-objects": {
-      "post": {
+    "/admin/health/reports/{id}/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Object"
+          "System"
         ],
-        "summary": "Delete Multiple Objects",
-        "operationId": "DeleteMultipleObjects",
+        "summary": "Download archived Health Report",
+        "operationId": "DownloadHealthReport",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "boolean",
-            "name": "all_versions",
+            "default": false,
+            "description": "hash hostnames, IPs and endpoints and strip secrets from the report",
+            "name": "anonymize",
             "in": "query"
-          },
-          {
-            "name": "files",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/deleteFile"
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1029,32 +1027,38 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-selected-replication-rules": {
-      "delete": {
+    "/admin/iam/effective-permissions": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Deletes selected replication rules from a bucket",
-        "operationId": "DeleteSelectedReplicationRules",
+        "summary": "Effective permissions of every user, group and service account per bucket and prefix",
+        "operationId": "EffectivePermissions",
         "parameters": [
           {
+            "enum": [
+              "user",
+              "group",
+              "serviceAccount"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "description": "only report this kind of entity",
+            "name": "entityType",
+            "in": "query"
           },
           {
-            "name": "rules",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketReplicationRuleList"
-            }
+            "type": "string",
+            "description": "only report the permissions applying to this bucket",
+            "name": "bucket",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/effectivePermissionsReport"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1065,24 +1069,41 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/disable": {
-      "post": {
+    "/admin/iam/effective-permissions/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Disable bucket encryption.",
-        "operationId": "DisableBucketEncryption",
+        "summary": "Export the effective permissions report as CSV",
+        "operationId": "ExportEffectivePermissions",
         "parameters": [
           {
+            "enum": [
+              "user",
+              "group",
+              "serviceAccount"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "description": "only report this kind of entity",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only report the permissions applying to this bucket",
+            "name": "bucket",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1093,32 +1114,34 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/enable": {
-      "post": {
+    "/admin/iam/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Enable bucket encryption.",
-        "operationId": "EnableBucketEncryption",
+        "summary": "Export the policies, users, groups and service accounts as a bundle",
+        "operationId": "ExportIAM",
         "parameters": [
           {
+            "enum": [
+              "json",
+              "yaml"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketEncryptionRequest"
-            }
+            "default": "json",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1129,26 +1152,28 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/info": {
-      "get": {
+    "/admin/iam/import": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Get bucket encryption information.",
-        "operationId": "GetBucketEncryptionInfo",
+        "summary": "Import a bundle of policies, users, groups and service accounts",
+        "operationId": "ImportIAM",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/iamImportRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionInfo"
+              "$ref": "#/definitions/iamImportResult"
             }
           },
           "default": {
@@ -1160,30 +1185,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events": {
+    "/admin/info": {
       "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "List Bucket Events",
-        "operationId": "ListBucketEvents",
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
+            "type": "boolean",
+            "default": false,
+            "name": "defaultOnly",
             "in": "query"
           }
         ],
@@ -1191,7 +1204,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketEventsResponse"
+              "$ref": "#/definitions/adminInfoResponse"
             }
           },
           "default": {
@@ -1201,32 +1214,44 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/admin/info/prometheus": {
+      "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "Create Bucket Event",
-        "operationId": "CreateBucketEvent",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+        "summary": "Check the connectivity with Prometheus",
+        "operationId": "CheckPrometheus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/prometheusCheck"
+            }
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "default": {
+            "description": "Generic error response.",
             "schema": {
-              "$ref": "#/definitions/bucketEventRequest"
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/admin/info/topology": {
+      "get": {
+        "tags": [
+          "System"
         ],
+        "summary": "Returns the erasure sets of the pools with the health of their drives",
+        "operationId": "ErasureTopology",
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/erasureTopology"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1237,38 +1262,44 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events/{arn}": {
-      "delete": {
+    "/admin/info/widgets/{widgetId}": {
+      "get": {
         "tags": [
-          "Bucket"
+          "System"
         ],
-        "summary": "Delete Bucket Event",
-        "operationId": "DeleteBucketEvent",
+        "summary": "Returns information about the deployment",
+        "operationId": "DashboardWidgetDetails",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "arn",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "name": "start",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/notificationDeleteRequest"
-            }
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/widgetDetails"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1279,26 +1310,63 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle": {
+    "/admin/inspect": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Bucket"
+          "Inspect"
         ],
-        "summary": "Bucket Lifecycle",
-        "operationId": "GetBucketLifecycle",
+        "summary": "Inspect Files on Drive",
+        "operationId": "Inspect",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
+            "name": "file",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "volume",
+            "in": "query",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "encrypt",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketLifecycleResponse"
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/notification_endpoints": {
+      "get": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Returns a list of active notification endpoints",
+        "operationId": "NotificationEndpointList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notifEndpointResponse"
             }
           },
           "default": {
@@ -1311,29 +1379,26 @@ func init() {
       },
       "post": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Add Bucket Lifecycle",
-        "operationId": "AddBucketLifecycle",
+        "summary": "Allows to configure a new notification endpoint",
+        "operationId": "AddNotificationEndpoint",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addBucketLifecycle"
+              "$ref": "#/definitions/notificationEndpoint"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setNotificationEndpointResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1344,38 +1409,50 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
+    "/admin/site-replication": {
+      "get": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Get list of Replication Sites",
+        "operationId": "GetSiteReplicationInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationInfoResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
       "put": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Update Lifecycle rule",
-        "operationId": "UpdateBucketLifecycle",
+        "summary": "Edit a Replication Site",
+        "operationId": "SiteReplicationEdit",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateBucketLifecycle"
+              "$ref": "#/definitions/peerInfo"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/peerSiteEditResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1385,29 +1462,28 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Delete Lifecycle rule",
-        "operationId": "DeleteBucketLifecycleRule",
+        "summary": "Add a Replication Site",
+        "operationId": "SiteReplicationInfoAdd",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1416,28 +1492,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/object-locking": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Bucket"
+          "SiteReplication"
         ],
-        "summary": "Returns the status of object locking support on the bucket",
-        "operationId": "GetBucketObjectLockingStatus",
+        "summary": "Remove a Replication Site",
+        "operationId": "SiteReplicationRemove",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/peerInfoRemove"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketObLockingResponse"
+              "$ref": "#/definitions/peerSiteRemoveResponse"
             }
           },
           "default": {
@@ -1449,46 +1525,60 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects": {
+    "/admin/site-replication/status": {
       "get": {
         "tags": [
-          "Object"
+          "SiteReplication"
         ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
+        "summary": "Display overall site replication status",
+        "operationId": "GetSiteReplicationStatus",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": true,
+            "description": "Include Bucket stats",
+            "name": "buckets",
+            "in": "query"
           },
           {
-            "type": "string",
-            "name": "prefix",
+            "type": "boolean",
+            "default": true,
+            "description": "Include Group stats",
+            "name": "groups",
             "in": "query"
           },
           {
             "type": "boolean",
-            "name": "recursive",
+            "default": true,
+            "description": "Include Policies stats",
+            "name": "policies",
             "in": "query"
           },
           {
             "type": "boolean",
-            "name": "with_versions",
+            "default": true,
+            "description": "Include Policies stats",
+            "name": "users",
             "in": "query"
           },
           {
-            "type": "boolean",
-            "name": "with_metadata",
+            "type": "string",
+            "description": "Entity Type to lookup",
+            "name": "entityType",
             "in": "query"
-          }
-        ],
-        "responses": {
+          },
+          {
+            "type": "string",
+            "description": "Entity Value to lookup",
+            "name": "entityValue",
+            "in": "query"
+          }
+        ],
+        "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
+              "$ref": "#/definitions/siteReplicationStatusResponse"
             }
           },
           "default": {
@@ -1498,50 +1588,35 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/speedtest/compare": {
+      "get": {
         "tags": [
-          "Object"
+          "Speedtest"
         ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
+        "summary": "Compare two Speedtest Results",
+        "operationId": "CompareSpeedtestResults",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
+            "name": "base",
+            "in": "query",
             "required": true
           },
           {
             "type": "string",
-            "name": "path",
+            "name": "target",
             "in": "query",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "non_current_versions",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestComparison"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1552,44 +1627,22 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
+    "/admin/speedtest/results": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Object"
+          "Speedtest"
         ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
+        "summary": "List Speedtest Results",
+        "operationId": "ListSpeedtestResults",
         "parameters": [
           {
+            "enum": [
+              "object",
+              "drive",
+              "net"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "name": "preview",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "default": "",
-            "name": "override_file_name",
+            "name": "mode",
             "in": "query"
           }
         ],
@@ -1597,7 +1650,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/listSpeedtestResultsResponse"
             }
           },
           "default": {
@@ -1609,43 +1662,103 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/legalhold": {
-      "put": {
+    "/admin/speedtest/results/{id}": {
+      "get": {
         "tags": [
-          "Object"
+          "Speedtest"
         ],
-        "summary": "Put Object's legalhold status",
-        "operationId": "PutObjectLegalHold",
+        "summary": "Get Speedtest Result",
+        "operationId": "GetSpeedtestResult",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestResult"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Delete Speedtest Result",
+        "operationId": "DeleteSpeedtestResult",
+        "parameters": [
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
+            "name": "id",
+            "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers": {
+      "get": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Returns a list of tiers for ilm",
+        "operationId": "TiersList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tierListResponse"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Allows to configure a new tier",
+        "operationId": "AddTier",
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putObjectLegalHoldRequest"
+              "$ref": "#/definitions/tier"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -1657,24 +1770,29 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/metadata": {
+    "/admin/tiers/{type}/{name}": {
       "get": {
         "tags": [
-          "Object"
+          "Tiering"
         ],
-        "summary": "Gets the metadata of an object",
-        "operationId": "GetObjectMetadata",
+        "summary": "Get Tier",
+        "operationId": "GetTier",
         "parameters": [
           {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
             "type": "string",
-            "name": "bucket_name",
+            "name": "type",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
+            "name": "name",
+            "in": "path",
             "required": true
           }
         ],
@@ -1682,7 +1800,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/metadata"
+              "$ref": "#/definitions/tier"
             }
           },
           "default": {
@@ -1694,31 +1812,38 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/restore": {
+    "/admin/tiers/{type}/{name}/credentials": {
       "put": {
         "tags": [
-          "Object"
+          "Tiering"
         ],
-        "summary": "Restore Object to a selected version",
-        "operationId": "PutObjectRestore",
+        "summary": "Edit Tier Credentials",
+        "operationId": "EditTierCredentials",
         "parameters": [
           {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
             "type": "string",
-            "name": "bucket_name",
+            "name": "type",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
+            "name": "name",
+            "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tierCredentialsRequest"
+            }
           }
         ],
         "responses": {
@@ -1734,44 +1859,19 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/retention": {
-      "put": {
+    "/admin/trace/recordings": {
+      "get": {
         "tags": [
-          "Object"
-        ],
-        "summary": "Put Object's retention status",
-        "operationId": "PutObjectRetention",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectRetentionRequest"
-            }
-          }
+          "Trace"
         ],
+        "summary": "List Trace Recordings",
+        "operationId": "ListTraceRecordings",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTraceRecordingsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1780,35 +1880,25 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/admin/trace/recordings/{id}": {
       "delete": {
         "tags": [
-          "Object"
+          "Trace"
         ],
-        "summary": "Delete Object retention from an object",
-        "operationId": "DeleteObjectRetention",
+        "summary": "Delete Trace Recording",
+        "operationId": "DeleteTraceRecording",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -1820,43 +1910,29 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
+    "/admin/trace/recordings/{id}/download": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Object"
+          "Trace"
         ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
+        "summary": "Download Trace Recording",
+        "operationId": "DownloadTraceRecording",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "expires",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "type": "file"
             }
           },
           "default": {
@@ -1868,44 +1944,27 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/tags": {
-      "put": {
+    "/admin/trace/recordings/{id}/summary": {
+      "get": {
         "tags": [
-          "Object"
+          "Trace"
         ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
+        "summary": "Trace Recording Summary",
+        "operationId": "TraceRecordingSummary",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/traceRecordingSummary"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1916,31 +1975,39 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
-        ],
+    "/bucket-policy/{bucket}": {
+      "get": {
         "tags": [
-          "Object"
+          "Bucket"
         ],
-        "summary": "Uploads an Object.",
+        "summary": "List Policies With Given Bucket",
+        "operationId": "ListPoliciesWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "prefix",
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1951,26 +2018,41 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication": {
+    "/bucket-users/{bucket}": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplication",
+        "summary": "List Users With Access to a Given Bucket",
+        "operationId": "ListUsersWithAccessToBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationResponse"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -1982,32 +2064,38 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication/{rule_id}": {
+    "/bucket/{bucket}/access-rules": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplicationRule",
+        "summary": "List Access Rules With Given Bucket",
+        "operationId": "ListAccessRulesWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationRule"
+              "$ref": "#/definitions/listAccessRulesResponse"
             }
           },
           "default": {
@@ -2022,33 +2110,30 @@ func init() {
         "tags": [
           "Bucket"
         ],
-        "summary": "Update Replication rule",
-        "operationId": "UpdateMultiBucketReplication",
+        "summary": "Add Access Rule To Given Bucket",
+        "operationId": "SetAccessRuleWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "rule_id",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
+            "name": "prefixaccess",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/multiBucketReplicationEdit"
+              "$ref": "#/definitions/prefixAccessPair"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2062,25 +2147,30 @@ func init() {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Replication Rule Delete",
-        "operationId": "DeleteBucketReplicationRule",
+        "summary": "Delete Access Rule From Given Bucket",
+        "operationId": "DeleteAccessRuleWithBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "bucket",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
+            "name": "prefix",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/prefixWrapper"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2091,26 +2181,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/retention": {
+    "/buckets": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get Bucket's retention config",
-        "operationId": "GetBucketRetentionConfig",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/getBucketRetentionConfig"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -2121,30 +2203,24 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Set Bucket's retention config",
-        "operationId": "SetBucketRetentionConfig",
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putBucketRetentionRequest"
+              "$ref": "#/definitions/makeBucketRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -2156,37 +2232,61 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/rewind/{date}": {
-      "get": {
+    "/buckets-replication": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
+        "summary": "Sets Multi Bucket Replication in multiple Buckets",
+        "operationId": "SetMultiBucketReplication",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "date",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/multiBucketReplication"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/multiBucketResponseState"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/multi-lifecycle": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Multi Bucket Lifecycle",
+        "operationId": "AddMultiBucketLifecycle",
+        "parameters": [
           {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addMultiBucketLifecycle"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/rewindResponse"
+              "$ref": "#/definitions/multiLifecycleResult"
             }
           },
           "default": {
@@ -2198,31 +2298,23 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/tags": {
-      "put": {
+    "/buckets/{bucket_name}/delete-all-replication-rules": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Put Bucket's tags",
-        "operationId": "PutBucketTags",
+        "summary": "Deletes all replication rules from a bucket",
+        "operationId": "DeleteAllReplicationRules",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putBucketTagsRequest"
-            }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -2234,27 +2326,40 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
-      "get": {
+    "/buckets/{bucket_name}/delete-objects": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Object"
         ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
+        "summary": "Delete Multiple Objects",
+        "operationId": "DeleteMultipleObjects",
         "parameters": [
           {
             "type": "string",
             "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "name": "files",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/deleteFile"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2263,13 +2368,15 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/delete-selected-replication-rules": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
+        "summary": "Deletes selected replication rules from a bucket",
+        "operationId": "DeleteSelectedReplicationRules",
         "parameters": [
           {
             "type": "string",
@@ -2278,16 +2385,16 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
+            "name": "rules",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
+              "$ref": "#/definitions/bucketReplicationRuleList"
             }
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -2299,27 +2406,60 @@ func init() {
         }
       }
     },
-    "/buckets/{name}": {
-      "get": {
+    "/buckets/{bucket_name}/encryption/disable": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
+        "summary": "Disable bucket encryption.",
+        "operationId": "DisableBucketEncryption",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/encryption/enable": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Enable bucket encryption.",
+        "operationId": "EnableBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionRequest"
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2328,24 +2468,29 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/encryption/info": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
+        "summary": "Get bucket encryption information.",
+        "operationId": "GetBucketEncryptionInfo",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionInfo"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2356,26 +2501,38 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/quota": {
+    "/buckets/{bucket_name}/events": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
+        "summary": "List Bucket Events",
+        "operationId": "ListBucketEvents",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketQuota"
+              "$ref": "#/definitions/listBucketEventsResponse"
             }
           },
           "default": {
@@ -2386,16 +2543,16 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Quota",
-        "operationId": "SetBucketQuota",
+        "summary": "Create Bucket Event",
+        "operationId": "CreateBucketEvent",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -2404,16 +2561,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketQuota"
+              "$ref": "#/definitions/bucketEventRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2424,17 +2578,23 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/set-policy": {
-      "put": {
+    "/buckets/{bucket_name}/events/{arn}": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
+        "summary": "Delete Bucket Event",
+        "operationId": "DeleteBucketEvent",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
             "in": "path",
             "required": true
           },
@@ -2443,16 +2603,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
+              "$ref": "#/definitions/notificationDeleteRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2463,19 +2620,26 @@ func init() {
         }
       }
     },
-    "/check-version": {
+    "/buckets/{bucket_name}/lifecycle": {
       "get": {
-        "security": [],
         "tags": [
-          "System"
+          "Bucket"
+        ],
+        "summary": "Bucket Lifecycle",
+        "operationId": "GetBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Checks the current MinIO version against the latest",
-        "operationId": "CheckMinIOVersion",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/checkVersionResponse"
+              "$ref": "#/definitions/bucketLifecycleResponse"
             }
           },
           "default": {
@@ -2485,35 +2649,32 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
+        "summary": "Add Bucket Lifecycle",
+        "operationId": "AddBucketLifecycle",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addBucketLifecycle"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listConfigResponse"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2524,46 +2685,23 @@ func init() {
         }
       }
     },
-    "/configs/{name}": {
-      "get": {
+    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
+      "put": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
+        "summary": "Update Lifecycle rule",
+        "operationId": "UpdateBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/configuration"
-            }
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "put": {
-        "tags": [
-          "Configuration"
-        ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
-        "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "lifecycle_id",
             "in": "path",
             "required": true
           },
@@ -2572,16 +2710,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setConfigRequest"
+              "$ref": "#/definitions/updateBucketLifecycle"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setConfigResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2590,29 +2725,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs/{name}/reset": {
-      "post": {
+      },
+      "delete": {
         "tags": [
-          "Configuration"
+          "Bucket"
         ],
-        "summary": "Configuration reset",
-        "operationId": "ResetConfig",
+        "summary": "Delete Lifecycle rule",
+        "operationId": "DeleteBucketLifecycleRule",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setConfigResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2623,17 +2759,17 @@ func init() {
         }
       }
     },
-    "/group/{name}": {
+    "/buckets/{bucket_name}/object-locking": {
       "get": {
         "tags": [
-          "Group"
+          "Bucket"
         ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
+        "summary": "Returns the status of object locking support on the bucket",
+        "operationId": "GetBucketObjectLockingStatus",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -2642,7 +2778,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/bucketObLockingResponse"
             }
           },
           "default": {
@@ -2652,34 +2788,48 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/objects": {
+      "get": {
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
+        "summary": "List Objects",
+        "operationId": "ListObjects",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
-            }
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_metadata",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/listObjectsResponse"
             }
           },
           "default": {
@@ -2692,20 +2842,46 @@ func init() {
       },
       "delete": {
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "path",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "non_current_versions",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -2717,24 +2893,44 @@ func init() {
         }
       }
     },
-    "/groups": {
+    "/buckets/{bucket_name}/objects/download": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
+        "summary": "Download Object",
+        "operationId": "Download Object",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
             "in": "query"
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
+            "type": "boolean",
+            "default": false,
+            "name": "preview",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "default": "",
+            "name": "override_file_name",
             "in": "query"
           }
         ],
@@ -2742,7 +2938,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -2752,59 +2948,46 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/legalhold": {
+      "put": {
         "tags": [
-          "Group"
+          "Object"
         ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
+        "summary": "Put Object's legalhold status",
+        "operationId": "PutObjectLegalHold",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addGroupRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/list-external-buckets": {
-      "post": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Lists an External list of buckets using custom credentials",
-        "operationId": "ListExternalBuckets",
-        "parameters": [
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/listExternalBucketsParams"
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2815,19 +2998,32 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/buckets/{bucket_name}/objects/metadata": {
       "get": {
-        "security": [],
         "tags": [
-          "Auth"
+          "Object"
+        ],
+        "summary": "Gets the metadata of an object",
+        "operationId": "GetObjectMetadata",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "$ref": "#/definitions/metadata"
             }
           },
           "default": {
@@ -2837,27 +3033,38 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "security": [],
+      }
+    },
+    "/buckets/{bucket_name}/objects/restore": {
+      "put": {
         "tags": [
-          "Auth"
+          "Object"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
+        "summary": "Restore Object to a selected version",
+        "operationId": "PutObjectRestore",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginRequest"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2868,27 +3075,44 @@ func init() {
         }
       }
     },
-    "/login/oauth2/auth": {
-      "post": {
-        "security": [],
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
         "tags": [
-          "Auth"
+          "Object"
         ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
+        "summary": "Put Object's retention status",
+        "operationId": "PutObjectRetention",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
+              "$ref": "#/definitions/putObjectRetentionRequest"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2897,15 +3121,33 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/logout": {
-      "post": {
+      },
+      "delete": {
         "tags": [
-          "Auth"
+          "Object"
+        ],
+        "summary": "Delete Object retention from an object",
+        "operationId": "DeleteObjectRetention",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          }
         ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
         "responses": {
           "200": {
             "description": "A successful response."
@@ -2919,51 +3161,35 @@ func init() {
         }
       }
     },
-    "/logs/search": {
+    "/buckets/{bucket_name}/objects/share": {
       "get": {
         "tags": [
-          "Logging"
+          "Object"
         ],
-        "summary": "Search the logs",
-        "operationId": "LogSearch",
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
         "parameters": [
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Filter Parameters",
-            "name": "fp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 10,
-            "name": "pageSize",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "pageNo",
-            "in": "query"
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
           },
           {
-            "enum": [
-              "timeDesc",
-              "timeAsc"
-            ],
             "type": "string",
-            "default": "timeDesc",
-            "name": "order",
-            "in": "query"
+            "name": "version_id",
+            "in": "query",
+            "required": true
           },
           {
             "type": "string",
-            "name": "timeStart",
+            "name": "expires",
             "in": "query"
           }
         ],
@@ -2971,33 +3197,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/logSearchResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/nodes": {
-      "get": {
-        "tags": [
-          "System"
-        ],
-        "summary": "Lists Nodes",
-        "operationId": "ListNodes",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "type": "string"
             }
           },
           "default": {
@@ -3009,64 +3209,44 @@ func init() {
         }
       }
     },
-    "/policies": {
-      "get": {
+    "/buckets/{bucket_name}/objects/tags": {
+      "put": {
         "tags": [
-          "Policy"
+          "Object"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
-            }
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "Policy"
-        ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
-        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
+              "$ref": "#/definitions/putObjectTagsRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3077,30 +3257,31 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/groups": {
-      "get": {
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
         "tags": [
-          "Policy"
+          "Object"
         ],
-        "summary": "List Groups for a Policy",
-        "operationId": "ListGroupsForPolicy",
+        "summary": "Uploads an Object.",
         "parameters": [
           {
             "type": "string",
-            "name": "policy",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3111,17 +3292,17 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/users": {
+    "/buckets/{bucket_name}/replication": {
       "get": {
         "tags": [
-          "Policy"
+          "Bucket"
         ],
-        "summary": "List Users for a Policy",
-        "operationId": "ListUsersForPolicy",
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplication",
         "parameters": [
           {
             "type": "string",
-            "name": "policy",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           }
@@ -3130,10 +3311,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/bucketReplicationResponse"
             }
           },
           "default": {
@@ -3145,17 +3323,23 @@ func init() {
         }
       }
     },
-    "/policy/{name}": {
+    "/buckets/{bucket_name}/replication/{rule_id}": {
       "get": {
         "tags": [
-          "Policy"
+          "Bucket"
         ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplicationRule",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
             "in": "path",
             "required": true
           }
@@ -3164,7 +3348,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "$ref": "#/definitions/bucketReplicationRule"
             }
           },
           "default": {
@@ -3175,56 +3359,37 @@ func init() {
           }
         }
       },
-      "delete": {
+      "put": {
         "tags": [
-          "Policy"
+          "Bucket"
         ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
+        "summary": "Update Replication rule",
+        "operationId": "UpdateMultiBucketReplication",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/profiling/start": {
-      "post": {
-        "tags": [
-          "Profile"
-        ],
-        "summary": "Start recording profile data",
-        "operationId": "ProfilingStart",
-        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/profilingStartRequest"
+              "$ref": "#/definitions/multiBucketReplicationEdit"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/startProfilingList"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3233,24 +3398,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/profiling/stop": {
-      "post": {
-        "produces": [
-          "application/zip"
-        ],
+      },
+      "delete": {
         "tags": [
-          "Profile"
+          "Bucket"
+        ],
+        "summary": "Bucket Replication Rule Delete",
+        "operationId": "DeleteBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Stop and download profile data",
-        "operationId": "ProfilingStop",
         "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3261,18 +3432,26 @@ func init() {
         }
       }
     },
-    "/remote-buckets": {
+    "/buckets/{bucket_name}/retention": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Remote Buckets",
-        "operationId": "ListRemoteBuckets",
+        "summary": "Get Bucket's retention config",
+        "operationId": "GetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listRemoteBucketsResponse"
+              "$ref": "#/definitions/getBucketRetentionConfig"
             }
           },
           "default": {
@@ -3283,24 +3462,30 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Add Remote Bucket",
-        "operationId": "AddRemoteBucket",
+        "summary": "Set Bucket's retention config",
+        "operationId": "SetBucketRetentionConfig",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createRemoteBucket"
+              "$ref": "#/definitions/putBucketRetentionRequest"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -3312,26 +3497,37 @@ func init() {
         }
       }
     },
-    "/remote-buckets/{name}": {
+    "/buckets/{bucket_name}/rewind/{date}": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Remote Bucket Details",
-        "operationId": "RemoteBucketDetails",
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "date",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/remoteBucket"
+              "$ref": "#/definitions/rewindResponse"
             }
           },
           "default": {
@@ -3343,63 +3539,32 @@ func init() {
         }
       }
     },
-    "/remote-buckets/{source-bucket-name}/{arn}": {
-      "delete": {
+    "/buckets/{bucket_name}/tags": {
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Delete Remote Bucket",
-        "operationId": "DeleteRemoteBucket",
+        "summary": "Put Bucket's tags",
+        "operationId": "PutBucketTags",
         "parameters": [
           {
             "type": "string",
-            "name": "source-bucket-name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "arn",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/service-account-credentials": {
-      "post": {
-        "tags": [
-          "ServiceAccount"
-        ],
-        "summary": "Create Service Account With Credentials",
-        "operationId": "CreateServiceAccountCreds",
-        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequestCreds"
+              "$ref": "#/definitions/putBucketTagsRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3410,32 +3575,26 @@ func init() {
         }
       }
     },
-    "/service-accounts": {
+    "/buckets/{bucket_name}/versioning": {
       "get": {
         "tags": [
-          "ServiceAccount"
+          "Bucket"
         ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/bucketVersioningResponse"
             }
           },
           "default": {
@@ -3446,28 +3605,31 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "ServiceAccount"
+          "Bucket"
         ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/setBucketVersioning"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3478,29 +3640,27 @@ func init() {
         }
       }
     },
-    "/service-accounts/delete-multi": {
-      "delete": {
+    "/buckets/{name}": {
+      "get": {
         "tags": [
-          "ServiceAccount"
+          "Bucket"
         ],
-        "summary": "Delete Multiple Service Accounts",
-        "operationId": "DeleteMultipleServiceAccounts",
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
         "parameters": [
           {
-            "name": "selectedSA",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3509,19 +3669,17 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/service-accounts/{access_key}": {
+      },
       "delete": {
         "tags": [
-          "ServiceAccount"
+          "Bucket"
         ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -3539,17 +3697,17 @@ func init() {
         }
       }
     },
-    "/service-accounts/{access_key}/policy": {
+    "/buckets/{name}/quota": {
       "get": {
         "tags": [
-          "ServiceAccount"
+          "Bucket"
         ],
-        "summary": "Get Service Account Policy",
-        "operationId": "GetServiceAccountPolicy",
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -3558,7 +3716,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/bucketQuota"
             }
           },
           "default": {
@@ -3571,29 +3729,32 @@ func init() {
       },
       "put": {
         "tags": [
-          "ServiceAccount"
+          "Bucket"
         ],
-        "summary": "Set Service Account Policy",
-        "operationId": "SetServiceAccountPolicy",
+        "summary": "Bucket Quota",
+        "operationId": "SetBucketQuota",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "name": "policy",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addServiceAccountPolicyRequest"
+              "$ref": "#/definitions/setBucketQuota"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3604,38 +3765,34 @@ func init() {
         }
       }
     },
-    "/service/restart": {
-      "post": {
+    "/buckets/{name}/set-policy": {
+      "put": {
         "tags": [
-          "Service"
+          "Bucket"
         ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
-        "responses": {
-          "204": {
-            "description": "A successful response."
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           },
-          "default": {
-            "description": "Generic error response.",
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/setBucketPolicyRequest"
             }
           }
-        }
-      }
-    },
-    "/session": {
-      "get": {
-        "tags": [
-          "Auth"
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/sessionResponse"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -3647,26 +3804,20 @@ func init() {
         }
       }
     },
-    "/set-policy": {
-      "put": {
+    "/check-version": {
+      "get": {
+        "security": [],
         "tags": [
-          "Policy"
+          "System"
         ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "Checks the current MinIO version against the latest",
+        "operationId": "CheckMinIOVersion",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setPolicyNameRequest"
+              "$ref": "#/definitions/checkVersionResponse"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3677,26 +3828,33 @@ func init() {
         }
       }
     },
-    "/set-policy-multi": {
-      "put": {
+    "/configs": {
+      "get": {
         "tags": [
-          "Policy"
+          "Configuration"
         ],
-        "summary": "Set policy to multiple users/groups",
-        "operationId": "SetPolicyMultiple",
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setPolicyMultipleNameRequest"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listConfigResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -3707,51 +3865,26 @@ func init() {
         }
       }
     },
-    "/subnet/info": {
+    "/configs/{name}": {
       "get": {
         "tags": [
-          "Subnet"
+          "Configuration"
         ],
-        "summary": "Subnet info",
-        "operationId": "SubnetInfo",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/license"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/subnet/login": {
-      "post": {
-        "tags": [
-          "Subnet"
-        ],
-        "summary": "Login to subnet",
-        "operationId": "SubnetLogin",
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/subnetLoginRequest"
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/subnetLoginResponse"
+              "$ref": "#/definitions/configuration"
             }
           },
           "default": {
@@ -3761,22 +3894,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/subnet/login/mfa": {
-      "post": {
+      },
+      "put": {
         "tags": [
-          "Subnet"
+          "Configuration"
         ],
-        "summary": "Login to subnet using mfa",
-        "operationId": "SubnetLoginMFA",
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/subnetLoginMFARequest"
+              "$ref": "#/definitions/setConfigRequest"
             }
           }
         ],
@@ -3784,7 +3921,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/subnetLoginResponse"
+              "$ref": "#/definitions/setConfigResponse"
             }
           },
           "default": {
@@ -3796,71 +3933,26 @@ func init() {
         }
       }
     },
-    "/subnet/register": {
+    "/configs/{name}/reset": {
       "post": {
         "tags": [
-          "Subnet"
+          "Configuration"
         ],
-        "summary": "Register cluster with Subnet",
-        "operationId": "SubnetRegister",
+        "summary": "Configuration reset",
+        "operationId": "ResetConfig",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/subnetRegisterRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/subnet/registration-token": {
-      "get": {
-        "tags": [
-          "Subnet"
-        ],
-        "summary": "Subnet registraton token",
-        "operationId": "SubnetRegToken",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/SubnetRegTokenResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "/user/policy": {
-      "get": {
-        "tags": [
-          "Policy"
         ],
-        "summary": "returns policies for logged in user",
-        "operationId": "GetUserPolicy",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/setConfigResponse"
             }
           },
           "default": {
@@ -3872,13 +3964,13 @@ func init() {
         }
       }
     },
-    "/user/{name}": {
+    "/group/{name}": {
       "get": {
         "tags": [
-          "User"
+          "Group"
         ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
+        "summary": "Group info",
+        "operationId": "GroupInfo",
         "parameters": [
           {
             "type": "string",
@@ -3891,7 +3983,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -3904,10 +3996,10 @@ func init() {
       },
       "put": {
         "tags": [
-          "User"
+          "Group"
         ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
         "parameters": [
           {
             "type": "string",
@@ -3920,7 +4012,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateUser"
+              "$ref": "#/definitions/updateGroupRequest"
             }
           }
         ],
@@ -3928,7 +4020,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -3941,10 +4033,10 @@ func init() {
       },
       "delete": {
         "tags": [
-          "User"
+          "Group"
         ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
         "parameters": [
           {
             "type": "string",
@@ -3966,34 +4058,32 @@ func init() {
         }
       }
     },
-    "/user/{name}/groups": {
-      "put": {
+    "/groups": {
+      "get": {
         "tags": [
-          "User"
+          "Group"
         ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
+        "summary": "List Groups",
+        "operationId": "ListGroups",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUserGroups"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/listGroupsResponse"
             }
           },
           "default": {
@@ -4003,29 +4093,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/user/{name}/policies": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "Policy"
+          "Group"
         ],
-        "summary": "returns policies assigned for a specified user",
-        "operationId": "GetSAUserPolicy",
+        "summary": "Add Group",
+        "operationId": "AddGroup",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addGroupRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/aUserPolicyResponse"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -4036,34 +4123,28 @@ func init() {
         }
       }
     },
-    "/user/{name}/service-account-credentials": {
+    "/list-external-buckets": {
       "post": {
         "tags": [
-          "User"
+          "Bucket"
         ],
-        "summary": "Create Service Account for User With Credentials",
-        "operationId": "CreateServiceAccountCredentials",
+        "summary": "Lists an External list of buckets using custom credentials",
+        "operationId": "ListExternalBuckets",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequestCreds"
+              "$ref": "#/definitions/listExternalBucketsParams"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -4075,26 +4156,19 @@ func init() {
         }
       }
     },
-    "/user/{name}/service-accounts": {
+    "/login": {
       "get": {
+        "security": [],
         "tags": [
-          "User"
-        ],
-        "summary": "returns a list of service accounts for a user",
-        "operationId": "ListAUserServiceAccounts",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
+          "Auth"
         ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/loginDetails"
             }
           },
           "default": {
@@ -4106,33 +4180,76 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
-          "User"
+          "Auth"
         ],
-        "summary": "Create Service Account for User",
-        "operationId": "CreateAUserServiceAccount",
+        "summary": "Login to Console",
+        "operationId": "Login",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/loginOauth2AuthRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response.",
+          "204": {
+            "description": "A successful login."
+          },
+          "default": {
+            "description": "Generic error response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/logout": {
+      "post": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
+        "responses": {
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -4143,24 +4260,67 @@ func init() {
         }
       }
     },
-    "/users": {
+    "/logs/search": {
       "get": {
         "tags": [
-          "User"
+          "Logging"
         ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
+        "summary": "Search the logs",
+        "operationId": "LogSearch",
         "parameters": [
           {
-            "type": "integer",
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "type": "number",
             "format": "int32",
-            "name": "offset",
+            "default": 10,
+            "name": "pageSize",
             "in": "query"
           },
           {
-            "type": "integer",
+            "type": "number",
             "format": "int32",
-            "name": "limit",
+            "default": 0,
+            "name": "pageNo",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
+            "type": "string",
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "reqinfo",
+              "raw"
+            ],
+            "type": "string",
+            "default": "reqinfo",
+            "description": "Query type, request info or raw log entries",
+            "name": "q",
             "in": "query"
           }
         ],
@@ -4168,7 +4328,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listUsersResponse"
+              "$ref": "#/definitions/logSearchResponse"
             }
           },
           "default": {
@@ -4178,28 +4338,102 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/logs/search/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "User"
+          "Logging"
         ],
-        "summary": "Add User",
-        "operationId": "AddUser",
+        "summary": "Export the logs matching a search",
+        "operationId": "LogSearchExport",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
+            "type": "string",
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "reqinfo",
+              "raw"
+            ],
+            "type": "string",
+            "default": "reqinfo",
+            "description": "Query type, request info or raw log entries",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "csv",
+              "ndjson"
+            ],
+            "type": "string",
+            "default": "ndjson",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/addUserRequest"
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/nodes": {
+      "get": {
+        "tags": [
+          "System"
         ],
+        "summary": "Lists Nodes",
+        "operationId": "ListNodes",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -4211,26 +4445,33 @@ func init() {
         }
       }
     },
-    "/users-groups-bulk": {
-      "put": {
+    "/policies": {
+      "get": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -4239,33 +4480,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/users/service-accounts": {
+      },
       "post": {
         "tags": [
-          "User"
+          "Policy"
         ],
-        "summary": "Check number of service accounts for each user specified",
-        "operationId": "CheckUserServiceAccounts",
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
         "parameters": [
           {
-            "name": "selectedUsers",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/addPolicyRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/userServiceAccountSummary"
+              "$ref": "#/definitions/policy"
             }
           },
           "default": {
@@ -4276,3181 +4512,9758 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "SubnetRegTokenResponse": {
-      "type": "object",
-      "properties": {
-        "regToken": {
-          "type": "string"
-        }
-      }
     },
-    "aUserPolicyResponse": {
-      "type": "object",
-      "properties": {
-        "policy": {
-          "type": "string"
+    "/policies/lint": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Lint a policy before saving it",
+        "operationId": "LintPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policyLintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyLintResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "accessRule": {
-      "type": "object",
-      "properties": {
-        "access": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
+    "/policies/simulate": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Simulate whether a user or service account can run an action on a resource",
+        "operationId": "SimulatePolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policySimulationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policySimulationResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "accountChangePasswordRequest": {
-      "type": "object",
-      "required": [
-        "current_secret_key",
-        "new_secret_key"
-      ],
-      "properties": {
-        "current_secret_key": {
-          "type": "string"
-        },
-        "new_secret_key": {
-          "type": "string"
+    "/policies/{policy}/groups": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List Groups for a Policy",
+        "operationId": "ListGroupsForPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "addBucketLifecycle": {
-      "type": "object",
-      "properties": {
-        "disable": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
-        },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
-          "type": "string"
-        },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
-          "type": "string"
-        },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
-          "type": "string"
-        },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "type": {
-          "description": "ILM Rule type (Expiry or transition)",
-          "type": "string",
-          "enum": [
-            "expiry",
-            "transition"
-          ]
+    "/policies/{policy}/users": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List Users for a Policy",
+        "operationId": "ListUsersForPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "addBucketReplication": {
-      "type": "object",
-      "properties": {
-        "arn": {
-          "type": "string"
-        },
-        "destination_bucket": {
-          "type": "string"
+    "/policy-templates": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List the built-in and custom policy templates",
+        "operationId": "ListPolicyTemplates",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyTemplateList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Register a custom policy template",
+        "operationId": "CreatePolicyTemplate",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policyTemplate"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyTemplate"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "addGroupRequest": {
-      "type": "object",
-      "required": [
-        "group",
-        "members"
-      ],
-      "properties": {
-        "group": {
-          "type": "string"
-        },
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/policy-templates/{id}": {
+      "delete": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Delete a custom policy template",
+        "operationId": "DeletePolicyTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
-        }
-      }
-    },
-    "addMultiBucketLifecycle": {
-      "type": "object",
-      "required": [
-        "buckets",
-        "type"
-      ],
-      "properties": {
-        "buckets": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
-        },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
-          "type": "string"
-        },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
-          "type": "string"
-        },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
-          "type": "string"
-        },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "type": {
-          "description": "ILM Rule type (Expiry or transition)",
-          "type": "string",
-          "enum": [
-            "expiry",
-            "transition"
-          ]
-        }
-      }
-    },
-    "addPolicyRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "policy"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        }
-      }
-    },
-    "addServiceAccountPolicyRequest": {
-      "type": "object",
-      "required": [
-        "policy"
-      ],
-      "properties": {
-        "policy": {
-          "type": "string"
         }
       }
     },
-    "addUserRequest": {
-      "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey",
-        "groups",
-        "policies"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/policy-templates/{id}/render": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Render a policy template, optionally saving and attaching the policy",
+        "operationId": "RenderPolicyTemplate",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policyTemplateRenderRequest"
+            }
           }
-        },
-        "policies": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyTemplateRenderResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "secretKey": {
-          "type": "string"
         }
       }
     },
-    "adminInfoResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "integer"
-        },
-        "objects": {
-          "type": "integer"
-        },
-        "prometheusNotReady": {
-          "type": "boolean"
-        },
-        "servers": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/serverProperties"
+    "/policy/{name}": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        },
-        "usage": {
-          "type": "integer"
-        },
-        "widgets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widget"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "arnsResponse": {
-      "type": "object",
-      "properties": {
-        "arns": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "delete": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "bucket": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
-        },
-        "creation_date": {
-          "type": "string"
-        },
-        "definition": {
-          "type": "string"
-        },
-        "details": {
-          "type": "object",
-          "properties": {
-            "locking": {
-              "type": "boolean"
-            },
-            "quota": {
-              "type": "object",
-              "properties": {
-                "quota": {
-                  "type": "integer",
-                  "format": "int64"
-                },
-                "type": {
-                  "type": "string",
-                  "enum": [
-                    "hard"
-                  ]
-                }
-              }
-            },
-            "replication": {
-              "type": "boolean"
-            },
-            "tags": {
-              "type": "object",
-              "additionalProperties": {
-                "type": "string"
-              }
-            },
-            "versioning": {
-              "type": "boolean"
-            },
-            "versioningSuspended": {
-              "type": "boolean"
-            }
+    "/policy/{name}/history": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List the revisions of a policy",
+        "operationId": "ListPolicyRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        },
-        "name": {
-          "type": "string",
-          "minLength": 3
-        },
-        "objects": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "rw_access": {
-          "type": "object",
-          "properties": {
-            "read": {
-              "type": "boolean"
-            },
-            "write": {
-              "type": "boolean"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyHistory"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
         }
       }
     },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketEncryptionInfo": {
-      "type": "object",
-      "properties": {
-        "algorithm": {
-          "type": "string"
-        },
-        "kmsMasterKeyID": {
-          "type": "string"
+    "/policy/{name}/history/diff": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Compare two revisions of a policy",
+        "operationId": "DiffPolicyRevisions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "defaults to the latest revision",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyRevisionDiff"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketEncryptionRequest": {
-      "type": "object",
-      "properties": {
-        "encType": {
-          "$ref": "#/definitions/bucketEncryptionType"
-        },
-        "kmsKeyID": {
-          "type": "string"
+    "/policy/{name}/history/{revision}/rollback": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Restore a previous revision of a policy",
+        "operationId": "RollbackPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "revision",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyRevision"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketEncryptionType": {
-      "type": "string",
-      "default": "sse-s3",
-      "enum": [
-        "sse-s3",
-        "sse-kms"
-      ]
-    },
-    "bucketEventRequest": {
-      "type": "object",
-      "required": [
-        "configuration"
-      ],
-      "properties": {
-        "configuration": {
-          "$ref": "#/definitions/notificationConfig"
-        },
-        "ignoreExisting": {
-          "type": "boolean"
+    "/profiling/start": {
+      "post": {
+        "tags": [
+          "Profile"
+        ],
+        "summary": "Start recording profile data",
+        "operationId": "ProfilingStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/profilingStartRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/startProfilingList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketLifecycleResponse": {
-      "type": "object",
-      "properties": {
-        "lifecycle": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/objectBucketLifecycle"
+    "/profiling/stop": {
+      "post": {
+        "produces": [
+          "application/zip"
+        ],
+        "tags": [
+          "Profile"
+        ],
+        "summary": "Stop and download profile data",
+        "operationId": "ProfilingStop",
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "bucketObLockingResponse": {
-      "type": "object",
-      "properties": {
-        "object_locking_enabled": {
-          "type": "boolean"
-        }
-      }
-    },
-    "bucketObject": {
-      "type": "object",
-      "properties": {
-        "content_type": {
-          "type": "string"
-        },
-        "etag": {
-          "type": "string"
-        },
-        "expiration": {
-          "type": "string"
-        },
-        "expiration_rule_id": {
-          "type": "string"
-        },
-        "is_delete_marker": {
-          "type": "boolean"
-        },
-        "is_latest": {
-          "type": "boolean"
-        },
-        "last_modified": {
-          "type": "string"
-        },
-        "legal_hold_status": {
-          "type": "string"
-        },
-        "metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "name": {
-          "type": "string"
-        },
-        "retention_mode": {
-          "type": "string"
-        },
-        "retention_until_date": {
-          "type": "string"
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/remote-buckets": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Remote Buckets",
+        "operationId": "ListRemoteBuckets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listRemoteBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "user_metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Remote Bucket",
+        "operationId": "AddRemoteBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRemoteBucket"
+            }
           }
-        },
-        "user_tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "version_id": {
-          "type": "string"
         }
       }
     },
-    "bucketQuota": {
-      "type": "object",
-      "properties": {
-        "quota": {
-          "type": "integer"
-        },
-        "type": {
-          "type": "string",
-          "enum": [
-            "hard"
-          ]
+    "/remote-buckets/{name}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Remote Bucket Details",
+        "operationId": "RemoteBucketDetails",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/remoteBucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketReplicationDestination": {
-      "type": "object",
-      "properties": {
-        "bucket": {
-          "type": "string"
+    "/remote-buckets/{source-bucket-name}/{arn}": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Remote Bucket",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "source-bucket-name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketReplicationResponse": {
-      "type": "object",
-      "properties": {
-        "rules": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/bucketReplicationRule"
+    "/service-account-credentials": {
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Create Service Account With Credentials",
+        "operationId": "CreateServiceAccountCreds",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequestCreds"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "bucketReplicationRule": {
-      "type": "object",
-      "properties": {
-        "bandwidth": {
-          "type": "string"
-        },
-        "delete_marker_replication": {
-          "type": "boolean"
-        },
-        "deletes_replication": {
-          "type": "boolean"
-        },
-        "destination": {
-          "$ref": "#/definitions/bucketReplicationDestination"
-        },
-        "existingObjects": {
-          "type": "boolean"
-        },
-        "healthCheckPeriod": {
-          "type": "integer"
-        },
-        "id": {
-          "type": "string"
-        },
-        "metadata_replication": {
-          "type": "boolean"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "priority": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "Enabled",
-            "Disabled"
-          ]
-        },
-        "storageClass": {
-          "type": "string"
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "tags": {
-          "type": "string"
+    "/service-accounts": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketReplicationRuleList": {
-      "type": "object",
-      "properties": {
-        "rules": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/service-accounts/delete-multi": {
+      "delete": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Delete Multiple Service Accounts",
+        "operationId": "DeleteMultipleServiceAccounts",
+        "parameters": [
+          {
+            "name": "selectedSA",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "bucketVersioningResponse": {
-      "type": "object",
-      "properties": {
-        "is_versioned": {
-          "type": "boolean"
+    "/service-accounts/expiring": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List the Service Accounts expiring soon",
+        "operationId": "ListExpiringServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "168h",
+            "description": "include the service accounts expiring within this duration",
+            "name": "within",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountExpiryList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bulkUserGroups": {
-      "type": "object",
-      "required": [
-        "users",
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/service-accounts/stale": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List the Service Accounts not used recently",
+        "operationId": "ListStaleServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "720h",
+            "description": "include the service accounts not used within this duration",
+            "name": "unusedFor",
+            "in": "query"
           }
-        },
-        "users": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountUsageList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "changeUserPasswordRequest": {
-      "type": "object",
-      "required": [
-        "selectedUser",
-        "newSecretKey"
-      ],
-      "properties": {
-        "newSecretKey": {
-          "type": "string"
-        },
-        "selectedUser": {
-          "type": "string"
+    "/service-accounts/usage": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "List the usage of the user's Service Accounts",
+        "operationId": "ListUserServiceAccountsUsage",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountUsageList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "checkVersionResponse": {
-      "type": "object",
-      "properties": {
-        "current_version": {
-          "type": "string"
-        },
-        "latest_version": {
-          "type": "string"
+    "/service-accounts/{access_key}": {
+      "delete": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "configDescription": {
-      "type": "object",
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "key": {
-          "type": "string"
+    "/service-accounts/{access_key}/expiry": {
+      "put": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Set or remove the expiration of a Service Account",
+        "operationId": "SetServiceAccountExpiry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountExpiryRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountExpiry"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "configuration": {
-      "type": "object",
-      "properties": {
-        "key_values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configurationKV"
+    "/service-accounts/{access_key}/policy": {
+      "get": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Get Service Account Policy",
+        "operationId": "GetServiceAccountPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "type": "string"
         }
-      }
-    },
-    "configurationKV": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
+      },
+      "put": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Set Service Account Policy",
+        "operationId": "SetServiceAccountPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "policy",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addServiceAccountPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "createRemoteBucket": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "sourceBucket",
-        "targetBucket"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "region": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "sourceBucket": {
-          "type": "string"
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "targetBucket": {
-          "type": "string"
-        },
-        "targetURL": {
-          "type": "string"
+    "/service-accounts/{access_key}/rotate": {
+      "post": {
+        "tags": [
+          "ServiceAccount"
+        ],
+        "summary": "Rotate a Service Account into a new one, keeping the old credentials valid for a grace period",
+        "operationId": "RotateServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRotateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRotation"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "deleteFile": {
-      "type": "object",
-      "properties": {
-        "path": {
-          "type": "string"
-        },
-        "recursive": {
-          "type": "boolean"
-        },
-        "versionID": {
-          "type": "string"
+    "/service/restart": {
+      "post": {
+        "tags": [
+          "Service"
+        ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "error": {
-      "type": "object",
-      "required": [
-        "message",
-        "detailedMessage"
-      ],
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "detailedMessage": {
-          "type": "string"
-        },
-        "message": {
-          "type": "string"
+    "/session": {
+      "get": {
+        "tags": [
+          "Auth"
+        ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "expirationResponse": {
-      "type": "object",
-      "properties": {
-        "date": {
-          "type": "string"
-        },
-        "days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "delete_marker": {
-          "type": "boolean"
-        },
-        "noncurrent_expiration_days": {
-          "type": "integer",
-          "format": "int64"
+    "/set-policy": {
+      "put": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Set policy",
+        "operationId": "SetPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyNameRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "getBucketRetentionConfig": {
-      "type": "object",
-      "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
-        },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+    "/set-policy-multi": {
+      "put": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Set policy to multiple users/groups",
+        "operationId": "SetPolicyMultiple",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyMultipleNameRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "group": {
-      "type": "object",
-      "properties": {
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/subnet/info": {
+      "get": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Subnet info",
+        "operationId": "SubnetInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/license"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "iamEntity": {
-      "type": "string",
-      "pattern": "^[\\w+=,.@-]{1,64}$"
-    },
-    "iamPolicy": {
-      "type": "object",
-      "properties": {
-        "statement": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/iamPolicyStatement"
+    "/subnet/login": {
+      "post": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Login to subnet",
+        "operationId": "SubnetLogin",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetLoginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/subnetLoginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "version": {
-          "type": "string"
         }
       }
     },
-    "iamPolicyStatement": {
-      "type": "object",
-      "properties": {
-        "action": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "condition": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "object"
+    "/subnet/login/mfa": {
+      "post": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Login to subnet using mfa",
+        "operationId": "SubnetLoginMFA",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetLoginMFARequest"
+            }
           }
-        },
-        "effect": {
-          "type": "string"
-        },
-        "resource": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/subnetLoginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "license": {
-      "type": "object",
-      "properties": {
-        "account_id": {
-          "type": "integer"
-        },
-        "email": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string"
-        },
-        "organization": {
-          "type": "string"
-        },
-        "plan": {
-          "type": "string"
-        },
-        "storage_capacity": {
-          "type": "integer"
+    "/subnet/register": {
+      "post": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Register cluster with Subnet",
+        "operationId": "SubnetRegister",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetRegisterRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "lifecycleTag": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
+    "/subnet/registration-token": {
+      "get": {
+        "tags": [
+          "Subnet"
+        ],
+        "summary": "Subnet registraton token",
+        "operationId": "SubnetRegToken",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/SubnetRegTokenResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "listAccessRulesResponse": {
-      "type": "object",
-      "properties": {
-        "accessRules": {
-          "type": "array",
-          "title": "list of policies",
-          "items": {
-            "$ref": "#/definitions/accessRule"
+    "/user/policy": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "returns policies for logged in user",
+        "operationId": "GetUserPolicy",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
         }
       }
     },
-    "listBucketEventsResponse": {
-      "type": "object",
-      "properties": {
-        "events": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationConfig"
+    "/user/{name}": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUser"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of bucket events"
         }
       }
     },
-    "listBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of resulting buckets",
-          "items": {
-            "$ref": "#/definitions/bucket"
+    "/user/{name}/groups": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of buckets accessible to the user"
         }
       }
     },
-    "listConfigResponse": {
-      "type": "object",
-      "properties": {
-        "configurations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configDescription"
+    "/user/{name}/policies": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "returns policies assigned for a specified user",
+        "operationId": "GetSAUserPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/aUserPolicyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of configurations"
         }
       }
     },
-    "listExternalBucketsParams": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "useTLS"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "region": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "targetURL": {
-          "type": "string"
-        },
-        "useTLS": {
-          "type": "boolean"
+    "/user/{name}/service-account-credentials": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Create Service Account for User With Credentials",
+        "operationId": "CreateServiceAccountCredentials",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequestCreds"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "listGroupsResponse": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "title": "list of groups",
-          "items": {
-            "type": "string"
+    "/user/{name}/service-accounts": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "returns a list of service accounts for a user",
+        "operationId": "ListAUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of groups"
         }
-      }
-    },
-    "listObjectsResponse": {
-      "type": "object",
-      "properties": {
-        "objects": {
-          "type": "array",
-          "title": "list of resulting objects",
-          "items": {
-            "$ref": "#/definitions/bucketObject"
+      },
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Create Service Account for User",
+        "operationId": "CreateAUserServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of objects"
         }
       }
     },
-    "listPoliciesResponse": {
-      "type": "object",
-      "properties": {
-        "policies": {
-          "type": "array",
-          "title": "list of policies",
-          "items": {
-            "$ref": "#/definitions/policy"
+    "/user/{name}/service-accounts/usage": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "returns the usage of the service accounts of a user",
+        "operationId": "ListAUserServiceAccountsUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountUsageList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
         }
       }
     },
-    "listRemoteBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of remote buckets",
-          "items": {
-            "$ref": "#/definitions/remoteBucket"
+    "/users": {
+      "get": {
+        "tags": [
+          "User"
+        ],
+        "summary": "List Users",
+        "operationId": "ListUsers",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Add User",
+        "operationId": "AddUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of remote buckets accessible to user"
         }
       }
     },
-    "listUsersResponse": {
-      "type": "object",
-      "properties": {
-        "users": {
-          "type": "array",
-          "title": "list of resulting users",
-          "items": {
-            "$ref": "#/definitions/user"
+    "/users-groups-bulk": {
+      "put": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "logSearchResponse": {
-      "type": "object",
-      "properties": {
-        "results": {
-          "type": "object",
-          "title": "list of log search responses"
+    "/users/import": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Create or update users in bulk from CSV or JSON",
+        "operationId": "ImportUsers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/usersImportRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/usersImportResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "loginDetails": {
+    "/users/import/secrets/{id}": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "User"
+        ],
+        "summary": "Download once the secret keys generated by a bulk import",
+        "operationId": "DownloadImportedUsersSecrets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/service-accounts": {
+      "post": {
+        "tags": [
+          "User"
+        ],
+        "summary": "Check number of service accounts for each user specified",
+        "operationId": "CheckUserServiceAccounts",
+        "parameters": [
+          {
+            "name": "selectedUsers",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/userServiceAccountSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "SubnetRegTokenResponse": {
       "type": "object",
       "properties": {
-        "loginStrategy": {
-          "type": "string",
-          "enum": [
-            "form",
-            "redirect",
-            "service-account",
-            "redirect-service-account"
-          ]
-        },
-        "redirect": {
+        "regToken": {
           "type": "string"
         }
       }
     },
-    "loginOauth2AuthRequest": {
+    "aUserPolicyResponse": {
       "type": "object",
-      "required": [
-        "state",
-        "code"
-      ],
       "properties": {
-        "code": {
-          "type": "string"
-        },
-        "state": {
+        "policy": {
           "type": "string"
         }
       }
     },
-    "loginRequest": {
+    "accessGrant": {
       "type": "object",
       "properties": {
-        "accessKey": {
+        "alreadyAttached": {
+          "description": "the policy was attached before the grant, so it is kept when the grant ends",
+          "type": "boolean"
+        },
+        "approver": {
           "type": "string"
         },
-        "features": {
-          "type": "object",
-          "properties": {
-            "hide_menu": {
-              "type": "boolean"
-            }
-          }
+        "endedAt": {
+          "description": "when the policy was detached",
+          "type": "string"
         },
-        "secretKey": {
+        "entity": {
           "type": "string"
         },
-        "sts": {
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
+        },
+        "error": {
+          "description": "last error detaching the policy, retried by the scheduler",
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "grantedAt": {
+          "type": "string"
+        },
+        "grantedBy": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "revokedBy": {
           "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "active",
+            "expired",
+            "revoked"
+          ]
         }
       }
     },
-    "loginResponse": {
+    "accessGrantList": {
       "type": "object",
       "properties": {
-        "sessionId": {
-          "type": "string"
+        "grants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/accessGrant"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "makeBucketRequest": {
+    "accessGrantRequest": {
       "type": "object",
       "required": [
-        "name"
+        "policy",
+        "entityType",
+        "entity",
+        "duration",
+        "reason",
+        "approver"
       ],
       "properties": {
-        "locking": {
-          "type": "boolean"
+        "approver": {
+          "type": "string"
         },
-        "name": {
+        "duration": {
+          "description": "how long the policy stays attached, as a duration like 4h or 30m",
           "type": "string"
         },
-        "quota": {
-          "$ref": "#/definitions/setBucketQuota"
+        "entity": {
+          "type": "string"
         },
-        "retention": {
-          "$ref": "#/definitions/putBucketRetentionRequest"
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
         },
-        "versioning": {
-          "type": "boolean"
+        "policy": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
         }
       }
     },
-    "metadata": {
+    "accessRule": {
       "type": "object",
       "properties": {
-        "objectMetadata": {
-          "type": "object",
-          "additionalProperties": true
+        "access": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
         }
       }
     },
-    "multiBucketReplication": {
+    "accountChangePasswordRequest": {
+      "type": "object",
       "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "bucketsRelation"
+        "current_secret_key",
+        "new_secret_key"
       ],
       "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
+        "current_secret_key": {
+          "type": "string"
         },
-        "bucketsRelation": {
-          "type": "array",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/multiBucketsRelation"
-          }
+        "new_secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "addBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "disable": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
         },
-        "healthCheckPeriod": {
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
           "type": "integer",
-          "format": "int32"
+          "format": "int32",
+          "default": 0
         },
-        "prefix": {
-          "type": "string"
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
         },
-        "priority": {
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
           "type": "integer",
           "format": "int32",
           "default": 0
         },
-        "region": {
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
           "type": "string"
         },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
         },
-        "replicateMetadata": {
-          "type": "boolean"
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
         },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
+        "tags": {
+          "description": "Non required field, tags to match ILM files",
+          "type": "string"
         },
-        "storageClass": {
-          "type": "string",
-          "default": ""
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
         },
-        "syncMode": {
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
           "type": "string",
-          "default": "async",
           "enum": [
-            "async",
-            "sync"
+            "expiry",
+            "transition"
           ]
-        },
-        "tags": {
+        }
+      }
+    },
+    "addBucketReplication": {
+      "type": "object",
+      "properties": {
+        "arn": {
           "type": "string"
         },
-        "targetURL": {
+        "destination_bucket": {
           "type": "string"
         }
       }
     },
-    "multiBucketReplicationEdit": {
+    "addGroupRequest": {
+      "type": "object",
+      "required": [
+        "group",
+        "members"
+      ],
       "properties": {
-        "arn": {
+        "group": {
           "type": "string"
         },
-        "prefix": {
-          "type": "string"
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "addMultiBucketLifecycle": {
+      "type": "object",
+      "required": [
+        "buckets",
+        "type"
+      ],
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "priority": {
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
           "type": "integer",
           "format": "int32",
           "default": 0
         },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
         },
-        "replicateExistingObjects": {
-          "type": "boolean"
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
         },
-        "replicateMetadata": {
-          "type": "boolean"
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
         },
-        "ruleState": {
-          "type": "boolean"
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
         },
-        "storageClass": {
-          "type": "string",
-          "default": ""
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
         },
         "tags": {
+          "description": "Non required field, tags to match ILM files",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
           "type": "string",
-          "default": ""
+          "enum": [
+            "expiry",
+            "transition"
+          ]
         }
       }
     },
-    "multiBucketResponseItem": {
+    "addPolicyRequest": {
       "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
       "properties": {
-        "errorString": {
-          "type": "string"
-        },
-        "originBucket": {
+        "name": {
           "type": "string"
         },
-        "targetBucket": {
+        "policy": {
           "type": "string"
         }
       }
     },
-    "multiBucketResponseState": {
+    "addServiceAccountPolicyRequest": {
       "type": "object",
+      "required": [
+        "policy"
+      ],
       "properties": {
-        "replicationState": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/multiBucketResponseItem"
-          }
+        "policy": {
+          "type": "string"
         }
       }
     },
-    "multiBucketsRelation": {
+    "addUserRequest": {
       "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey",
+        "groups",
+        "policies"
+      ],
       "properties": {
-        "destinationBucket": {
+        "accessKey": {
           "type": "string"
         },
-        "originBucket": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
           "type": "string"
         }
       }
     },
-    "multiLifecycleResult": {
+    "adminInfoResponse": {
+      "type": "object",
       "properties": {
-        "results": {
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "prometheusNotReady": {
+          "type": "boolean"
+        },
+        "servers": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/multicycleResultItem"
+            "$ref": "#/definitions/serverProperties"
+          }
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
           }
         }
       }
     },
-    "multicycleResultItem": {
+    "alert": {
       "type": "object",
       "properties": {
-        "bucketName": {
+        "acknowledged": {
+          "type": "boolean"
+        },
+        "acknowledgedAt": {
           "type": "string"
         },
-        "error": {
+        "acknowledgedBy": {
           "type": "string"
-        }
-      }
-    },
-    "nofiticationService": {
-      "type": "string",
-      "enum": [
-        "webhook",
-        "amqp",
-        "kafka",
-        "mqtt",
-        "nats",
-        "nsq",
-        "mysql",
-        "postgres",
-        "elasticsearch",
-        "redis"
-      ]
-    },
-    "notifEndpointResponse": {
-      "type": "object",
-      "properties": {
-        "notification_endpoints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationEndpointItem"
-          }
-        }
-      }
-    },
-    "notificationConfig": {
-      "type": "object",
-      "required": [
-        "arn"
-      ],
-      "properties": {
-        "arn": {
+        },
+        "id": {
           "type": "string"
         },
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
-          }
+        "notifiedAt": {
+          "type": "string"
         },
-        "id": {
+        "resolvedAt": {
           "type": "string"
         },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
+        "ruleId": {
+          "type": "string"
         },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
-        }
-      }
-    },
-    "notificationDeleteRequest": {
-      "type": "object",
-      "required": [
-        "events",
-        "prefix",
-        "suffix"
-      ],
-      "properties": {
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
-          }
+        "ruleName": {
+          "type": "string"
         },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
+        "severity": {
+          "type": "string"
         },
-        "suffix": {
+        "startedAt": {
+          "type": "string"
+        },
+        "status": {
           "type": "string",
-          "title": "filter event associated to the specified suffix"
+          "enum": [
+            "firing",
+            "resolved"
+          ]
+        },
+        "summary": {
+          "type": "string"
+        },
+        "value": {
+          "type": "number"
         }
       }
     },
-    "notificationEndpoint": {
+    "alertChannel": {
       "type": "object",
       "required": [
-        "service",
-        "account_id",
-        "properties"
+        "name",
+        "type"
       ],
       "properties": {
-        "account_id": {
+        "name": {
           "type": "string"
         },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
+        "recipients": {
+          "description": "addresses of email channels",
+          "type": "array",
+          "items": {
             "type": "string"
           }
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
-        }
-      }
-    },
-    "notificationEndpointItem": {
-      "type": "object",
-      "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "email",
+            "slack"
+          ]
         },
-        "status": {
+        "url": {
+          "description": "endpoint of webhook and slack channels",
           "type": "string"
         }
       }
     },
-    "notificationEventType": {
-      "type": "string",
-      "enum": [
-        "put",
-        "delete",
-        "get"
-      ]
-    },
-    "objectBucketLifecycle": {
+    "alertChannelList": {
       "type": "object",
       "properties": {
-        "expiration": {
-          "$ref": "#/definitions/expirationResponse"
-        },
-        "id": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        },
-        "tags": {
+        "channels": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/lifecycleTag"
+            "$ref": "#/definitions/alertChannel"
           }
-        },
-        "transition": {
-          "$ref": "#/definitions/transitionResponse"
         }
       }
     },
-    "objectLegalHoldStatus": {
-      "type": "string",
-      "enum": [
-        "enabled",
-        "disabled"
-      ]
-    },
-    "objectRetentionMode": {
-      "type": "string",
-      "enum": [
-        "governance",
-        "compliance"
-      ]
-    },
-    "objectRetentionUnit": {
-      "type": "string",
-      "enum": [
-        "days",
-        "years"
-      ]
-    },
-    "peerInfo": {
+    "alertList": {
       "type": "object",
       "properties": {
-        "deploymentID": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
+        "alerts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alert"
+          }
         },
-        "name": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "peerInfoRemove": {
+    "alertRule": {
       "type": "object",
       "required": [
-        "sites"
+        "name",
+        "type"
       ],
       "properties": {
-        "all": {
-          "type": "boolean"
-        },
-        "sites": {
+        "channels": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        }
-      }
-    },
-    "peerSite": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
         },
-        "endpoint": {
+        "disabled": {
+          "type": "boolean"
+        },
+        "id": {
           "type": "string"
         },
         "name": {
           "type": "string"
         },
-        "secretKey": {
+        "query": {
+          "description": "PromQL expression of prometheus rules, the rule fires when it returns any series",
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "silenceComment": {
+          "type": "string"
+        },
+        "silencedUntil": {
           "type": "string"
+        },
+        "threshold": {
+          "description": "the rule fires when the value is over the threshold, capacity usage is a percentage",
+          "type": "number"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "offlineServers",
+            "offlineDrives",
+            "healingDrives",
+            "capacityUsage",
+            "prometheus"
+          ]
         }
       }
     },
-    "peerSiteEditResponse": {
+    "alertRuleList": {
       "type": "object",
       "properties": {
-        "errorDetail": {
-          "type": "string"
-        },
-        "status": {
+        "interval": {
+          "description": "evaluation interval, empty when the alert engine is disabled",
           "type": "string"
         },
-        "success": {
-          "type": "boolean"
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
+          }
         }
       }
     },
-    "peerSiteRemoveResponse": {
+    "alertSilence": {
       "type": "object",
+      "required": [
+        "duration"
+      ],
       "properties": {
-        "errorDetail": {
+        "comment": {
           "type": "string"
         },
-        "status": {
+        "duration": {
+          "description": "how long the notifications are silenced, e.g. 2h",
           "type": "string"
         }
       }
     },
-    "permissionResource": {
+    "arnsResponse": {
       "type": "object",
       "properties": {
-        "conditionOperator": {
-          "type": "string"
-        },
-        "prefixes": {
+        "arns": {
           "type": "array",
           "items": {
             "type": "string"
           }
-        },
-        "resource": {
-          "type": "string"
         }
       }
     },
-    "policy": {
+    "bucket": {
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "name": {
-          "type": "string"
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
         },
-        "policy": {
-          "type": "string"
-        }
-      }
-    },
-    "policyArgs": {
-      "type": "object",
-      "properties": {
-        "action": {
+        "creation_date": {
           "type": "string"
         },
-        "bucket_name": {
+        "definition": {
           "type": "string"
         },
-        "id": {
-          "type": "string"
+        "details": {
+          "type": "object",
+          "properties": {
+            "locking": {
+              "type": "boolean"
+            },
+            "quota": {
+              "type": "object",
+              "properties": {
+                "quota": {
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "type": {
+                  "type": "string",
+                  "enum": [
+                    "hard"
+                  ]
+                }
+              }
+            },
+            "replication": {
+              "type": "boolean"
+            },
+            "tags": {
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            },
+            "versioning": {
+              "type": "boolean"
+            },
+            "versioningSuspended": {
+              "type": "boolean"
+            }
+          }
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rw_access": {
+          "type": "object",
+          "properties": {
+            "read": {
+              "type": "boolean"
+            },
+            "write": {
+              "type": "boolean"
+            }
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "policyEntity": {
+    "bucketAccess": {
       "type": "string",
-      "default": "user",
+      "default": "PRIVATE",
       "enum": [
-        "user",
-        "group"
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
       ]
     },
-    "prefixAccessPair": {
+    "bucketEncryptionInfo": {
       "type": "object",
       "properties": {
-        "access": {
+        "algorithm": {
           "type": "string"
         },
-        "prefix": {
+        "kmsMasterKeyID": {
           "type": "string"
         }
       }
     },
-    "prefixWrapper": {
+    "bucketEncryptionRequest": {
       "type": "object",
       "properties": {
-        "prefix": {
+        "encType": {
+          "$ref": "#/definitions/bucketEncryptionType"
+        },
+        "kmsKeyID": {
           "type": "string"
         }
       }
     },
-    "principal": {
+    "bucketEncryptionType": {
+      "type": "string",
+      "default": "sse-s3",
+      "enum": [
+        "sse-s3",
+        "sse-kms"
+      ]
+    },
+    "bucketEventRequest": {
       "type": "object",
+      "required": [
+        "configuration"
+      ],
       "properties": {
-        "STSAccessKeyID": {
-          "type": "string"
-        },
-        "STSSecretAccessKey": {
-          "type": "string"
-        },
-        "STSSessionToken": {
-          "type": "string"
-        },
-        "accountAccessKey": {
-          "type": "string"
-        },
-        "hm": {
-          "type": "boolean"
+        "configuration": {
+          "$ref": "#/definitions/notificationConfig"
         },
-        "ob": {
+        "ignoreExisting": {
           "type": "boolean"
         }
       }
     },
-    "profilingStartRequest": {
+    "bucketLifecycleResponse": {
       "type": "object",
-      "required": [
-        "type"
-      ],
       "properties": {
-        "type": {
-          "type": "string"
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectBucketLifecycle"
+          }
         }
       }
     },
-    "putBucketRetentionRequest": {
+    "bucketObLockingResponse": {
       "type": "object",
-      "required": [
-        "mode",
-        "unit",
-        "validity"
-      ],
       "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
-        },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+        "object_locking_enabled": {
+          "type": "boolean"
         }
       }
     },
-    "putBucketTagsRequest": {
+    "bucketObject": {
       "type": "object",
       "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "expiration_rule_id": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
         "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_tags": {
+          "type": "object",
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "putObjectLegalHoldRequest": {
+    "bucketQuota": {
       "type": "object",
-      "required": [
-        "status"
-      ],
       "properties": {
-        "status": {
-          "$ref": "#/definitions/objectLegalHoldStatus"
+        "quota": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
         }
       }
     },
-    "putObjectRetentionRequest": {
+    "bucketReplicationDestination": {
       "type": "object",
-      "required": [
-        "mode",
-        "expires"
-      ],
       "properties": {
-        "expires": {
+        "bucket": {
           "type": "string"
-        },
-        "governance_bypass": {
-          "type": "boolean"
-        },
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
         }
       }
     },
-    "putObjectTagsRequest": {
+    "bucketReplicationResponse": {
       "type": "object",
       "properties": {
-        "tags": {
-          "additionalProperties": {
-            "type": "string"
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
           }
         }
       }
     },
-    "remoteBucket": {
+    "bucketReplicationRule": {
       "type": "object",
-      "required": [
-        "accessKey",
-        "sourceBucket",
-        "remoteARN"
-      ],
       "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
         "bandwidth": {
-          "type": "integer",
-          "format": "int64"
+          "type": "string"
+        },
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "destination": {
+          "$ref": "#/definitions/bucketReplicationDestination"
+        },
+        "existingObjects": {
+          "type": "boolean"
         },
         "healthCheckPeriod": {
           "type": "integer"
         },
-        "remoteARN": {
+        "id": {
           "type": "string"
         },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
+        "metadata_replication": {
+          "type": "boolean"
         },
-        "service": {
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
           "type": "string",
           "enum": [
-            "replication"
+            "Enabled",
+            "Disabled"
           ]
         },
-        "sourceBucket": {
-          "type": "string"
-        },
-        "status": {
+        "storageClass": {
           "type": "string"
         },
         "syncMode": {
-          "type": "string"
-        },
-        "targetBucket": {
-          "type": "string"
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
         },
-        "targetURL": {
+        "tags": {
           "type": "string"
         }
       }
     },
-    "resultTarget": {
+    "bucketReplicationRuleList": {
       "type": "object",
       "properties": {
-        "legendFormat": {
-          "type": "string"
-        },
-        "result": {
+        "rules": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/widgetResult"
+            "type": "string"
           }
-        },
-        "resultType": {
-          "type": "string"
         }
       }
     },
-    "rewindItem": {
+    "bucketVersioningResponse": {
       "type": "object",
       "properties": {
-        "action": {
-          "type": "string"
-        },
-        "delete_flag": {
+        "is_versioned": {
           "type": "boolean"
-        },
-        "last_modified": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "version_id": {
-          "type": "string"
         }
       }
     },
-    "rewindResponse": {
+    "bulkUserGroups": {
       "type": "object",
+      "required": [
+        "users",
+        "groups"
+      ],
       "properties": {
-        "objects": {
+        "groups": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/rewindItem"
+            "type": "string"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "serverDrives": {
+    "capacityForecast": {
       "type": "object",
       "properties": {
-        "availableSpace": {
-          "type": "integer"
-        },
-        "drivePath": {
-          "type": "string"
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityTrend"
+          }
         },
-        "endpoint": {
-          "type": "string"
+        "cluster": {
+          "$ref": "#/definitions/capacityTrend"
         },
-        "healing": {
-          "type": "boolean"
+        "days": {
+          "type": "integer",
+          "format": "int32"
         },
-        "model": {
+        "generatedAt": {
           "type": "string"
         },
-        "rootDisk": {
-          "type": "boolean"
-        },
-        "state": {
+        "interval": {
+          "description": "interval of the usage samples, empty when the sampling is disabled",
           "type": "string"
         },
-        "totalSpace": {
-          "type": "integer"
-        },
-        "usedSpace": {
-          "type": "integer"
-        },
-        "uuid": {
-          "type": "string"
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityTrend"
+          }
         }
       }
     },
-    "serverProperties": {
+    "capacityTrend": {
       "type": "object",
       "properties": {
-        "commitID": {
-          "type": "string"
+        "capacity": {
+          "description": "usable capacity of pools, the raw drive space less the standard parity of their erasure sets, or hard quota of buckets, 0 when unlimited",
+          "type": "integer",
+          "format": "int64"
         },
-        "drives": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/serverDrives"
-          }
+        "daysUntilFull": {
+          "type": "number"
         },
-        "endpoint": {
-          "type": "string"
+        "fit": {
+          "description": "coefficient of determination of the fitted trend, from 0 to 1",
+          "type": "number"
         },
-        "network": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "fullDate": {
+          "type": "string"
         },
-        "poolNumber": {
-          "type": "integer"
+        "growthPerDay": {
+          "description": "bytes per day of the fitted linear trend",
+          "type": "number"
         },
-        "state": {
+        "name": {
           "type": "string"
         },
-        "uptime": {
-          "type": "integer"
+        "samples": {
+          "type": "integer",
+          "format": "int64"
         },
-        "version": {
-          "type": "string"
+        "trend": {
+          "type": "string",
+          "enum": [
+            "growing",
+            "stable",
+            "shrinking",
+            "insufficientData"
+          ]
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "serviceAccountCreds": {
+    "changeUserPasswordRequest": {
       "type": "object",
+      "required": [
+        "selectedUser",
+        "newSecretKey"
+      ],
       "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "secretKey": {
+        "newSecretKey": {
           "type": "string"
         },
-        "url": {
+        "selectedUser": {
           "type": "string"
         }
       }
     },
-    "serviceAccountRequest": {
+    "checkVersionResponse": {
       "type": "object",
       "properties": {
-        "policy": {
-          "type": "string",
-          "title": "policy to be applied to the Service Account if any"
+        "current_version": {
+          "type": "string"
+        },
+        "latest_version": {
+          "type": "string"
         }
       }
     },
-    "serviceAccountRequestCreds": {
+    "configDescription": {
       "type": "object",
       "properties": {
-        "accessKey": {
+        "description": {
           "type": "string"
         },
-        "policy": {
-          "type": "string",
-          "title": "policy to be applied to the Service Account if any"
-        },
-        "secretKey": {
+        "key": {
           "type": "string"
         }
       }
     },
-    "serviceAccounts": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      }
-    },
-    "sessionResponse": {
+    "configuration": {
       "type": "object",
       "properties": {
-        "allowResources": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/permissionResource"
-          }
-        },
-        "distributedMode": {
-          "type": "boolean"
-        },
-        "features": {
+        "key_values": {
           "type": "array",
           "items": {
-            "type": "string"
-          }
-        },
-        "operator": {
-          "type": "boolean"
-        },
-        "permissions": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "array",
-            "items": {
-              "type": "string"
-            }
+            "$ref": "#/definitions/configurationKV"
           }
         },
-        "status": {
-          "type": "string",
-          "enum": [
-            "ok"
-          ]
+        "name": {
+          "type": "string"
         }
       }
     },
-    "setBucketPolicyRequest": {
+    "configurationKV": {
       "type": "object",
-      "required": [
-        "access"
-      ],
       "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
+        "key": {
+          "type": "string"
         },
-        "definition": {
+        "value": {
           "type": "string"
         }
       }
     },
-    "setBucketQuota": {
-      "type": "object",
+    "createRemoteBucket": {
       "required": [
-        "enabled"
-      ],
-      "properties": {
-        "amount": {
-          "type": "integer"
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "sourceBucket",
+        "targetBucket"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
         },
-        "enabled": {
-          "type": "boolean"
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
         },
-        "quota_type": {
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretKey": {
           "type": "string",
+          "minLength": 8
+        },
+        "sourceBucket": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
           "enum": [
-            "hard"
+            "async",
+            "sync"
           ]
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
         }
       }
     },
-    "setBucketVersioning": {
+    "dashboardExport": {
       "type": "object",
       "properties": {
-        "versioning": {
-          "type": "boolean"
+        "version": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
+          }
         }
       }
     },
-    "setConfigRequest": {
+    "dashboardWidget": {
       "type": "object",
       "required": [
-        "key_values"
+        "title",
+        "type",
+        "targets"
       ],
       "properties": {
-        "arn_resource_id": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "gridPos": {
+          "$ref": "#/definitions/dashboardWidgetGridPos"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "maxDataPoints": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "owner": {
+          "type": "string"
+        },
+        "position": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "scope": {
           "type": "string",
-          "title": "Used if configuration is an event notification's target"
+          "enum": [
+            "user",
+            "global"
+          ]
         },
-        "key_values": {
+        "targets": {
           "type": "array",
-          "minItems": 1,
           "items": {
-            "$ref": "#/definitions/configurationKV"
+            "$ref": "#/definitions/dashboardWidgetTarget"
           }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "updated": {
+          "type": "string"
         }
       }
     },
-    "setConfigResponse": {
-      "type": "object",
-      "properties": {
-        "restart": {
-          "description": "Returns wheter server needs to restart to apply changes or not",
-          "type": "boolean"
-        }
-      }
-    },
-    "setNotificationEndpointResponse": {
+    "dashboardWidgetGridPos": {
       "type": "object",
-      "required": [
-        "service",
-        "account_id",
-        "properties"
-      ],
       "properties": {
-        "account_id": {
-          "type": "string"
+        "h": {
+          "type": "integer",
+          "format": "int32"
         },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "w": {
+          "type": "integer",
+          "format": "int32"
         },
-        "restart": {
-          "type": "boolean"
+        "x": {
+          "type": "integer",
+          "format": "int32"
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
+        "y": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "setPolicyMultipleNameRequest": {
+    "dashboardWidgetList": {
       "type": "object",
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/iamEntity"
-          }
-        },
-        "name": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "users": {
+        "widgets": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/iamEntity"
+            "$ref": "#/definitions/dashboardWidget"
           }
         }
       }
     },
-    "setPolicyNameRequest": {
+    "dashboardWidgetTarget": {
       "type": "object",
       "required": [
-        "name",
-        "entityType",
-        "entityName"
+        "expr"
       ],
       "properties": {
-        "entityName": {
+        "expr": {
+          "description": "PromQL expression, $__query is replaced by the job and extra labels selector",
           "type": "string"
         },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
+        "initialTime": {
+          "description": "start of the default range in minutes, relative to now",
+          "type": "integer",
+          "format": "int64"
         },
-        "name": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "legendFormat": {
+          "type": "string"
+        },
+        "step": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "setPolicyRequest": {
+    "dashboardWidgetsOrder": {
       "type": "object",
       "required": [
-        "entityType",
-        "entityName"
+        "scope",
+        "ids"
       ],
       "properties": {
-        "entityName": {
-          "type": "string"
+        "ids": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
         },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
+        "scope": {
+          "type": "string",
+          "enum": [
+            "user",
+            "global"
+          ]
         }
       }
     },
-    "siteReplicationAddRequest": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/peerSite"
-      }
-    },
-    "siteReplicationAddResponse": {
+    "deleteFile": {
       "type": "object",
       "properties": {
-        "errorDetail": {
+        "path": {
           "type": "string"
         },
-        "initialSyncErrorMessage": {
-          "type": "string"
+        "recursive": {
+          "type": "boolean"
         },
-        "status": {
+        "versionID": {
           "type": "string"
-        },
-        "success": {
-          "type": "boolean"
         }
       }
     },
-    "siteReplicationInfoResponse": {
+    "effectivePermission": {
       "type": "object",
       "properties": {
-        "enabled": {
-          "type": "boolean"
+        "allowedActions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
+        "bucket": {
           "type": "string"
         },
-        "serviceAccountAccessKey": {
-          "type": "string"
+        "conditional": {
+          "description": "some statements on the resource only apply under conditions",
+          "type": "boolean"
         },
-        "sites": {
+        "deniedActions": {
+          "description": "actions allowed by some statement but removed by a Deny statement",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/peerInfo"
+            "type": "string"
           }
-        }
-      }
-    },
-    "siteReplicationStatusResponse": {
-      "type": "object",
-      "properties": {
-        "bucketStats": {
-          "type": "object"
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "groupStats": {
-          "type": "object"
-        },
-        "maxBuckets": {
-          "type": "integer"
-        },
-        "maxGroups": {
-          "type": "integer"
         },
-        "maxPolicies": {
-          "type": "integer"
+        "entity": {
+          "type": "string"
         },
-        "maxUsers": {
-          "type": "integer"
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount"
+          ]
         },
-        "policyStats": {
-          "type": "object"
+        "parentUser": {
+          "type": "string"
         },
-        "sites": {
-          "type": "object"
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "statsSummary": {
-          "type": "object"
+        "resource": {
+          "description": "bucket or bucket/prefix pattern, empty for admin actions",
+          "type": "string"
         },
-        "userStats": {
-          "type": "object"
+        "scope": {
+          "type": "string",
+          "enum": [
+            "admin",
+            "bucket",
+            "object"
+          ]
         }
       }
     },
-    "startProfilingItem": {
+    "effectivePermissionsReport": {
       "type": "object",
       "properties": {
-        "error": {
+        "generatedAt": {
           "type": "string"
         },
-        "nodeName": {
-          "type": "string"
+        "permissions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/effectivePermission"
+          }
         },
-        "success": {
-          "type": "boolean"
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "startProfilingList": {
+    "erasureTopology": {
       "type": "object",
       "properties": {
-        "startResults": {
+        "degradedSets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pools": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/startProfilingItem"
+            "$ref": "#/definitions/topologyPool"
           }
         },
-        "total": {
+        "reducedParity": {
           "type": "integer",
-          "format": "int64",
-          "title": "number of start results"
+          "format": "int32"
+        },
+        "standardParity": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "unassignedDrives": {
+          "description": "drives not assigned to an erasure set, usually offline",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyDrive"
+          }
+        },
+        "unavailableSets": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "subnetLoginMFARequest": {
+    "error": {
       "type": "object",
       "required": [
-        "username",
-        "otp",
-        "mfa_token"
+        "message",
+        "detailedMessage"
       ],
       "properties": {
-        "mfa_token": {
-          "type": "string"
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "otp": {
+        "detailedMessage": {
           "type": "string"
         },
-        "username": {
+        "message": {
           "type": "string"
         }
       }
     },
-    "subnetLoginRequest": {
+    "expirationResponse": {
       "type": "object",
       "properties": {
-        "apiKey": {
+        "date": {
           "type": "string"
         },
-        "password": {
-          "type": "string"
+        "days": {
+          "type": "integer",
+          "format": "int64"
         },
-        "username": {
-          "type": "string"
+        "delete_marker": {
+          "type": "boolean"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "subnetLoginResponse": {
+    "getBucketRetentionConfig": {
       "type": "object",
       "properties": {
-        "access_token": {
-          "type": "string"
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
         },
-        "mfa_token": {
-          "type": "string"
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
         },
-        "organizations": {
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/subnetOrganization"
+            "type": "string"
           }
         },
-        "registered": {
-          "type": "boolean"
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
         }
       }
     },
-    "subnetOrganization": {
+    "healJob": {
       "type": "object",
       "properties": {
-        "accountId": {
-          "type": "integer"
+        "bucket": {
+          "type": "string"
         },
-        "company": {
+        "bytesScanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "clientToken": {
           "type": "string"
         },
-        "isAccountOwner": {
-          "type": "boolean"
+        "endTime": {
+          "type": "string"
         },
-        "shortName": {
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failureDetail": {
           "type": "string"
         },
-        "subscriptionStatus": {
+        "healthAfterCols": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "healthBeforeCols": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "itemsHealed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "itemsScanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsHealed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsScanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "owner": {
+          "description": "access key of the session that started the job, only it can reattach to the job",
           "type": "string"
         },
-        "userId": {
-          "type": "integer"
+        "prefix": {
+          "type": "string"
+        },
+        "startTime": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "running",
+            "finished",
+            "stopped",
+            "failed"
+          ]
         }
       }
     },
-    "subnetRegisterRequest": {
+    "healthReport": {
       "type": "object",
-      "required": [
-        "token",
-        "account_id"
-      ],
       "properties": {
-        "account_id": {
+        "id": {
           "type": "string"
         },
-        "token": {
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "time": {
           "type": "string"
         }
       }
     },
-    "tier": {
+    "healthReportChange": {
       "type": "object",
       "properties": {
-        "azure": {
-          "type": "object",
-          "$ref": "#/definitions/tier_azure"
+        "after": {
+          "type": "string"
         },
-        "gcs": {
-          "type": "object",
-          "$ref": "#/definitions/tier_gcs"
+        "before": {
+          "type": "string"
         },
-        "s3": {
-          "type": "object",
-          "$ref": "#/definitions/tier_s3"
+        "category": {
+          "type": "string",
+          "enum": [
+            "server",
+            "drive",
+            "config"
+          ]
         },
-        "type": {
+        "field": {
+          "type": "string"
+        },
+        "item": {
+          "description": "server endpoint, drive endpoint or config key",
+          "type": "string"
+        },
+        "status": {
           "type": "string",
           "enum": [
-            "s3",
-            "gcs",
-            "azure",
-            "unsupported"
+            "added",
+            "removed",
+            "changed"
           ]
         }
       }
     },
-    "tierCredentialsRequest": {
+    "healthReportDiff": {
       "type": "object",
       "properties": {
-        "access_key": {
+        "base": {
           "type": "string"
         },
-        "creds": {
-          "description": "a base64 encoded value",
-          "type": "string"
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/healthReportChange"
+          }
         },
-        "secret_key": {
+        "target": {
           "type": "string"
         }
       }
     },
-    "tierListResponse": {
+    "iamEntity": {
+      "type": "string",
+      "pattern": "^[\\w+=,.@-]{1,64}$"
+    },
+    "iamImportChange": {
       "type": "object",
       "properties": {
-        "items": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "unchanged"
+          ]
+        },
+        "details": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/tier"
+            "type": "string"
           }
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "policy",
+            "user",
+            "group",
+            "serviceAccount"
+          ]
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "tier_azure": {
+    "iamImportRequest": {
       "type": "object",
+      "required": [
+        "bundle"
+      ],
       "properties": {
-        "accountkey": {
-          "type": "string"
-        },
-        "accountname": {
-          "type": "string"
-        },
-        "bucket": {
-          "type": "string"
-        },
-        "endpoint": {
+        "bundle": {
+          "description": "exported bundle, as JSON or YAML",
           "type": "string"
         },
-        "name": {
-          "type": "string"
+        "dryRun": {
+          "description": "only report the changes the import would make",
+          "type": "boolean"
+        }
+      }
+    },
+    "iamImportResult": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamImportChange"
+          }
         },
-        "objects": {
-          "type": "string"
+        "created": {
+          "type": "integer",
+          "format": "int64"
         },
-        "prefix": {
-          "type": "string"
+        "dryRun": {
+          "type": "boolean"
         },
-        "region": {
+        "secretsId": {
+          "description": "id to download the secret keys generated for the created users and service accounts, they can be downloaded once",
           "type": "string"
         },
-        "usage": {
-          "type": "string"
+        "unchanged": {
+          "type": "integer",
+          "format": "int64"
         },
-        "versions": {
-          "type": "string"
+        "updated": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "tier_gcs": {
+    "iamPolicy": {
       "type": "object",
       "properties": {
-        "bucket": {
-          "type": "string"
-        },
-        "creds": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "objects": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
-          "type": "string"
-        },
-        "usage": {
-          "type": "string"
+        "statement": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamPolicyStatement"
+          }
         },
-        "versions": {
+        "version": {
           "type": "string"
         }
       }
     },
-    "tier_s3": {
+    "iamPolicyStatement": {
       "type": "object",
       "properties": {
-        "accesskey": {
-          "type": "string"
+        "action": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "bucket": {
-          "type": "string"
+        "condition": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
         },
-        "endpoint": {
+        "effect": {
           "type": "string"
         },
-        "name": {
-          "type": "string"
+        "resource": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "importDashboardRequest": {
+      "type": "object",
+      "required": [
+        "dashboard"
+      ],
+      "properties": {
+        "dashboard": {
+          "$ref": "#/definitions/dashboardExport"
         },
-        "objects": {
-          "type": "string"
+        "replace": {
+          "description": "replace the widgets of the scope instead of appending to them",
+          "type": "boolean"
         },
-        "prefix": {
-          "type": "string"
+        "scope": {
+          "type": "string",
+          "enum": [
+            "user",
+            "global"
+          ]
+        }
+      }
+    },
+    "license": {
+      "type": "object",
+      "properties": {
+        "account_id": {
+          "type": "integer"
         },
-        "region": {
+        "email": {
           "type": "string"
         },
-        "secretkey": {
+        "expires_at": {
           "type": "string"
         },
-        "storageclass": {
+        "organization": {
           "type": "string"
         },
-        "usage": {
+        "plan": {
           "type": "string"
         },
-        "versions": {
-          "type": "string"
+        "storage_capacity": {
+          "type": "integer"
         }
       }
     },
-    "transitionResponse": {
+    "lifecycleTag": {
       "type": "object",
       "properties": {
-        "date": {
-          "type": "string"
-        },
-        "days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "noncurrent_storage_class": {
+        "key": {
           "type": "string"
         },
-        "noncurrent_transition_days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "storage_class": {
+        "value": {
           "type": "string"
         }
       }
     },
-    "updateBucketLifecycle": {
+    "listAccessRulesResponse": {
       "type": "object",
-      "required": [
-        "type"
-      ],
       "properties": {
-        "disable": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
-        },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
-          "type": "string"
-        },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
-          "type": "string"
-        },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
-          "type": "string"
+        "accessRules": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/accessRule"
+          }
         },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+        "total": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "type": {
-          "description": "ILM Rule type (Expiry or transition)",
-          "type": "string",
-          "enum": [
-            "expiry",
-            "transition"
-          ]
+          "format": "int64",
+          "title": "total number of policies"
         }
       }
     },
-    "updateGroupRequest": {
+    "listBucketEventsResponse": {
       "type": "object",
-      "required": [
-        "members",
-        "status"
-      ],
       "properties": {
-        "members": {
+        "events": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/notificationConfig"
           }
         },
-        "status": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of bucket events"
         }
       }
     },
-    "updateUser": {
+    "listBucketsResponse": {
       "type": "object",
-      "required": [
-        "status",
-        "groups"
-      ],
       "properties": {
-        "groups": {
+        "buckets": {
           "type": "array",
+          "title": "list of resulting buckets",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/bucket"
           }
         },
-        "status": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of buckets accessible to the user"
         }
       }
     },
-    "updateUserGroups": {
+    "listConfigResponse": {
       "type": "object",
-      "required": [
-        "groups"
-      ],
       "properties": {
-        "groups": {
+        "configurations": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/configDescription"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of configurations"
         }
       }
     },
-    "user": {
-      "type": "object",
+    "listExternalBucketsParams": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "useTLS"
+      ],
       "properties": {
         "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "region": {
           "type": "string"
         },
-        "hasPolicy": {
-          "type": "boolean"
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
         },
-        "memberOf": {
+        "targetURL": {
+          "type": "string"
+        },
+        "useTLS": {
+          "type": "boolean"
+        }
+      }
+    },
+    "listGroupsResponse": {
+      "type": "object",
+      "properties": {
+        "groups": {
           "type": "array",
+          "title": "list of groups",
           "items": {
             "type": "string"
           }
         },
-        "policy": {
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of groups"
+        }
+      }
+    },
+    "listHealJobsResponse": {
+      "type": "object",
+      "properties": {
+        "jobs": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/healJob"
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "userSAs": {
+    "listHealthReportsResponse": {
       "type": "object",
       "properties": {
-        "path": {
-          "type": "string"
-        },
-        "recursive": {
-          "type": "boolean"
+        "reports": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/healthReport"
+          }
         },
-        "versionID": {
+        "schedule": {
+          "description": "interval of the scheduled collections, empty when disabled",
           "type": "string"
         }
       }
     },
-    "userServiceAccountItem": {
+    "listObjectsResponse": {
       "type": "object",
       "properties": {
-        "numSAs": {
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
+        },
+        "total": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "title": "number of objects"
+        }
+      }
+    },
+    "listPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
         },
-        "userName": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of policies"
         }
       }
     },
-    "userServiceAccountSummary": {
+    "listRemoteBucketsResponse": {
       "type": "object",
       "properties": {
-        "hasSA": {
-          "type": "boolean"
+        "buckets": {
+          "type": "array",
+          "title": "list of remote buckets",
+          "items": {
+            "$ref": "#/definitions/remoteBucket"
+          }
         },
-        "userServiceAccountList": {
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of remote buckets accessible to user"
+        }
+      }
+    },
+    "listSpeedtestResultsResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
           "type": "array",
-          "title": "list of users with number of service accounts",
           "items": {
-            "$ref": "#/definitions/userServiceAccountItem"
+            "$ref": "#/definitions/speedtestResult"
           }
         }
       }
     },
-    "widget": {
+    "listTraceRecordingsResponse": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "options": {
-          "type": "object",
-          "properties": {
-            "reduceOptions": {
-              "type": "object",
-              "properties": {
-                "calcs": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
-            }
+        "recordings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/traceRecording"
           }
-        },
-        "targets": {
+        }
+      }
+    },
+    "listUsersResponse": {
+      "type": "object",
+      "properties": {
+        "users": {
           "type": "array",
+          "title": "list of resulting users",
           "items": {
-            "$ref": "#/definitions/resultTarget"
+            "$ref": "#/definitions/user"
           }
+        }
+      }
+    },
+    "logSearchResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "object",
+          "title": "list of log search responses"
+        }
+      }
+    },
+    "loginDetails": {
+      "type": "object",
+      "properties": {
+        "loginStrategy": {
+          "type": "string",
+          "enum": [
+            "form",
+            "redirect",
+            "service-account",
+            "redirect-service-account"
+          ]
         },
-        "title": {
+        "redirect": {
+          "type": "string"
+        }
+      }
+    },
+    "loginOauth2AuthRequest": {
+      "type": "object",
+      "required": [
+        "state",
+        "code"
+      ],
+      "properties": {
+        "code": {
           "type": "string"
         },
-        "type": {
+        "state": {
           "type": "string"
         }
       }
     },
-    "widgetDetails": {
+    "loginRequest": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int32"
+        "accessKey": {
+          "type": "string"
         },
-        "options": {
+        "features": {
           "type": "object",
           "properties": {
-            "reduceOptions": {
-              "type": "object",
-              "properties": {
-                "calcs": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+            "hide_menu": {
+              "type": "boolean"
             }
           }
         },
-        "targets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resultTarget"
-          }
+        "secretKey": {
+          "type": "string"
         },
-        "title": {
+        "sts": {
+          "type": "string"
+        }
+      }
+    },
+    "loginResponse": {
+      "type": "object",
+      "properties": {
+        "sessionId": {
           "type": "string"
+        }
+      }
+    },
+    "makeBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "locking": {
+          "type": "boolean"
         },
-        "type": {
+        "name": {
           "type": "string"
+        },
+        "quota": {
+          "$ref": "#/definitions/setBucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/putBucketRetentionRequest"
+        },
+        "versioning": {
+          "type": "boolean"
         }
       }
     },
-    "widgetResult": {
+    "metadata": {
       "type": "object",
       "properties": {
-        "metric": {
+        "objectMetadata": {
           "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "values": {
-          "type": "array",
-          "items": {}
+          "additionalProperties": true
         }
       }
-    }
-  },
-  "securityDefinitions": {
-    "key": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://min.io",
-      "tokenUrl": "http://min.io"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "ws"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "GuinsooLab Console Server",
-    "version": "0.1.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/account/change-password": {
-      "post": {
-        "tags": [
-          "Account"
-        ],
-        "summary": "Change password of currently logged in user.",
-        "operationId": "AccountChangePassword",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/accountChangePasswordRequest"
-            }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful login."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    },
+    "multiBucketReplication": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "bucketsRelation"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bucketsRelation": {
+          "type": "array",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/multiBucketsRelation"
           }
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "region": {
+          "type": "string"
+        },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
         }
       }
     },
-    "/account/change-user-password": {
-      "post": {
-        "tags": [
-          "Account"
-        ],
-        "summary": "Change password of currently logged in user.",
-        "operationId": "ChangeUserPassword",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/changeUserPasswordRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Password successfully changed."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "multiBucketReplicationEdit": {
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateExistingObjects": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
+        "ruleState": {
+          "type": "boolean"
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "tags": {
+          "type": "string",
+          "default": ""
         }
       }
     },
-    "/admin/arns": {
-      "get": {
-        "tags": [
-          "System"
-        ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/arnsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "multiBucketResponseItem": {
+      "type": "object",
+      "properties": {
+        "errorString": {
+          "type": "string"
+        },
+        "originBucket": {
+          "type": "string"
+        },
+        "targetBucket": {
+          "type": "string"
         }
       }
     },
-    "/admin/info": {
-      "get": {
-        "tags": [
-          "System"
-        ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": false,
-            "name": "defaultOnly",
-            "in": "query"
+    "multiBucketResponseState": {
+      "type": "object",
+      "properties": {
+        "replicationState": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/multiBucketResponseItem"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        }
+      }
+    },
+    "multiBucketsRelation": {
+      "type": "object",
+      "properties": {
+        "destinationBucket": {
+          "type": "string"
+        },
+        "originBucket": {
+          "type": "string"
+        }
+      }
+    },
+    "multiLifecycleResult": {
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/multicycleResultItem"
           }
         }
       }
     },
-    "/admin/info/widgets/{widgetId}": {
-      "get": {
-        "tags": [
-          "System"
-        ],
-        "summary": "Returns information about the deployment",
-        "operationId": "DashboardWidgetDetails",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "widgetId",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "name": "start",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "end",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "step",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/widgetDetails"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "multicycleResultItem": {
+      "type": "object",
+      "properties": {
+        "bucketName": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
         }
       }
     },
-    "/admin/inspect": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "Inspect"
-        ],
-        "summary": "Inspect Files on Drive",
-        "operationId": "Inspect",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "file",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "volume",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "encrypt",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "nofiticationService": {
+      "type": "string",
+      "enum": [
+        "webhook",
+        "amqp",
+        "kafka",
+        "mqtt",
+        "nats",
+        "nsq",
+        "mysql",
+        "postgres",
+        "elasticsearch",
+        "redis"
+      ]
+    },
+    "notifEndpointResponse": {
+      "type": "object",
+      "properties": {
+        "notification_endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationEndpointItem"
           }
         }
       }
     },
-    "/admin/notification_endpoints": {
-      "get": {
-        "tags": [
-          "Configuration"
-        ],
-        "summary": "Returns a list of active notification endpoints",
-        "operationId": "NotificationEndpointList",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/notifEndpointResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "notificationConfig": {
+      "type": "object",
+      "required": [
+        "arn"
+      ],
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
           }
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
-      },
-      "post": {
-        "tags": [
-          "Configuration"
-        ],
-        "summary": "Allows to configure a new notification endpoint",
-        "operationId": "AddNotificationEndpoint",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setNotificationEndpointResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "notificationDeleteRequest": {
+      "type": "object",
+      "required": [
+        "events",
+        "prefix",
+        "suffix"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
           }
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "/admin/site-replication": {
-      "get": {
-        "tags": [
-          "SiteReplication"
-        ],
-        "summary": "Get list of Replication Sites",
-        "operationId": "GetSiteReplicationInfo",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/siteReplicationInfoResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "notificationEndpoint": {
+      "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
         }
-      },
-      "put": {
-        "tags": [
-          "SiteReplication"
-        ],
-        "summary": "Edit a Replication Site",
-        "operationId": "SiteReplicationEdit",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/peerInfo"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/peerSiteEditResponse"
+      }
+    },
+    "notificationEndpointItem": {
+      "type": "object",
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationEventType": {
+      "type": "string",
+      "enum": [
+        "put",
+        "delete",
+        "get"
+      ]
+    },
+    "objectBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "expiration": {
+          "$ref": "#/definitions/expirationResponse"
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "transition": {
+          "$ref": "#/definitions/transitionResponse"
+        }
+      }
+    },
+    "objectLegalHoldStatus": {
+      "type": "string",
+      "enum": [
+        "enabled",
+        "disabled"
+      ]
+    },
+    "objectRetentionMode": {
+      "type": "string",
+      "enum": [
+        "governance",
+        "compliance"
+      ]
+    },
+    "objectRetentionUnit": {
+      "type": "string",
+      "enum": [
+        "days",
+        "years"
+      ]
+    },
+    "peerInfo": {
+      "type": "object",
+      "properties": {
+        "deploymentID": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "peerInfoRemove": {
+      "type": "object",
+      "required": [
+        "sites"
+      ],
+      "properties": {
+        "all": {
+          "type": "boolean"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "peerSite": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "peerSiteEditResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "peerSiteRemoveResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "permissionResource": {
+      "type": "object",
+      "properties": {
+        "conditionOperator": {
+          "type": "string"
+        },
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "resource": {
+          "type": "string"
+        }
+      }
+    },
+    "policy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "policyArgs": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "bucket_name": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "policyEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group"
+      ]
+    },
+    "policyHistory": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "revisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyRevision"
+          }
+        }
+      }
+    },
+    "policyLintIssue": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "type": "string",
+          "enum": [
+            "invalidJSON",
+            "invalidVersion",
+            "invalidEffect",
+            "emptyAction",
+            "unknownAction",
+            "invalidResource",
+            "unknownBucket",
+            "invalidCondition",
+            "invalidConditionKey",
+            "invalidStatement",
+            "shadowedStatement",
+            "broadPermission",
+            "notAttached"
+          ]
+        },
+        "message": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "error",
+            "warning",
+            "info"
+          ]
+        },
+        "statement": {
+          "description": "position of the statement starting at 1, 0 for issues of the whole policy",
+          "type": "integer",
+          "format": "int32"
+        },
+        "suggestion": {
+          "type": "string"
+        },
+        "value": {
+          "description": "offending action, resource or condition",
+          "type": "string"
+        }
+      }
+    },
+    "policyLintRequest": {
+      "type": "object",
+      "required": [
+        "policy"
+      ],
+      "properties": {
+        "name": {
+          "description": "name the policy is saved as, used to report whether it is attached",
+          "type": "string"
+        },
+        "policy": {
+          "description": "policy document as JSON",
+          "type": "string"
+        }
+      }
+    },
+    "policyLintResult": {
+      "type": "object",
+      "properties": {
+        "issues": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyLintIssue"
+          }
+        },
+        "unusedPolicies": {
+          "description": "existing policies attached to no user or group",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "valid": {
+          "description": "the policy has no errors and can be saved",
+          "type": "boolean"
+        }
+      }
+    },
+    "policyRevision": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "baseline",
+            "create",
+            "update",
+            "rollback",
+            "delete"
+          ]
+        },
+        "author": {
+          "description": "access key of the user who saved the revision, empty for revisions saved outside of the console",
+          "type": "string"
+        },
+        "diff": {
+          "description": "changes from the previous revision",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groups": {
+          "description": "groups the policy was attached to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "description": "policy document as JSON, empty once the policy is deleted",
+          "type": "string"
+        },
+        "restoredRevision": {
+          "description": "revision restored by a rollback",
+          "type": "integer",
+          "format": "int32"
+        },
+        "revision": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "users": {
+          "description": "users the policy was attached to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "policyRevisionDiff": {
+      "type": "object",
+      "properties": {
+        "addedGroups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "addedUsers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "diff": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "from": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "name": {
+          "type": "string"
+        },
+        "removedGroups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "removedUsers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "to": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "policySimulationPolicy": {
+      "type": "object",
+      "properties": {
+        "group": {
+          "description": "group the policy is attached to when the source is group",
+          "type": "string"
+        },
+        "missing": {
+          "description": "the policy is attached but does not exist",
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount",
+            "session"
+          ]
+        }
+      }
+    },
+    "policySimulationRequest": {
+      "type": "object",
+      "required": [
+        "principal",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "description": "action to evaluate, e.g. s3:GetObject or admin:ServerInfo",
+          "type": "string"
+        },
+        "conditions": {
+          "description": "condition values the statements are evaluated with, e.g. aws:SourceIp",
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "principal": {
+          "description": "access key of the user or service account",
+          "type": "string"
+        },
+        "resource": {
+          "description": "resource ARN, e.g. arn:aws:s3:::bucket/object, not needed for admin actions",
+          "type": "string"
+        },
+        "sessionPolicy": {
+          "description": "optional session policy document restricting the principal, as used by temporary credentials",
+          "type": "string"
+        }
+      }
+    },
+    "policySimulationResult": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "allowed": {
+          "type": "boolean"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "decidingStatement": {
+          "$ref": "#/definitions/policySimulationStatement"
+        },
+        "decision": {
+          "type": "string",
+          "enum": [
+            "allowed",
+            "explicitDeny",
+            "implicitDeny"
+          ]
+        },
+        "matchedStatements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationStatement"
+          }
+        },
+        "object": {
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationPolicy"
+          }
+        },
+        "principal": {
+          "type": "string"
+        },
+        "principalType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "serviceAccount"
+          ]
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "policySimulationStatement": {
+      "type": "object",
+      "properties": {
+        "effect": {
+          "type": "string"
+        },
+        "group": {
+          "type": "string"
+        },
+        "index": {
+          "description": "position of the statement in its policy",
+          "type": "integer",
+          "format": "int32"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "sid": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount",
+            "session"
+          ]
+        },
+        "statement": {
+          "description": "statement as JSON",
+          "type": "string"
+        }
+      }
+    },
+    "policyTemplate": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "policy"
+      ],
+      "properties": {
+        "builtin": {
+          "type": "boolean"
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "parameters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyTemplateParameter"
+          }
+        },
+        "policy": {
+          "description": "policy document as JSON with {{name}} placeholders",
+          "type": "string"
+        }
+      }
+    },
+    "policyTemplateList": {
+      "type": "object",
+      "properties": {
+        "templates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyTemplate"
+          }
+        }
+      }
+    },
+    "policyTemplateParameter": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "default": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "description": "referenced in the template as {{name}}",
+          "type": "string"
+        },
+        "required": {
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string",
+          "default": "string",
+          "enum": [
+            "bucket",
+            "prefix",
+            "string"
+          ]
+        }
+      }
+    },
+    "policyTemplateRenderRequest": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "description": "groups to attach the saved policy to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "name to save the rendered policy as, the policy is only rendered when empty",
+          "type": "string"
+        },
+        "users": {
+          "description": "users to attach the saved policy to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "values": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "policyTemplateRenderResult": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "saved": {
+          "type": "boolean"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "prefixAccessPair": {
+      "type": "object",
+      "properties": {
+        "access": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "prefixWrapper": {
+      "type": "object",
+      "properties": {
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "principal": {
+      "type": "object",
+      "properties": {
+        "STSAccessKeyID": {
+          "type": "string"
+        },
+        "STSSecretAccessKey": {
+          "type": "string"
+        },
+        "STSSessionToken": {
+          "type": "string"
+        },
+        "accountAccessKey": {
+          "type": "string"
+        },
+        "hm": {
+          "type": "boolean"
+        },
+        "ob": {
+          "type": "boolean"
+        }
+      }
+    },
+    "profilingStartRequest": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "prometheusCheck": {
+      "type": "object",
+      "properties": {
+        "authType": {
+          "type": "string",
+          "enum": [
+            "none",
+            "basic",
+            "bearer"
+          ]
+        },
+        "clientCertificate": {
+          "type": "boolean"
+        },
+        "configured": {
+          "type": "boolean"
+        },
+        "error": {
+          "type": "string"
+        },
+        "latency": {
+          "description": "latency of the check in milliseconds",
+          "type": "integer",
+          "format": "int64"
+        },
+        "reachable": {
+          "type": "boolean"
+        },
+        "statusCode": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "tls": {
+          "type": "boolean"
+        },
+        "url": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "putBucketRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "unit",
+        "validity"
+      ],
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "putBucketTagsRequest": {
+      "type": "object",
+      "properties": {
+        "tags": {
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "putObjectLegalHoldRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "$ref": "#/definitions/objectLegalHoldStatus"
+        }
+      }
+    },
+    "putObjectRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "expires"
+      ],
+      "properties": {
+        "expires": {
+          "type": "string"
+        },
+        "governance_bypass": {
+          "type": "boolean"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        }
+      }
+    },
+    "putObjectTagsRequest": {
+      "type": "object",
+      "properties": {
+        "tags": {
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "remoteBucket": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "sourceBucket",
+        "remoteARN"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healthCheckPeriod": {
+          "type": "integer"
+        },
+        "remoteARN": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "service": {
+          "type": "string",
+          "enum": [
+            "replication"
+          ]
+        },
+        "sourceBucket": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string"
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "resultTarget": {
+      "type": "object",
+      "properties": {
+        "legendFormat": {
+          "type": "string"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widgetResult"
+          }
+        },
+        "resultType": {
+          "type": "string"
+        }
+      }
+    },
+    "rewindItem": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "delete_flag": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "rewindResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rewindItem"
+          }
+        }
+      }
+    },
+    "serverDrives": {
+      "type": "object",
+      "properties": {
+        "availableSpace": {
+          "type": "integer"
+        },
+        "diskIndex": {
+          "type": "integer"
+        },
+        "drivePath": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "healing": {
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "poolIndex": {
+          "type": "integer"
+        },
+        "rootDisk": {
+          "type": "boolean"
+        },
+        "setIndex": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string"
+        },
+        "totalSpace": {
+          "type": "integer"
+        },
+        "usedSpace": {
+          "type": "integer"
+        },
+        "uuid": {
+          "type": "string"
+        }
+      }
+    },
+    "serverProperties": {
+      "type": "object",
+      "properties": {
+        "commitID": {
+          "type": "string"
+        },
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverDrives"
+          }
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "network": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "poolNumber": {
+          "type": "integer"
+        },
+        "state": {
+          "type": "string"
+        },
+        "uptime": {
+          "type": "integer"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountCreds": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountExpiry": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "deleteAt": {
+          "description": "when the disabled service account is deleted",
+          "type": "string"
+        },
+        "disabledAt": {
+          "type": "string"
+        },
+        "error": {
+          "description": "last error disabling or deleting the service account, retried by the scheduler",
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "rotatedFrom": {
+          "type": "string"
+        },
+        "rotatedTo": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "active",
+            "disabled"
+          ]
+        }
+      }
+    },
+    "serviceAccountExpiryList": {
+      "type": "object",
+      "properties": {
+        "accounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountExpiry"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "serviceAccountExpiryRequest": {
+      "type": "object",
+      "properties": {
+        "expiresAt": {
+          "description": "RFC 3339 time, leave it and expiresIn empty to remove the expiration",
+          "type": "string"
+        },
+        "expiresIn": {
+          "description": "duration like 720h, used when expiresAt is empty",
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountRequest": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "type": "string",
+          "title": "policy to be applied to the Service Account if any"
+        }
+      }
+    },
+    "serviceAccountRequestCreds": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string",
+          "title": "policy to be applied to the Service Account if any"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountRotateRequest": {
+      "type": "object",
+      "properties": {
+        "expiresIn": {
+          "description": "expiration of the new credentials, as a duration like 720h",
+          "type": "string"
+        },
+        "gracePeriod": {
+          "description": "how long the old credentials stay valid",
+          "type": "string",
+          "default": "24h"
+        }
+      }
+    },
+    "serviceAccountRotation": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "description": "access key of the new service account, MinIO keeps a single secret key per service account so a rotation issues a new access key as well",
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "previousAccessKey": {
+          "description": "access key of the rotated service account, disabled and then deleted once it expires",
+          "type": "string"
+        },
+        "previousExpiresAt": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountUsage": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "apiCalls": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "lastUsed": {
+          "type": "string"
+        },
+        "neverUsed": {
+          "description": "no request was seen since the usage is tracked",
+          "type": "boolean"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "sourceIps": {
+          "description": "most recent client addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "totalCalls": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "serviceAccountUsageList": {
+      "type": "object",
+      "properties": {
+        "accounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountUsage"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "trackedSince": {
+          "description": "when the console started tracking the usage, empty when it isn't tracked",
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccounts": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/serviceAccountUsage"
+      }
+    },
+    "sessionResponse": {
+      "type": "object",
+      "properties": {
+        "allowResources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/permissionResource"
+          }
+        },
+        "distributedMode": {
+          "type": "boolean"
+        },
+        "features": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operator": {
+          "type": "boolean"
+        },
+        "permissions": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "ok"
+          ]
+        }
+      }
+    },
+    "setBucketPolicyRequest": {
+      "type": "object",
+      "required": [
+        "access"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "definition": {
+          "type": "string"
+        }
+      }
+    },
+    "setBucketQuota": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "amount": {
+          "type": "integer"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "quota_type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
+        }
+      }
+    },
+    "setBucketVersioning": {
+      "type": "object",
+      "properties": {
+        "versioning": {
+          "type": "boolean"
+        }
+      }
+    },
+    "setConfigRequest": {
+      "type": "object",
+      "required": [
+        "key_values"
+      ],
+      "properties": {
+        "arn_resource_id": {
+          "type": "string",
+          "title": "Used if configuration is an event notification's target"
+        },
+        "key_values": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        }
+      }
+    },
+    "setConfigResponse": {
+      "type": "object",
+      "properties": {
+        "restart": {
+          "description": "Returns wheter server needs to restart to apply changes or not",
+          "type": "boolean"
+        }
+      }
+    },
+    "setNotificationEndpointResponse": {
+      "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "restart": {
+          "type": "boolean"
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        }
+      }
+    },
+    "setPolicyMultipleNameRequest": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
+        },
+        "name": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
+        }
+      }
+    },
+    "setPolicyNameRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "entityType",
+        "entityName"
+      ],
+      "properties": {
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
+        },
+        "name": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "setPolicyRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName"
+      ],
+      "properties": {
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
+        }
+      }
+    },
+    "siteReplicationAddRequest": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/peerSite"
+      }
+    },
+    "siteReplicationAddResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "initialSyncErrorMessage": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationInfoResponse": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "serviceAccountAccessKey": {
+          "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerInfo"
+          }
+        }
+      }
+    },
+    "siteReplicationStatusResponse": {
+      "type": "object",
+      "properties": {
+        "bucketStats": {
+          "type": "object"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "groupStats": {
+          "type": "object"
+        },
+        "maxBuckets": {
+          "type": "integer"
+        },
+        "maxGroups": {
+          "type": "integer"
+        },
+        "maxPolicies": {
+          "type": "integer"
+        },
+        "maxUsers": {
+          "type": "integer"
+        },
+        "policyStats": {
+          "type": "object"
+        },
+        "sites": {
+          "type": "object"
+        },
+        "statsSummary": {
+          "type": "object"
+        },
+        "userStats": {
+          "type": "object"
+        }
+      }
+    },
+    "speedtestComparison": {
+      "type": "object",
+      "properties": {
+        "base": {
+          "$ref": "#/definitions/speedtestResult"
+        },
+        "metrics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestMetricDiff"
+          }
+        },
+        "parametersChanged": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "target": {
+          "$ref": "#/definitions/speedtestResult"
+        }
+      }
+    },
+    "speedtestMetric": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "description": "server or drive the metric belongs to, empty for cluster totals",
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "description": "metric identifier, e.g. put.throughput or drive.read",
+          "type": "string"
+        },
+        "unit": {
+          "type": "string"
+        },
+        "value": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "speedtestMetricDiff": {
+      "type": "object",
+      "properties": {
+        "base": {
+          "type": "number",
+          "format": "double"
+        },
+        "delta": {
+          "type": "number",
+          "format": "double"
+        },
+        "deltaPercent": {
+          "description": "change relative to base, empty when base is 0",
+          "type": "number",
+          "format": "double"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "unchanged",
+            "changed",
+            "added",
+            "removed"
+          ]
+        },
+        "target": {
+          "type": "number",
+          "format": "double"
+        },
+        "unit": {
+          "type": "string"
+        }
+      }
+    },
+    "speedtestResult": {
+      "type": "object",
+      "properties": {
+        "endTime": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "metrics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestMetric"
+          }
+        },
+        "mode": {
+          "type": "string",
+          "enum": [
+            "object",
+            "drive",
+            "net"
+          ]
+        },
+        "parameters": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "startTime": {
+          "type": "string"
+        }
+      }
+    },
+    "startProfilingItem": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "nodeName": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "startProfilingList": {
+      "type": "object",
+      "properties": {
+        "startResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/startProfilingItem"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of start results"
+        }
+      }
+    },
+    "subnetLoginMFARequest": {
+      "type": "object",
+      "required": [
+        "username",
+        "otp",
+        "mfa_token"
+      ],
+      "properties": {
+        "mfa_token": {
+          "type": "string"
+        },
+        "otp": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "subnetLoginRequest": {
+      "type": "object",
+      "properties": {
+        "apiKey": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "subnetLoginResponse": {
+      "type": "object",
+      "properties": {
+        "access_token": {
+          "type": "string"
+        },
+        "mfa_token": {
+          "type": "string"
+        },
+        "organizations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/subnetOrganization"
+          }
+        },
+        "registered": {
+          "type": "boolean"
+        }
+      }
+    },
+    "subnetOrganization": {
+      "type": "object",
+      "properties": {
+        "accountId": {
+          "type": "integer"
+        },
+        "company": {
+          "type": "string"
+        },
+        "isAccountOwner": {
+          "type": "boolean"
+        },
+        "shortName": {
+          "type": "string"
+        },
+        "subscriptionStatus": {
+          "type": "string"
+        },
+        "userId": {
+          "type": "integer"
+        }
+      }
+    },
+    "subnetRegisterRequest": {
+      "type": "object",
+      "required": [
+        "token",
+        "account_id"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "token": {
+          "type": "string"
+        }
+      }
+    },
+    "tier": {
+      "type": "object",
+      "properties": {
+        "azure": {
+          "type": "object",
+          "$ref": "#/definitions/tier_azure"
+        },
+        "gcs": {
+          "type": "object",
+          "$ref": "#/definitions/tier_gcs"
+        },
+        "s3": {
+          "type": "object",
+          "$ref": "#/definitions/tier_s3"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "s3",
+            "gcs",
+            "azure",
+            "unsupported"
+          ]
+        }
+      }
+    },
+    "tierCredentialsRequest": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "creds": {
+          "description": "a base64 encoded value",
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "tierListResponse": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tier"
+          }
+        }
+      }
+    },
+    "tier_azure": {
+      "type": "object",
+      "properties": {
+        "accountkey": {
+          "type": "string"
+        },
+        "accountname": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "string"
+        }
+      }
+    },
+    "tier_gcs": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "creds": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "string"
+        }
+      }
+    },
+    "tier_s3": {
+      "type": "object",
+      "properties": {
+        "accesskey": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretkey": {
+          "type": "string"
+        },
+        "storageclass": {
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "string"
+        }
+      }
+    },
+    "topologyDrive": {
+      "type": "object",
+      "properties": {
+        "availableSpace": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "freeInodes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healing": {
+          "$ref": "#/definitions/topologyDriveHealing"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "model": {
+          "type": "string"
+        },
+        "operations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyDriveOperation"
+          }
+        },
+        "path": {
+          "type": "string"
+        },
+        "readLatency": {
+          "type": "number"
+        },
+        "readThroughput": {
+          "type": "number"
+        },
+        "server": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "totalSpace": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "usedSpace": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "utilization": {
+          "type": "number"
+        },
+        "uuid": {
+          "type": "string"
+        },
+        "writeLatency": {
+          "type": "number"
+        },
+        "writeThroughput": {
+          "type": "number"
+        }
+      }
+    },
+    "topologyDriveHealing": {
+      "type": "object",
+      "properties": {
+        "bytesDone": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytesFailed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "currentBucket": {
+          "type": "string"
+        },
+        "currentObject": {
+          "type": "string"
+        },
+        "healedBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "itemsFailed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "itemsHealed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastUpdate": {
+          "type": "string"
+        },
+        "objectsTotalCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsTotalSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "progress": {
+          "description": "percentage of the objects healed",
+          "type": "number"
+        },
+        "queuedBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "started": {
+          "type": "string"
+        }
+      }
+    },
+    "topologyDriveOperation": {
+      "type": "object",
+      "properties": {
+        "avgLatency": {
+          "description": "average latency in milliseconds",
+          "type": "number"
+        },
+        "count": {
+          "description": "operations on the last minute",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "topologyPool": {
+      "type": "object",
+      "properties": {
+        "healingDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "offlineDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "onlineDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "sets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologySet"
+          }
+        }
+      }
+    },
+    "topologySet": {
+      "type": "object",
+      "properties": {
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyDrive"
+          }
+        },
+        "healingDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "offlineDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "onlineDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "healthy",
+            "healing",
+            "degraded",
+            "unavailable"
+          ]
+        }
+      }
+    },
+    "traceApiStats": {
+      "type": "object",
+      "properties": {
+        "api": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "errorRate": {
+          "type": "number",
+          "format": "double"
+        },
+        "errors": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "p50": {
+          "description": "latency in milliseconds",
+          "type": "number",
+          "format": "double"
+        },
+        "p90": {
+          "description": "latency in milliseconds",
+          "type": "number",
+          "format": "double"
+        },
+        "p99": {
+          "description": "latency in milliseconds",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "traceClientStats": {
+      "type": "object",
+      "properties": {
+        "client": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "traceRecording": {
+      "type": "object",
+      "properties": {
+        "endTime": {
+          "type": "string"
+        },
+        "entries": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string"
+        },
+        "query": {
+          "description": "trace options the session was recorded with",
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "startTime": {
+          "type": "string"
+        }
+      }
+    },
+    "traceRecordingSummary": {
+      "type": "object",
+      "properties": {
+        "apis": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/traceApiStats"
+          }
+        },
+        "errorRate": {
+          "type": "number",
+          "format": "double"
+        },
+        "errors": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string"
+        },
+        "topClients": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/traceClientStats"
+          }
+        },
+        "totalCalls": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "transitionResponse": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_storage_class": {
+          "type": "string"
+        },
+        "noncurrent_transition_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "updateBucketLifecycle": {
+      "type": "object",
+      "required": [
+        "type"
+      ],
+      "properties": {
+        "disable": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
+        },
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
+          "type": "string",
+          "enum": [
+            "expiry",
+            "transition"
+          ]
+        }
+      }
+    },
+    "updateGroupRequest": {
+      "type": "object",
+      "required": [
+        "members",
+        "status"
+      ],
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "updateUser": {
+      "type": "object",
+      "required": [
+        "status",
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "updateUserGroups": {
+      "type": "object",
+      "required": [
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "hasPolicy": {
+          "type": "boolean"
+        },
+        "memberOf": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "userSAs": {
+      "type": "object",
+      "properties": {
+        "path": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "userServiceAccountItem": {
+      "type": "object",
+      "properties": {
+        "numSAs": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "userName": {
+          "type": "string"
+        }
+      }
+    },
+    "userServiceAccountSummary": {
+      "type": "object",
+      "properties": {
+        "hasSA": {
+          "type": "boolean"
+        },
+        "userServiceAccountList": {
+          "type": "array",
+          "title": "list of users with number of service accounts",
+          "items": {
+            "$ref": "#/definitions/userServiceAccountItem"
+          }
+        }
+      }
+    },
+    "usersImportRequest": {
+      "type": "object",
+      "required": [
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "description": "CSV with the columns access_key, secret_key, groups, policies and status, groups and policies separated by semicolons, or a JSON array of users. Missing columns or fields leave existing users unchanged",
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "format": {
+          "type": "string",
+          "default": "csv",
+          "enum": [
+            "csv",
+            "json"
+          ]
+        }
+      }
+    },
+    "usersImportResult": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "description": "false when a row is invalid, nothing is applied then",
+          "type": "boolean"
+        },
+        "created": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/usersImportRow"
+          }
+        },
+        "secretsId": {
+          "description": "id to download the generated secret keys, they can be downloaded once",
+          "type": "string"
+        },
+        "unchanged": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "updated": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "usersImportRow": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "unchanged",
+            "invalid",
+            "failed"
+          ]
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "type": "string"
+        },
+        "row": {
+          "description": "position of the user in the data starting at 1",
+          "type": "integer",
+          "format": "int32"
+        },
+        "secretGenerated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "widget": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resultTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "widgetDetails": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resultTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "widgetResult": {
+      "type": "object",
+      "properties": {
+        "metric": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "values": {
+          "type": "array",
+          "items": {}
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "key": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://min.io",
+      "tokenUrl": "http://min.io"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "ws"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "GuinsooLab Console Server",
+    "version": "0.1.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/account/change-password": {
+      "post": {
+        "tags": [
+          "Account"
+        ],
+        "summary": "Change password of currently logged in user.",
+        "operationId": "AccountChangePassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/accountChangePasswordRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/account/change-user-password": {
+      "post": {
+        "tags": [
+          "Account"
+        ],
+        "summary": "Change password of currently logged in user.",
+        "operationId": "ChangeUserPassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/changeUserPasswordRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Password successfully changed."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/channels": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "List the alert notification channels",
+        "operationId": "ListAlertChannels",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertChannelList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Set the alert notification channels",
+        "operationId": "SetAlertChannels",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertChannelList"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertChannelList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/channels/{name}/test": {
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Send a test notification to an alert channel",
+        "operationId": "TestAlertChannel",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/history": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "List the alerts history",
+        "operationId": "ListAlerts",
+        "parameters": [
+          {
+            "enum": [
+              "firing",
+              "resolved"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/history/{alertId}/acknowledge": {
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Acknowledge a firing alert",
+        "operationId": "AcknowledgeAlert",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "alertId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alert"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/rules": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "List the alert rules",
+        "operationId": "ListAlertRules",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRuleList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Create an alert rule",
+        "operationId": "CreateAlertRule",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/rules/{ruleId}": {
+      "put": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Update an alert rule",
+        "operationId": "UpdateAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Delete an alert rule",
+        "operationId": "DeleteAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/rules/{ruleId}/silence": {
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Silence the notifications of an alert rule",
+        "operationId": "SilenceAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertSilence"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Remove the silence of an alert rule",
+        "operationId": "UnsilenceAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "ruleId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/arns": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/arnsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/capacity/forecast": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Forecast when the pools and bucket quotas are full",
+        "operationId": "CapacityForecast",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "days of usage samples the trend is fitted on, defaults to 30",
+            "name": "days",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/capacityForecast"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/console/logs/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Logging"
+        ],
+        "summary": "Download the last console log entries of every node",
+        "operationId": "DownloadConsoleLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "node",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "minio",
+              "application",
+              "all"
+            ],
+            "type": "string",
+            "default": "all",
+            "name": "logType",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 100,
+            "description": "Number of entries per node",
+            "name": "lineCount",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Regular expression matched against the log message",
+            "name": "search",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "api",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "errKind",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "deploymentId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboard/export": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Export the custom dashboard widgets",
+        "operationId": "ExportDashboard",
+        "parameters": [
+          {
+            "enum": [
+              "user",
+              "global"
+            ],
+            "type": "string",
+            "name": "scope",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardExport"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboard/import": {
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Import custom dashboard widgets",
+        "operationId": "ImportDashboard",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/importDashboardRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidgetList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboard/order": {
+      "put": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Order the custom dashboard widgets",
+        "operationId": "OrderDashboardWidgets",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidgetsOrder"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidgetList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboard/widgets": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "List custom dashboard widgets",
+        "operationId": "ListDashboardWidgets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidgetList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Create a custom dashboard widget",
+        "operationId": "CreateDashboardWidget",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboard/widgets/{widgetId}": {
+      "put": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Update a custom dashboard widget",
+        "operationId": "UpdateDashboardWidget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Delete a custom dashboard widget",
+        "operationId": "DeleteDashboardWidget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/grants": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "List the temporary access grants",
+        "operationId": "ListAccessGrants",
+        "parameters": [
+          {
+            "enum": [
+              "active",
+              "expired",
+              "revoked"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/accessGrantList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Attach a policy to a user or group for a limited time",
+        "operationId": "CreateAccessGrant",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/accessGrantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/accessGrant"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/grants/{id}": {
+      "delete": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Revoke a temporary access grant before it expires",
+        "operationId": "RevokeAccessGrant",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/accessGrant"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/heal/jobs": {
+      "get": {
+        "tags": [
+          "Heal"
+        ],
+        "summary": "List running and past heal jobs",
+        "operationId": "ListHealJobs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listHealJobsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/health/reports": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "List archived Health Reports",
+        "operationId": "ListHealthReports",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listHealthReportsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/health/reports/diff": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Diff two archived Health Reports",
+        "operationId": "DiffHealthReports",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "base",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/healthReportDiff"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/health/reports/{id}/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "System"
+        ],
+        "summary": "Download archived Health Report",
+        "operationId": "DownloadHealthReport",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "hash hostnames, IPs and endpoints and strip secrets from the report",
+            "name": "anonymize",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/iam/effective-permissions": {
+      "get": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Effective permissions of every user, group and service account per bucket and prefix",
+        "operationId": "EffectivePermissions",
+        "parameters": [
+          {
+            "enum": [
+              "user",
+              "group",
+              "serviceAccount"
+            ],
+            "type": "string",
+            "description": "only report this kind of entity",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only report the permissions applying to this bucket",
+            "name": "bucket",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/effectivePermissionsReport"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/iam/effective-permissions/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Export the effective permissions report as CSV",
+        "operationId": "ExportEffectivePermissions",
+        "parameters": [
+          {
+            "enum": [
+              "user",
+              "group",
+              "serviceAccount"
+            ],
+            "type": "string",
+            "description": "only report this kind of entity",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "only report the permissions applying to this bucket",
+            "name": "bucket",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/iam/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Export the policies, users, groups and service accounts as a bundle",
+        "operationId": "ExportIAM",
+        "parameters": [
+          {
+            "enum": [
+              "json",
+              "yaml"
+            ],
+            "type": "string",
+            "default": "json",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/iam/import": {
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Import a bundle of policies, users, groups and service accounts",
+        "operationId": "ImportIAM",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/iamImportRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/iamImportResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "name": "defaultOnly",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/adminInfoResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info/prometheus": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Check the connectivity with Prometheus",
+        "operationId": "CheckPrometheus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/prometheusCheck"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info/topology": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns the erasure sets of the pools with the health of their drives",
+        "operationId": "ErasureTopology",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/erasureTopology"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info/widgets/{widgetId}": {
+      "get": {
+        "tags": [
+          "System"
+        ],
+        "summary": "Returns information about the deployment",
+        "operationId": "DashboardWidgetDetails",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/widgetDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/inspect": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Inspect"
+        ],
+        "summary": "Inspect Files on Drive",
+        "operationId": "Inspect",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "file",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "volume",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "encrypt",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/notification_endpoints": {
+      "get": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Returns a list of active notification endpoints",
+        "operationId": "NotificationEndpointList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notifEndpointResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Configuration"
+        ],
+        "summary": "Allows to configure a new notification endpoint",
+        "operationId": "AddNotificationEndpoint",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationEndpoint"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setNotificationEndpointResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/site-replication": {
+      "get": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Get list of Replication Sites",
+        "operationId": "GetSiteReplicationInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationInfoResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Edit a Replication Site",
+        "operationId": "SiteReplicationEdit",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/peerInfo"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/peerSiteEditResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Add a Replication Site",
+        "operationId": "SiteReplicationInfoAdd",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Remove a Replication Site",
+        "operationId": "SiteReplicationRemove",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/peerInfoRemove"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/peerSiteRemoveResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/site-replication/status": {
+      "get": {
+        "tags": [
+          "SiteReplication"
+        ],
+        "summary": "Display overall site replication status",
+        "operationId": "GetSiteReplicationStatus",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Bucket stats",
+            "name": "buckets",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Group stats",
+            "name": "groups",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Policies stats",
+            "name": "policies",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "Include Policies stats",
+            "name": "users",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Entity Type to lookup",
+            "name": "entityType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Entity Value to lookup",
+            "name": "entityValue",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/speedtest/compare": {
+      "get": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Compare two Speedtest Results",
+        "operationId": "CompareSpeedtestResults",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "base",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestComparison"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/speedtest/results": {
+      "get": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "List Speedtest Results",
+        "operationId": "ListSpeedtestResults",
+        "parameters": [
+          {
+            "enum": [
+              "object",
+              "drive",
+              "net"
+            ],
+            "type": "string",
+            "name": "mode",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listSpeedtestResultsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/speedtest/results/{id}": {
+      "get": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Get Speedtest Result",
+        "operationId": "GetSpeedtestResult",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/speedtestResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Speedtest"
+        ],
+        "summary": "Delete Speedtest Result",
+        "operationId": "DeleteSpeedtestResult",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers": {
+      "get": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Returns a list of tiers for ilm",
+        "operationId": "TiersList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tierListResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Allows to configure a new tier",
+        "operationId": "AddTier",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tier"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers/{type}/{name}": {
+      "get": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Get Tier",
+        "operationId": "GetTier",
+        "parameters": [
+          {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tier"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers/{type}/{name}/credentials": {
+      "put": {
+        "tags": [
+          "Tiering"
+        ],
+        "summary": "Edit Tier Credentials",
+        "operationId": "EditTierCredentials",
+        "parameters": [
+          {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tierCredentialsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/trace/recordings": {
+      "get": {
+        "tags": [
+          "Trace"
+        ],
+        "summary": "List Trace Recordings",
+        "operationId": "ListTraceRecordings",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTraceRecordingsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/trace/recordings/{id}": {
+      "delete": {
+        "tags": [
+          "Trace"
+        ],
+        "summary": "Delete Trace Recording",
+        "operationId": "DeleteTraceRecording",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/trace/recordings/{id}/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Trace"
+        ],
+        "summary": "Download Trace Recording",
+        "operationId": "DownloadTraceRecording",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/trace/recordings/{id}/summary": {
+      "get": {
+        "tags": [
+          "Trace"
+        ],
+        "summary": "Trace Recording Summary",
+        "operationId": "TraceRecordingSummary",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/traceRecordingSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/bucket-policy/{bucket}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Policies With Given Bucket",
+        "operationId": "ListPoliciesWithBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/bucket-users/{bucket}": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Users With Access to a Given Bucket",
+        "operationId": "ListUsersWithAccessToBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/bucket/{bucket}/access-rules": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Access Rules With Given Bucket",
+        "operationId": "ListAccessRulesWithBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listAccessRulesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Access Rule To Given Bucket",
+        "operationId": "SetAccessRuleWithBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "prefixaccess",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/prefixAccessPair"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Access Rule From Given Bucket",
+        "operationId": "DeleteAccessRuleWithBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "prefix",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/prefixWrapper"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "boolean"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/makeBucketRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets-replication": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Sets Multi Bucket Replication in multiple Buckets",
+        "operationId": "SetMultiBucketReplication",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/multiBucketReplication"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/multiBucketResponseState"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/multi-lifecycle": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Multi Bucket Lifecycle",
+        "operationId": "AddMultiBucketLifecycle",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addMultiBucketLifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/multiLifecycleResult"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/delete-all-replication-rules": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Deletes all replication rules from a bucket",
+        "operationId": "DeleteAllReplicationRules",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/delete-objects": {
+      "post": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Delete Multiple Objects",
+        "operationId": "DeleteMultipleObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "name": "files",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/deleteFile"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/delete-selected-replication-rules": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Deletes selected replication rules from a bucket",
+        "operationId": "DeleteSelectedReplicationRules",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "rules",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRuleList"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/encryption/disable": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Disable bucket encryption.",
+        "operationId": "DisableBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/encryption/enable": {
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Enable bucket encryption.",
+        "operationId": "EnableBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/encryption/info": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Get bucket encryption information.",
+        "operationId": "GetBucketEncryptionInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionInfo"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/events": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "List Bucket Events",
+        "operationId": "ListBucketEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketEventsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Create Bucket Event",
+        "operationId": "CreateBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEventRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/events/{arn}": {
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Bucket Event",
+        "operationId": "DeleteBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationDeleteRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Lifecycle",
+        "operationId": "GetBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Add Bucket Lifecycle",
+        "operationId": "AddBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addBucketLifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
+      "put": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Update Lifecycle rule",
+        "operationId": "UpdateBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateBucketLifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Delete Lifecycle rule",
+        "operationId": "DeleteBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/object-locking": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Returns the status of object locking support on the bucket",
+        "operationId": "GetBucketObjectLockingStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketObLockingResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "List Objects",
+        "operationId": "ListObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_metadata",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "path",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "non_current_versions",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Download Object",
+        "operationId": "Download Object",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "name": "preview",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "default": "",
+            "name": "override_file_name",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/legalhold": {
+      "put": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Put Object's legalhold status",
+        "operationId": "PutObjectLegalHold",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/metadata": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Gets the metadata of an object",
+        "operationId": "GetObjectMetadata",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/metadata"
             }
           },
           "default": {
@@ -7460,29 +14273,86 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/restore": {
+      "put": {
         "tags": [
-          "SiteReplication"
+          "Object"
         ],
-        "summary": "Add a Replication Site",
-        "operationId": "SiteReplicationInfoAdd",
+        "summary": "Restore Object to a selected version",
+        "operationId": "PutObjectRestore",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Put Object's retention status",
+        "operationId": "PutObjectRetention",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/siteReplicationAddRequest"
+              "$ref": "#/definitions/putObjectRetentionRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/siteReplicationAddResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -7494,25 +14364,80 @@ func init() {
       },
       "delete": {
         "tags": [
-          "SiteReplication"
+          "Object"
         ],
-        "summary": "Remove a Replication Site",
-        "operationId": "SiteReplicationRemove",
+        "summary": "Delete Object retention from an object",
+        "operationId": "DeleteObjectRetention",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
             "schema": {
-              "$ref": "#/definitions/peerInfoRemove"
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/share": {
+      "get": {
+        "tags": [
+          "Object"
+        ],
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "expires",
+            "in": "query"
+          }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/peerSiteRemoveResponse"
+              "type": "string"
             }
           },
           "default": {
@@ -7524,60 +14449,109 @@ func init() {
         }
       }
     },
-    "/admin/site-replication/status": {
-      "get": {
+    "/buckets/{bucket_name}/objects/tags": {
+      "put": {
         "tags": [
-          "SiteReplication"
+          "Object"
         ],
-        "summary": "Display overall site replication status",
-        "operationId": "GetSiteReplicationStatus",
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
         "parameters": [
           {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Bucket stats",
-            "name": "buckets",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Group stats",
-            "name": "groups",
-            "in": "query"
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Policies stats",
-            "name": "policies",
-            "in": "query"
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "Include Policies stats",
-            "name": "users",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectTagsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "Object"
+        ],
+        "summary": "Uploads an Object.",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Entity Type to lookup",
-            "name": "entityType",
+            "name": "prefix",
             "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication": {
+      "get": {
+        "tags": [
+          "Bucket"
+        ],
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplication",
+        "parameters": [
           {
             "type": "string",
-            "description": "Entity Value to lookup",
-            "name": "entityValue",
-            "in": "query"
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/siteReplicationStatusResponse"
+              "$ref": "#/definitions/bucketReplicationResponse"
             }
           },
           "default": {
@@ -7589,18 +14563,32 @@ func init() {
         }
       }
     },
-    "/admin/tiers": {
+    "/buckets/{bucket_name}/replication/{rule_id}": {
       "get": {
         "tags": [
-          "Tiering"
+          "Bucket"
+        ],
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns a list of tiers for ilm",
-        "operationId": "TiersList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tierListResponse"
+              "$ref": "#/definitions/bucketReplicationRule"
             }
           },
           "default": {
@@ -7611,19 +14599,31 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "Tiering"
+          "Bucket"
         ],
-        "summary": "Allows to configure a new tier",
-        "operationId": "AddTier",
+        "summary": "Update Replication rule",
+        "operationId": "UpdateMultiBucketReplication",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/multiBucketReplicationEdit"
             }
           }
         ],
@@ -7638,40 +14638,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/admin/tiers/{type}/{name}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Tiering"
+          "Bucket"
         ],
-        "summary": "Get Tier",
-        "operationId": "GetTier",
+        "summary": "Bucket Replication Rule Delete",
+        "operationId": "DeleteBucketReplicationRule",
         "parameters": [
           {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure"
-            ],
             "type": "string",
-            "name": "type",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "name",
+            "name": "rule_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/tier"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -7682,43 +14672,27 @@ func init() {
         }
       }
     },
-    "/admin/tiers/{type}/{name}/credentials": {
-      "put": {
+    "/buckets/{bucket_name}/retention": {
+      "get": {
         "tags": [
-          "Tiering"
+          "Bucket"
         ],
-        "summary": "Edit Tier Credentials",
-        "operationId": "EditTierCredentials",
+        "summary": "Get Bucket's retention config",
+        "operationId": "GetBucketRetentionConfig",
         "parameters": [
-          {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure"
-            ],
-            "type": "string",
-            "name": "type",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/tierCredentialsRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/getBucketRetentionConfig"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -7727,41 +14701,32 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/bucket-policy/{bucket}": {
-      "get": {
+      },
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Policies With Given Bucket",
-        "operationId": "ListPoliciesWithBucket",
+        "summary": "Set Bucket's retention config",
+        "operationId": "SetBucketRetentionConfig",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -7772,30 +14737,29 @@ func init() {
         }
       }
     },
-    "/bucket-users/{bucket}": {
+    "/buckets/{bucket_name}/rewind/{date}": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Users With Access to a Given Bucket",
-        "operationId": "ListUsersWithAccessToBucket",
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "date",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
+            "type": "string",
+            "name": "prefix",
             "in": "query"
           }
         ],
@@ -7803,10 +14767,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/rewindResponse"
             }
           },
           "default": {
@@ -7818,39 +14779,32 @@ func init() {
         }
       }
     },
-    "/bucket/{bucket}/access-rules": {
-      "get": {
+    "/buckets/{bucket_name}/tags": {
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Access Rules With Given Bucket",
-        "operationId": "ListAccessRulesWithBucket",
+        "summary": "Put Bucket's tags",
+        "operationId": "PutBucketTags",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketTagsRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listAccessRulesResponse"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -7859,34 +14813,28 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/versioning": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Add Access Rule To Given Bucket",
-        "operationId": "SetAccessRuleWithBucket",
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "bucket_name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "prefixaccess",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/prefixAccessPair"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "boolean"
+              "$ref": "#/definitions/bucketVersioningResponse"
             }
           },
           "default": {
@@ -7897,34 +14845,31 @@ func init() {
           }
         }
       },
-      "delete": {
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Delete Access Rule From Given Bucket",
-        "operationId": "DeleteAccessRuleWithBucket",
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "prefix",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/prefixWrapper"
+              "$ref": "#/definitions/setBucketVersioning"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "boolean"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -7935,18 +14880,26 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/buckets/{name}": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -7957,24 +14910,22 @@ func init() {
           }
         }
       },
-      "post": {
+      "delete": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -7986,28 +14937,26 @@ func init() {
         }
       }
     },
-    "/buckets-replication": {
-      "post": {
+    "/buckets/{name}/quota": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Sets Multi Bucket Replication in multiple Buckets",
-        "operationId": "SetMultiBucketReplication",
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplication"
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiBucketResponseState"
+              "$ref": "#/definitions/bucketQuota"
             }
           },
           "default": {
@@ -8017,22 +14966,26 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/multi-lifecycle": {
-      "post": {
+      },
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Add Multi Bucket Lifecycle",
-        "operationId": "AddMultiBucketLifecycle",
+        "summary": "Bucket Quota",
+        "operationId": "SetBucketQuota",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addMultiBucketLifecycle"
+              "$ref": "#/definitions/setBucketQuota"
             }
           }
         ],
@@ -8040,7 +14993,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiLifecycleResult"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -8052,24 +15005,35 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-all-replication-rules": {
-      "delete": {
+    "/buckets/{name}/set-policy": {
+      "put": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Deletes all replication rules from a bucket",
-        "operationId": "DeleteAllReplicationRules",
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketPolicyRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8080,40 +15044,20 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-objects": {
-      "post": {
+    "/check-version": {
+      "get": {
+        "security": [],
         "tags": [
-          "Object"
-        ],
-        "summary": "Delete Multiple Objects",
-        "operationId": "DeleteMultipleObjects",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
-          },
-          {
-            "name": "files",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/deleteFile"
-              }
-            }
-          }
+          "System"
         ],
+        "summary": "Checks the current MinIO version against the latest",
+        "operationId": "CheckMinIOVersion",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/checkVersionResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8124,32 +15068,33 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/delete-selected-replication-rules": {
-      "delete": {
+    "/configs": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Deletes selected replication rules from a bucket",
-        "operationId": "DeleteSelectedReplicationRules",
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
           },
           {
-            "name": "rules",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketReplicationRuleList"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listConfigResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8160,24 +15105,27 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/disable": {
-      "post": {
+    "/configs/{name}": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Disable bucket encryption.",
-        "operationId": "DisableBucketEncryption",
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configuration"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8186,19 +15134,17 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/encryption/enable": {
-      "post": {
+      },
+      "put": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Enable bucket encryption.",
-        "operationId": "EnableBucketEncryption",
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           },
@@ -8207,13 +15153,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionRequest"
+              "$ref": "#/definitions/setConfigRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8224,17 +15173,17 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/info": {
-      "get": {
+    "/configs/{name}/reset": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Configuration"
         ],
-        "summary": "Get bucket encryption information.",
-        "operationId": "GetBucketEncryptionInfo",
+        "summary": "Configuration reset",
+        "operationId": "ResetConfig",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -8243,7 +15192,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionInfo"
+              "$ref": "#/definitions/setConfigResponse"
             }
           },
           "default": {
@@ -8255,38 +15204,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events": {
+    "/group/{name}": {
       "get": {
         "tags": [
-          "Bucket"
+          "Group"
         ],
-        "summary": "List Bucket Events",
-        "operationId": "ListBucketEvents",
+        "summary": "Group info",
+        "operationId": "GroupInfo",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketEventsResponse"
+              "$ref": "#/definitions/group"
             }
           },
           "default": {
@@ -8297,16 +15234,16 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "Bucket"
-        ],
-        "summary": "Create Bucket Event",
-        "operationId": "CreateBucketEvent",
+          "Group"
+        ],
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           },
@@ -8315,13 +15252,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bucketEventRequest"
+              "$ref": "#/definitions/updateGroupRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8330,35 +15270,19 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/events/{arn}": {
+      },
       "delete": {
         "tags": [
-          "Bucket"
+          "Group"
         ],
-        "summary": "Delete Bucket Event",
-        "operationId": "DeleteBucketEvent",
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "arn",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/notificationDeleteRequest"
-            }
           }
         ],
         "responses": {
@@ -8374,26 +15298,32 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle": {
+    "/groups": {
       "get": {
         "tags": [
-          "Bucket"
+          "Group"
         ],
-        "summary": "Bucket Lifecycle",
-        "operationId": "GetBucketLifecycle",
+        "summary": "List Groups",
+        "operationId": "ListGroups",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketLifecycleResponse"
+              "$ref": "#/definitions/listGroupsResponse"
             }
           },
           "default": {
@@ -8406,23 +15336,17 @@ func init() {
       },
       "post": {
         "tags": [
-          "Bucket"
+          "Group"
         ],
-        "summary": "Add Bucket Lifecycle",
-        "operationId": "AddBucketLifecycle",
+        "summary": "Add Group",
+        "operationId": "AddGroup",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addBucketLifecycle"
+              "$ref": "#/definitions/addGroupRequest"
             }
           }
         ],
@@ -8439,70 +15363,29 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
-      "put": {
+    "/list-external-buckets": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Update Lifecycle rule",
-        "operationId": "UpdateBucketLifecycle",
+        "summary": "Lists an External list of buckets using custom credentials",
+        "operationId": "ListExternalBuckets",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateBucketLifecycle"
+              "$ref": "#/definitions/listExternalBucketsParams"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/listBucketsResponse"
             }
-          }
-        }
-      },
-      "delete": {
-        "tags": [
-          "Bucket"
-        ],
-        "summary": "Delete Lifecycle rule",
-        "operationId": "DeleteBucketLifecycleRule",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -8513,26 +15396,19 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/object-locking": {
+    "/login": {
       "get": {
+        "security": [],
         "tags": [
-          "Bucket"
-        ],
-        "summary": "Returns the status of object locking support on the bucket",
-        "operationId": "GetBucketObjectLockingStatus",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
+          "Auth"
         ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketObLockingResponse"
+              "$ref": "#/definitions/loginDetails"
             }
           },
           "default": {
@@ -8542,49 +15418,27 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects": {
-      "get": {
+      },
+      "post": {
+        "security": [],
         "tags": [
-          "Object"
+          "Auth"
         ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
+        "summary": "Login to Console",
+        "operationId": "Login",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_versions",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_metadata",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
-            }
+          "204": {
+            "description": "A successful login."
           },
           "default": {
             "description": "Generic error response.",
@@ -8593,47 +15447,46 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
         "tags": [
-          "Object"
+          "Auth"
         ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "path",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "all_versions",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOauth2AuthRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful login."
           },
-          {
-            "type": "boolean",
-            "name": "non_current_versions",
-            "in": "query"
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
+        }
+      }
+    },
+    "/logout": {
+      "post": {
+        "tags": [
+          "Auth"
         ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
         "responses": {
           "200": {
             "description": "A successful response."
@@ -8647,44 +15500,67 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
+    "/logs/search": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
         "tags": [
-          "Object"
+          "Logging"
         ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
+        "summary": "Search the logs",
+        "operationId": "LogSearch",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 10,
+            "name": "pageSize",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "pageNo",
+            "in": "query"
           },
           {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
             "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
           },
           {
             "type": "string",
-            "name": "version_id",
+            "name": "timeStart",
             "in": "query"
           },
           {
-            "type": "boolean",
-            "default": false,
-            "name": "preview",
+            "type": "string",
+            "name": "timeEnd",
             "in": "query"
           },
           {
+            "enum": [
+              "reqinfo",
+              "raw"
+            ],
             "type": "string",
-            "default": "",
-            "name": "override_file_name",
+            "default": "reqinfo",
+            "description": "Query type, request info or raw log entries",
+            "name": "q",
             "in": "query"
           }
         ],
@@ -8692,7 +15568,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/logSearchResponse"
             }
           },
           "default": {
@@ -8704,80 +15580,74 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/legalhold": {
-      "put": {
+    "/logs/search/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Object"
+          "Logging"
         ],
-        "summary": "Put Object's legalhold status",
-        "operationId": "PutObjectLegalHold",
+        "summary": "Export the logs matching a search",
+        "operationId": "LogSearchExport",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
           },
           {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
             "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
           },
           {
             "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "name": "timeStart",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectLegalHoldRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/buckets/{bucket_name}/objects/metadata": {
-      "get": {
-        "tags": [
-          "Object"
-        ],
-        "summary": "Gets the metadata of an object",
-        "operationId": "GetObjectMetadata",
-        "parameters": [
           {
+            "enum": [
+              "reqinfo",
+              "raw"
+            ],
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "default": "reqinfo",
+            "description": "Query type, request info or raw log entries",
+            "name": "q",
+            "in": "query"
           },
           {
+            "enum": [
+              "csv",
+              "ndjson"
+            ],
             "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
+            "default": "ndjson",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/metadata"
+              "type": "file"
             }
           },
           "default": {
@@ -8789,36 +15659,22 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/restore": {
-      "put": {
+    "/nodes": {
+      "get": {
         "tags": [
-          "Object"
-        ],
-        "summary": "Restore Object to a selected version",
-        "operationId": "PutObjectRestore",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          }
+          "System"
         ],
+        "summary": "Lists Nodes",
+        "operationId": "ListNodes",
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8829,44 +15685,33 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/retention": {
-      "put": {
+    "/policies": {
+      "get": {
         "tags": [
-          "Object"
+          "Policy"
         ],
-        "summary": "Put Object's retention status",
-        "operationId": "PutObjectRetention",
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectRetentionRequest"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8875,36 +15720,29 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "Object"
-        ],
-        "summary": "Delete Object retention from an object",
-        "operationId": "DeleteObjectRetention",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
+      },
+      "post": {
+        "tags": [
+          "Policy"
+        ],
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
+        "parameters": [
           {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addPolicyRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -8915,43 +15753,28 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
-      "get": {
+    "/policies/lint": {
+      "post": {
         "tags": [
-          "Object"
+          "Policy"
         ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
+        "summary": "Lint a policy before saving it",
+        "operationId": "LintPolicy",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "expires",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policyLintRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/policyLintResult"
             }
           },
           "default": {
@@ -8963,44 +15786,29 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/tags": {
-      "put": {
+    "/policies/simulate": {
+      "post": {
         "tags": [
-          "Object"
+          "Policy"
         ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
+        "summary": "Simulate whether a user or service account can run an action on a resource",
+        "operationId": "SimulatePolicy",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
+              "$ref": "#/definitions/policySimulationRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policySimulationResult"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9011,31 +15819,30 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
-        ],
+    "/policies/{policy}/groups": {
+      "get": {
         "tags": [
-          "Object"
+          "Policy"
         ],
-        "summary": "Uploads an Object.",
+        "summary": "List Groups for a Policy",
+        "operationId": "ListGroupsForPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "policy",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9046,17 +15853,17 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication": {
+    "/policies/{policy}/users": {
       "get": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplication",
+        "summary": "List Users for a Policy",
+        "operationId": "ListUsersForPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "policy",
             "in": "path",
             "required": true
           }
@@ -9065,7 +15872,10 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationResponse"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -9077,32 +15887,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication/{rule_id}": {
+    "/policy-templates": {
       "get": {
         "tags": [
-          "Bucket"
-        ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplicationRule",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
-          }
+          "Policy"
         ],
+        "summary": "List the built-in and custom policy templates",
+        "operationId": "ListPolicyTemplates",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketReplicationRule"
+              "$ref": "#/definitions/policyTemplateList"
             }
           },
           "default": {
@@ -9113,37 +15909,28 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Update Replication rule",
-        "operationId": "UpdateMultiBucketReplication",
+        "summary": "Register a custom policy template",
+        "operationId": "CreatePolicyTemplate",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/multiBucketReplicationEdit"
+              "$ref": "#/definitions/policyTemplate"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyTemplate"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9152,23 +15939,19 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/policy-templates/{id}": {
       "delete": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Bucket Replication Rule Delete",
-        "operationId": "DeleteBucketReplicationRule",
+        "summary": "Delete a custom policy template",
+        "operationId": "DeletePolicyTemplate",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "rule_id",
+            "name": "id",
             "in": "path",
             "required": true
           }
@@ -9186,26 +15969,34 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/retention": {
-      "get": {
+    "/policy-templates/{id}/render": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Get Bucket's retention config",
-        "operationId": "GetBucketRetentionConfig",
+        "summary": "Render a policy template, optionally saving and attaching the policy",
+        "operationId": "RenderPolicyTemplate",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policyTemplateRenderRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/getBucketRetentionConfig"
+              "$ref": "#/definitions/policyTemplateRenderResult"
             }
           },
           "default": {
@@ -9215,32 +16006,29 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/policy/{name}": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Set Bucket's retention config",
-        "operationId": "SetBucketRetentionConfig",
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putBucketRetentionRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9249,40 +16037,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/rewind/{date}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "date",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/rewindResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9293,32 +16065,27 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/tags": {
-      "put": {
+    "/policy/{name}/history": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Put Bucket's tags",
-        "operationId": "PutBucketTags",
+        "summary": "List the revisions of a policy",
+        "operationId": "ListPolicyRevisions",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putBucketTagsRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyHistory"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9329,26 +16096,40 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
+    "/policy/{name}/history/diff": {
       "get": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
+        "summary": "Compare two revisions of a policy",
+        "operationId": "DiffPolicyRevisions",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "defaults to the latest revision",
+            "name": "to",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
+              "$ref": "#/definitions/policyRevisionDiff"
             }
           },
           "default": {
@@ -9358,32 +16139,36 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/policy/{name}/history/{revision}/rollback": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
+        "summary": "Restore a previous revision of a policy",
+        "operationId": "RollbackPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "revision",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policyRevision"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9394,26 +16179,28 @@ func init() {
         }
       }
     },
-    "/buckets/{name}": {
-      "get": {
+    "/profiling/start": {
+      "post": {
         "tags": [
-          "Bucket"
+          "Profile"
         ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
+        "summary": "Start recording profile data",
+        "operationId": "ProfilingStart",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/profilingStartRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/startProfilingList"
             }
           },
           "default": {
@@ -9423,24 +16210,24 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "Bucket"
+      }
+    },
+    "/profiling/stop": {
+      "post": {
+        "produces": [
+          "application/zip"
         ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
+        "tags": [
+          "Profile"
         ],
+        "summary": "Stop and download profile data",
+        "operationId": "ProfilingStop",
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -9451,26 +16238,18 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/quota": {
+    "/remote-buckets": {
       "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "List Remote Buckets",
+        "operationId": "ListRemoteBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketQuota"
+              "$ref": "#/definitions/listRemoteBucketsResponse"
             }
           },
           "default": {
@@ -9481,34 +16260,25 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Quota",
-        "operationId": "SetBucketQuota",
+        "summary": "Add Remote Bucket",
+        "operationId": "AddRemoteBucket",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketQuota"
+              "$ref": "#/definitions/createRemoteBucket"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+          "201": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9519,34 +16289,26 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/set-policy": {
-      "put": {
+    "/remote-buckets/{name}": {
+      "get": {
         "tags": [
           "Bucket"
         ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
+        "summary": "Remote Bucket Details",
+        "operationId": "RemoteBucketDetails",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
-            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/remoteBucket"
             }
           },
           "default": {
@@ -9558,20 +16320,30 @@ func init() {
         }
       }
     },
-    "/check-version": {
-      "get": {
-        "security": [],
+    "/remote-buckets/{source-bucket-name}/{arn}": {
+      "delete": {
         "tags": [
-          "System"
+          "Bucket"
+        ],
+        "summary": "Delete Remote Bucket",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "source-bucket-name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Checks the current MinIO version against the latest",
-        "operationId": "CheckMinIOVersion",
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/checkVersionResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9582,32 +16354,28 @@ func init() {
         }
       }
     },
-    "/configs": {
-      "get": {
+    "/service-account-credentials": {
+      "post": {
         "tags": [
-          "Configuration"
+          "ServiceAccount"
         ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
+        "summary": "Create Service Account With Credentials",
+        "operationId": "CreateServiceAccountCreds",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequestCreds"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listConfigResponse"
+              "$ref": "#/definitions/serviceAccountCreds"
             }
           },
           "default": {
@@ -9619,26 +16387,32 @@ func init() {
         }
       }
     },
-    "/configs/{name}": {
+    "/service-accounts": {
       "get": {
         "tags": [
-          "Configuration"
+          "ServiceAccount"
         ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/configuration"
+              "$ref": "#/definitions/serviceAccounts"
             }
           },
           "default": {
@@ -9649,33 +16423,27 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "tags": [
-          "Configuration"
+          "ServiceAccount"
         ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setConfigRequest"
+              "$ref": "#/definitions/serviceAccountRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setConfigResponse"
+              "$ref": "#/definitions/serviceAccountCreds"
             }
           },
           "default": {
@@ -9687,27 +16455,29 @@ func init() {
         }
       }
     },
-    "/configs/{name}/reset": {
-      "post": {
+    "/service-accounts/delete-multi": {
+      "delete": {
         "tags": [
-          "Configuration"
+          "ServiceAccount"
         ],
-        "summary": "Configuration reset",
-        "operationId": "ResetConfig",
+        "summary": "Delete Multiple Service Accounts",
+        "operationId": "DeleteMultipleServiceAccounts",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "selectedSA",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setConfigResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9718,26 +16488,27 @@ func init() {
         }
       }
     },
-    "/group/{name}": {
+    "/service-accounts/expiring": {
       "get": {
         "tags": [
-          "Group"
+          "ServiceAccount"
         ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
+        "summary": "List the Service Accounts expiring soon",
+        "operationId": "ListExpiringServiceAccounts",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "default": "168h",
+            "description": "include the service accounts expiring within this duration",
+            "name": "within",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/serviceAccountExpiryList"
             }
           },
           "default": {
@@ -9747,61 +16518,30 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/service-accounts/stale": {
+      "get": {
         "tags": [
-          "Group"
+          "ServiceAccount"
         ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
+        "summary": "List the Service Accounts not used recently",
+        "operationId": "ListStaleServiceAccounts",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
-            }
+            "default": "720h",
+            "description": "include the service accounts not used within this duration",
+            "name": "unusedFor",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/serviceAccountUsageList"
             }
-          }
-        }
-      },
-      "delete": {
-        "tags": [
-          "Group"
-        ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9812,32 +16552,18 @@ func init() {
         }
       }
     },
-    "/groups": {
+    "/service-accounts/usage": {
       "get": {
         "tags": [
-          "Group"
-        ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
+          "ServiceAccount"
         ],
+        "summary": "List the usage of the user's Service Accounts",
+        "operationId": "ListUserServiceAccountsUsage",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
+              "$ref": "#/definitions/serviceAccountUsageList"
             }
           },
           "default": {
@@ -9847,25 +16573,25 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/service-accounts/{access_key}": {
+      "delete": {
         "tags": [
-          "Group"
+          "ServiceAccount"
         ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addGroupRequest"
-            }
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -9877,20 +16603,26 @@ func init() {
         }
       }
     },
-    "/list-external-buckets": {
-      "post": {
+    "/service-accounts/{access_key}/expiry": {
+      "put": {
         "tags": [
-          "Bucket"
+          "ServiceAccount"
         ],
-        "summary": "Lists an External list of buckets using custom credentials",
-        "operationId": "ListExternalBuckets",
+        "summary": "Set or remove the expiration of a Service Account",
+        "operationId": "SetServiceAccountExpiry",
         "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/listExternalBucketsParams"
+              "$ref": "#/definitions/serviceAccountExpiryRequest"
             }
           }
         ],
@@ -9898,7 +16630,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/serviceAccountExpiry"
             }
           },
           "default": {
@@ -9910,19 +16642,26 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/service-accounts/{access_key}/policy": {
       "get": {
-        "security": [],
         "tags": [
-          "Auth"
+          "ServiceAccount"
+        ],
+        "summary": "Get Service Account Policy",
+        "operationId": "GetServiceAccountPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "type": "string"
             }
           },
           "default": {
@@ -9933,26 +16672,31 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [],
+      "put": {
         "tags": [
-          "Auth"
+          "ServiceAccount"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
+        "summary": "Set Service Account Policy",
+        "operationId": "SetServiceAccountPolicy",
         "parameters": [
           {
-            "name": "body",
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "policy",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginRequest"
+              "$ref": "#/definitions/addServiceAccountPolicyRequest"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful login."
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -9963,47 +16707,35 @@ func init() {
         }
       }
     },
-    "/login/oauth2/auth": {
+    "/service-accounts/{access_key}/rotate": {
       "post": {
-        "security": [],
         "tags": [
-          "Auth"
+          "ServiceAccount"
         ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
+        "summary": "Rotate a Service Account into a new one, keeping the old credentials valid for a grace period",
+        "operationId": "RotateServiceAccount",
         "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
-            }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful login."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/serviceAccountRotateRequest"
             }
           }
-        }
-      }
-    },
-    "/logout": {
-      "post": {
-        "tags": [
-          "Auth"
-        ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
+        ],
         "responses": {
-          "200": {
-            "description": "A successful response."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRotation"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10014,60 +16746,16 @@ func init() {
         }
       }
     },
-    "/logs/search": {
-      "get": {
+    "/service/restart": {
+      "post": {
         "tags": [
-          "Logging"
-        ],
-        "summary": "Search the logs",
-        "operationId": "LogSearch",
-        "parameters": [
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Filter Parameters",
-            "name": "fp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 10,
-            "name": "pageSize",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "pageNo",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "timeDesc",
-              "timeAsc"
-            ],
-            "type": "string",
-            "default": "timeDesc",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "timeStart",
-            "in": "query"
-          }
+          "Service"
         ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/logSearchResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -10078,21 +16766,18 @@ func init() {
         }
       }
     },
-    "/nodes": {
+    "/session": {
       "get": {
         "tags": [
-          "System"
+          "Auth"
         ],
-        "summary": "Lists Nodes",
-        "operationId": "ListNodes",
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/sessionResponse"
             }
           },
           "default": {
@@ -10104,33 +16789,26 @@ func init() {
         }
       }
     },
-    "/policies": {
-      "get": {
+    "/set-policy": {
+      "put": {
         "tags": [
           "Policy"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
+        "summary": "Set policy",
+        "operationId": "SetPolicy",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyNameRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -10139,29 +16817,28 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/set-policy-multi": {
+      "put": {
         "tags": [
           "Policy"
         ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
+        "summary": "Set policy to multiple users/groups",
+        "operationId": "SetPolicyMultiple",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
+              "$ref": "#/definitions/setPolicyMultipleNameRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -10172,29 +16849,18 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/groups": {
+    "/subnet/info": {
       "get": {
         "tags": [
-          "Policy"
-        ],
-        "summary": "List Groups for a Policy",
-        "operationId": "ListGroupsForPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "policy",
-            "in": "path",
-            "required": true
-          }
+          "Subnet"
         ],
+        "summary": "Subnet info",
+        "operationId": "SubnetInfo",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/license"
             }
           },
           "default": {
@@ -10206,29 +16872,28 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/users": {
-      "get": {
+    "/subnet/login": {
+      "post": {
         "tags": [
-          "Policy"
+          "Subnet"
         ],
-        "summary": "List Users for a Policy",
-        "operationId": "ListUsersForPolicy",
+        "summary": "Login to subnet",
+        "operationId": "SubnetLogin",
         "parameters": [
           {
-            "type": "string",
-            "name": "policy",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetLoginRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/subnetLoginResponse"
             }
           },
           "default": {
@@ -10240,26 +16905,28 @@ func init() {
         }
       }
     },
-    "/policy/{name}": {
-      "get": {
+    "/subnet/login/mfa": {
+      "post": {
         "tags": [
-          "Policy"
+          "Subnet"
         ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
+        "summary": "Login to subnet using mfa",
+        "operationId": "SubnetLoginMFA",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetLoginMFARequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/policy"
+              "$ref": "#/definitions/subnetLoginResponse"
             }
           },
           "default": {
@@ -10269,23 +16936,27 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/subnet/register": {
+      "post": {
         "tags": [
-          "Policy"
+          "Subnet"
         ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
+        "summary": "Register cluster with Subnet",
+        "operationId": "SubnetRegister",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/subnetRegisterRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -10297,28 +16968,41 @@ func init() {
         }
       }
     },
-    "/profiling/start": {
-      "post": {
+    "/subnet/registration-token": {
+      "get": {
         "tags": [
-          "Profile"
+          "Subnet"
         ],
-        "summary": "Start recording profile data",
-        "operationId": "ProfilingStart",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+        "summary": "Subnet registraton token",
+        "operationId": "SubnetRegToken",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/profilingStartRequest"
+              "$ref": "#/definitions/SubnetRegTokenResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/user/policy": {
+      "get": {
+        "tags": [
+          "Policy"
         ],
+        "summary": "returns policies for logged in user",
+        "operationId": "GetUserPolicy",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/startProfilingList"
+              "type": "string"
             }
           },
           "default": {
@@ -10330,21 +17014,26 @@ func init() {
         }
       }
     },
-    "/profiling/stop": {
-      "post": {
-        "produces": [
-          "application/zip"
-        ],
+    "/user/{name}": {
+      "get": {
         "tags": [
-          "Profile"
+          "User"
+        ],
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Stop and download profile data",
-        "operationId": "ProfilingStop",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -10354,20 +17043,34 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/remote-buckets": {
-      "get": {
+      },
+      "put": {
         "tags": [
-          "Bucket"
+          "User"
+        ],
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUser"
+            }
+          }
         ],
-        "summary": "List Remote Buckets",
-        "operationId": "ListRemoteBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listRemoteBucketsResponse"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -10378,24 +17081,22 @@ func init() {
           }
         }
       },
-      "post": {
+      "delete": {
         "tags": [
-          "Bucket"
+          "User"
         ],
-        "summary": "Add Remote Bucket",
-        "operationId": "AddRemoteBucket",
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createRemoteBucket"
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -10407,26 +17108,34 @@ func init() {
         }
       }
     },
-    "/remote-buckets/{name}": {
-      "get": {
+    "/user/{name}/groups": {
+      "put": {
         "tags": [
-          "Bucket"
+          "User"
         ],
-        "summary": "Remote Bucket Details",
-        "operationId": "RemoteBucketDetails",
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
         "parameters": [
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/remoteBucket"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -10438,30 +17147,27 @@ func init() {
         }
       }
     },
-    "/remote-buckets/{source-bucket-name}/{arn}": {
-      "delete": {
+    "/user/{name}/policies": {
+      "get": {
         "tags": [
-          "Bucket"
+          "Policy"
         ],
-        "summary": "Delete Remote Bucket",
-        "operationId": "DeleteRemoteBucket",
+        "summary": "returns policies assigned for a specified user",
+        "operationId": "GetSAUserPolicy",
         "parameters": [
           {
             "type": "string",
-            "name": "source-bucket-name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "arn",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/aUserPolicyResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10472,14 +17178,20 @@ func init() {
         }
       }
     },
-    "/service-account-credentials": {
+    "/user/{name}/service-account-credentials": {
       "post": {
         "tags": [
-          "ServiceAccount"
+          "User"
         ],
-        "summary": "Create Service Account With Credentials",
-        "operationId": "CreateServiceAccountCreds",
+        "summary": "Create Service Account for User With Credentials",
+        "operationId": "CreateServiceAccountCredentials",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
@@ -10505,25 +17217,19 @@ func init() {
         }
       }
     },
-    "/service-accounts": {
+    "/user/{name}/service-accounts": {
       "get": {
         "tags": [
-          "ServiceAccount"
+          "User"
         ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
+        "summary": "returns a list of service accounts for a user",
+        "operationId": "ListAUserServiceAccounts",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -10543,11 +17249,17 @@ func init() {
       },
       "post": {
         "tags": [
-          "ServiceAccount"
+          "User"
         ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
+        "summary": "Create Service Account for User",
+        "operationId": "CreateAUserServiceAccount",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
@@ -10573,29 +17285,27 @@ func init() {
         }
       }
     },
-    "/service-accounts/delete-multi": {
-      "delete": {
+    "/user/{name}/service-accounts/usage": {
+      "get": {
         "tags": [
-          "ServiceAccount"
+          "User"
         ],
-        "summary": "Delete Multiple Service Accounts",
-        "operationId": "DeleteMultipleServiceAccounts",
+        "summary": "returns the usage of the service accounts of a user",
+        "operationId": "ListAUserServiceAccountsUsage",
         "parameters": [
           {
-            "name": "selectedSA",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountUsageList"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10606,24 +17316,33 @@ func init() {
         }
       }
     },
-    "/service-accounts/{access_key}": {
-      "delete": {
+    "/users": {
+      "get": {
         "tags": [
-          "ServiceAccount"
+          "User"
         ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
+        "summary": "List Users",
+        "operationId": "ListUsers",
         "parameters": [
           {
-            "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10632,28 +17351,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/service-accounts/{access_key}/policy": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "ServiceAccount"
+          "User"
         ],
-        "summary": "Get Service Account Policy",
-        "operationId": "GetServiceAccountPolicy",
+        "summary": "Add User",
+        "operationId": "AddUser",
         "parameters": [
           {
-            "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -10663,26 +17382,22 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/users-groups-bulk": {
       "put": {
         "tags": [
-          "ServiceAccount"
+          "User"
         ],
-        "summary": "Set Service Account Policy",
-        "operationId": "SetServiceAccountPolicy",
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
         "parameters": [
           {
-            "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "policy",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addServiceAccountPolicyRequest"
+              "$ref": "#/definitions/bulkUserGroups"
             }
           }
         ],
@@ -10699,16 +17414,29 @@ func init() {
         }
       }
     },
-    "/service/restart": {
+    "/users/import": {
       "post": {
         "tags": [
-          "Service"
+          "User"
+        ],
+        "summary": "Create or update users in bulk from CSV or JSON",
+        "operationId": "ImportUsers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/usersImportRequest"
+            }
+          }
         ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/usersImportResult"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10719,18 +17447,29 @@ func init() {
         }
       }
     },
-    "/session": {
+    "/users/import/secrets/{id}": {
       "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "Auth"
+          "User"
+        ],
+        "summary": "Download once the secret keys generated by a bulk import",
+        "operationId": "DownloadImportedUsersSecrets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/sessionResponse"
+              "type": "file"
             }
           },
           "default": {
@@ -10742,26 +17481,32 @@ func init() {
         }
       }
     },
-    "/set-policy": {
-      "put": {
+    "/users/service-accounts": {
+      "post": {
         "tags": [
-          "Policy"
+          "User"
         ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
+        "summary": "Check number of service accounts for each user specified",
+        "operationId": "CheckUserServiceAccounts",
         "parameters": [
           {
-            "name": "body",
+            "name": "selectedUsers",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setPolicyNameRequest"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/userServiceAccountSummary"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -10771,632 +17516,925 @@ func init() {
           }
         }
       }
+    }
+  },
+  "definitions": {
+    "BucketDetails": {
+      "type": "object",
+      "properties": {
+        "locking": {
+          "type": "boolean"
+        },
+        "quota": {
+          "type": "object",
+          "properties": {
+            "quota": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "type": {
+              "type": "string",
+              "enum": [
+                "hard"
+              ]
+            }
+          }
+        },
+        "replication": {
+          "type": "boolean"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "versioning": {
+          "type": "boolean"
+        },
+        "versioningSuspended": {
+          "type": "boolean"
+        }
+      }
+    },
+    "BucketDetailsQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard"
+          ]
+        }
+      }
+    },
+    "BucketRwAccess": {
+      "type": "object",
+      "properties": {
+        "read": {
+          "type": "boolean"
+        },
+        "write": {
+          "type": "boolean"
+        }
+      }
+    },
+    "LoginRequestFeatures": {
+      "type": "object",
+      "properties": {
+        "hide_menu": {
+          "type": "boolean"
+        }
+      }
+    },
+    "SubnetRegTokenResponse": {
+      "type": "object",
+      "properties": {
+        "regToken": {
+          "type": "string"
+        }
+      }
+    },
+    "WidgetDetailsOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "type": "object",
+          "properties": {
+            "calcs": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "WidgetDetailsOptionsReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "WidgetOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "type": "object",
+          "properties": {
+            "calcs": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      }
+    },
+    "WidgetOptionsReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "aUserPolicyResponse": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "accessGrant": {
+      "type": "object",
+      "properties": {
+        "alreadyAttached": {
+          "description": "the policy was attached before the grant, so it is kept when the grant ends",
+          "type": "boolean"
+        },
+        "approver": {
+          "type": "string"
+        },
+        "endedAt": {
+          "description": "when the policy was detached",
+          "type": "string"
+        },
+        "entity": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
+        },
+        "error": {
+          "description": "last error detaching the policy, retried by the scheduler",
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "grantedAt": {
+          "type": "string"
+        },
+        "grantedBy": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "revokedBy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "active",
+            "expired",
+            "revoked"
+          ]
+        }
+      }
     },
-    "/set-policy-multi": {
-      "put": {
-        "tags": [
-          "Policy"
-        ],
-        "summary": "Set policy to multiple users/groups",
-        "operationId": "SetPolicyMultiple",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setPolicyMultipleNameRequest"
-            }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "accessGrantList": {
+      "type": "object",
+      "properties": {
+        "grants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/accessGrant"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/subnet/info": {
-      "get": {
-        "tags": [
-          "Subnet"
-        ],
-        "summary": "Subnet info",
-        "operationId": "SubnetInfo",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/license"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "accessGrantRequest": {
+      "type": "object",
+      "required": [
+        "policy",
+        "entityType",
+        "entity",
+        "duration",
+        "reason",
+        "approver"
+      ],
+      "properties": {
+        "approver": {
+          "type": "string"
+        },
+        "duration": {
+          "description": "how long the policy stays attached, as a duration like 4h or 30m",
+          "type": "string"
+        },
+        "entity": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group"
+          ]
+        },
+        "policy": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
         }
       }
     },
-    "/subnet/login": {
-      "post": {
-        "tags": [
-          "Subnet"
-        ],
-        "summary": "Login to subnet",
-        "operationId": "SubnetLogin",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/subnetLoginRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/subnetLoginResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "accessRule": {
+      "type": "object",
+      "properties": {
+        "access": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
         }
       }
     },
-    "/subnet/login/mfa": {
-      "post": {
-        "tags": [
-          "Subnet"
-        ],
-        "summary": "Login to subnet using mfa",
-        "operationId": "SubnetLoginMFA",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/subnetLoginMFARequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/subnetLoginResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "accountChangePasswordRequest": {
+      "type": "object",
+      "required": [
+        "current_secret_key",
+        "new_secret_key"
+      ],
+      "properties": {
+        "current_secret_key": {
+          "type": "string"
+        },
+        "new_secret_key": {
+          "type": "string"
         }
       }
     },
-    "/subnet/register": {
-      "post": {
-        "tags": [
-          "Subnet"
-        ],
-        "summary": "Register cluster with Subnet",
-        "operationId": "SubnetRegister",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/subnetRegisterRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "addBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "disable": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
+        },
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
+          "type": "string",
+          "enum": [
+            "expiry",
+            "transition"
+          ]
+        }
+      }
+    },
+    "addBucketReplication": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "destination_bucket": {
+          "type": "string"
         }
       }
     },
-    "/subnet/registration-token": {
-      "get": {
-        "tags": [
-          "Subnet"
-        ],
-        "summary": "Subnet registraton token",
-        "operationId": "SubnetRegToken",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/SubnetRegTokenResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "addGroupRequest": {
+      "type": "object",
+      "required": [
+        "group",
+        "members"
+      ],
+      "properties": {
+        "group": {
+          "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/user/policy": {
-      "get": {
-        "tags": [
-          "Policy"
-        ],
-        "summary": "returns policies for logged in user",
-        "operationId": "GetUserPolicy",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "addMultiBucketLifecycle": {
+      "type": "object",
+      "required": [
+        "buckets",
+        "type"
+      ],
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
+        },
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "type": {
+          "description": "ILM Rule type (Expiry or transition)",
+          "type": "string",
+          "enum": [
+            "expiry",
+            "transition"
+          ]
         }
       }
     },
-    "/user/{name}": {
-      "get": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "addPolicyRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
         }
-      },
-      "put": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUser"
-            }
+      }
+    },
+    "addServiceAccountPolicyRequest": {
+      "type": "object",
+      "required": [
+        "policy"
+      ],
+      "properties": {
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey",
+        "groups",
+        "policies"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "secretKey": {
+          "type": "string"
         }
-      },
-      "delete": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+      }
+    },
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "prometheusNotReady": {
+          "type": "boolean"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serverProperties"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
           }
         }
       }
     },
-    "/user/{name}/groups": {
-      "put": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUserGroups"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "alert": {
+      "type": "object",
+      "properties": {
+        "acknowledged": {
+          "type": "boolean"
+        },
+        "acknowledgedAt": {
+          "type": "string"
+        },
+        "acknowledgedBy": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "notifiedAt": {
+          "type": "string"
+        },
+        "resolvedAt": {
+          "type": "string"
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "ruleName": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "startedAt": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "firing",
+            "resolved"
+          ]
+        },
+        "summary": {
+          "type": "string"
+        },
+        "value": {
+          "type": "number"
         }
       }
     },
-    "/user/{name}/policies": {
-      "get": {
-        "tags": [
-          "Policy"
-        ],
-        "summary": "returns policies assigned for a specified user",
-        "operationId": "GetSAUserPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/aUserPolicyResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "alertChannel": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "recipients": {
+          "description": "addresses of email channels",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "email",
+            "slack"
+          ]
+        },
+        "url": {
+          "description": "endpoint of webhook and slack channels",
+          "type": "string"
         }
       }
     },
-    "/user/{name}/service-account-credentials": {
-      "post": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Create Service Account for User With Credentials",
-        "operationId": "CreateServiceAccountCredentials",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceAccountRequestCreds"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "alertChannelList": {
+      "type": "object",
+      "properties": {
+        "channels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertChannel"
           }
         }
       }
     },
-    "/user/{name}/service-accounts": {
-      "get": {
-        "tags": [
-          "User"
-        ],
-        "summary": "returns a list of service accounts for a user",
-        "operationId": "ListAUserServiceAccounts",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccounts"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "alertList": {
+      "type": "object",
+      "properties": {
+        "alerts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alert"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
-      },
-      "post": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Create Service Account for User",
-        "operationId": "CreateAUserServiceAccount",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "alertRule": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "channels": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "disabled": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "query": {
+          "description": "PromQL expression of prometheus rules, the rule fires when it returns any series",
+          "type": "string"
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "silenceComment": {
+          "type": "string"
+        },
+        "silencedUntil": {
+          "type": "string"
+        },
+        "threshold": {
+          "description": "the rule fires when the value is over the threshold, capacity usage is a percentage",
+          "type": "number"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "offlineServers",
+            "offlineDrives",
+            "healingDrives",
+            "capacityUsage",
+            "prometheus"
+          ]
         }
       }
     },
-    "/users": {
-      "get": {
-        "tags": [
-          "User"
-        ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listUsersResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "alertRuleList": {
+      "type": "object",
+      "properties": {
+        "interval": {
+          "description": "evaluation interval, empty when the alert engine is disabled",
+          "type": "string"
+        },
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
           }
         }
-      },
-      "post": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Add User",
-        "operationId": "AddUser",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addUserRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "alertSilence": {
+      "type": "object",
+      "required": [
+        "duration"
+      ],
+      "properties": {
+        "comment": {
+          "type": "string"
+        },
+        "duration": {
+          "description": "how long the notifications are silenced, e.g. 2h",
+          "type": "string"
+        }
+      }
+    },
+    "arnsResponse": {
+      "type": "object",
+      "properties": {
+        "arns": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/users-groups-bulk": {
-      "put": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
+    "bucket": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "creation_date": {
+          "type": "string"
+        },
+        "definition": {
+          "type": "string"
+        },
+        "details": {
+          "type": "object",
+          "properties": {
+            "locking": {
+              "type": "boolean"
+            },
+            "quota": {
+              "type": "object",
+              "properties": {
+                "quota": {
+                  "type": "integer",
+                  "format": "int64"
+                },
+                "type": {
+                  "type": "string",
+                  "enum": [
+                    "hard"
+                  ]
+                }
+              }
+            },
+            "replication": {
+              "type": "boolean"
+            },
+            "tags": {
+              "type": "object",
+              "additionalProperties": {
+                "type": "string"
+              }
+            },
+            "versioning": {
+              "type": "boolean"
+            },
+            "versioningSuspended": {
+              "type": "boolean"
             }
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rw_access": {
+          "type": "object",
+          "properties": {
+            "read": {
+              "type": "boolean"
+            },
+            "write": {
+              "type": "boolean"
             }
           }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketEncryptionInfo": {
+      "type": "object",
+      "properties": {
+        "algorithm": {
+          "type": "string"
+        },
+        "kmsMasterKeyID": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketEncryptionRequest": {
+      "type": "object",
+      "properties": {
+        "encType": {
+          "$ref": "#/definitions/bucketEncryptionType"
+        },
+        "kmsKeyID": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketEncryptionType": {
+      "type": "string",
+      "default": "sse-s3",
+      "enum": [
+        "sse-s3",
+        "sse-kms"
+      ]
+    },
+    "bucketEventRequest": {
+      "type": "object",
+      "required": [
+        "configuration"
+      ],
+      "properties": {
+        "configuration": {
+          "$ref": "#/definitions/notificationConfig"
+        },
+        "ignoreExisting": {
+          "type": "boolean"
         }
       }
     },
-    "/users/service-accounts": {
-      "post": {
-        "tags": [
-          "User"
-        ],
-        "summary": "Check number of service accounts for each user specified",
-        "operationId": "CheckUserServiceAccounts",
-        "parameters": [
-          {
-            "name": "selectedUsers",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/userServiceAccountSummary"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "bucketLifecycleResponse": {
+      "type": "object",
+      "properties": {
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectBucketLifecycle"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "BucketDetails": {
+    },
+    "bucketObLockingResponse": {
       "type": "object",
       "properties": {
-        "locking": {
+        "object_locking_enabled": {
           "type": "boolean"
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
         },
-        "quota": {
+        "etag": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "expiration_rule_id": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "metadata": {
           "type": "object",
-          "properties": {
-            "quota": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "type": {
-              "type": "string",
-              "enum": [
-                "hard"
-              ]
-            }
+          "additionalProperties": {
+            "type": "string"
           }
         },
-        "replication": {
-          "type": "boolean"
+        "name": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
         },
         "tags": {
           "type": "object",
@@ -11404,20 +18442,28 @@ func init() {
             "type": "string"
           }
         },
-        "versioning": {
-          "type": "boolean"
+        "user_metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         },
-        "versioningSuspended": {
-          "type": "boolean"
+        "user_tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "BucketDetailsQuota": {
+    "bucketQuota": {
       "type": "object",
       "properties": {
         "quota": {
-          "type": "integer",
-          "format": "int64"
+          "type": "integer"
         },
         "type": {
           "type": "string",
@@ -11427,686 +18473,908 @@ func init() {
         }
       }
     },
-    "BucketRwAccess": {
+    "bucketReplicationDestination": {
       "type": "object",
       "properties": {
-        "read": {
+        "bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
+          }
+        }
+      }
+    },
+    "bucketReplicationRule": {
+      "type": "object",
+      "properties": {
+        "bandwidth": {
+          "type": "string"
+        },
+        "delete_marker_replication": {
           "type": "boolean"
         },
-        "write": {
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "destination": {
+          "$ref": "#/definitions/bucketReplicationDestination"
+        },
+        "existingObjects": {
+          "type": "boolean"
+        },
+        "healthCheckPeriod": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "metadata_replication": {
           "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "storageClass": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
         }
       }
     },
-    "LoginRequestFeatures": {
+    "bucketReplicationRuleList": {
       "type": "object",
       "properties": {
-        "hide_menu": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketVersioningResponse": {
+      "type": "object",
+      "properties": {
+        "is_versioned": {
           "type": "boolean"
         }
       }
     },
-    "SubnetRegTokenResponse": {
+    "bulkUserGroups": {
       "type": "object",
+      "required": [
+        "users",
+        "groups"
+      ],
       "properties": {
-        "regToken": {
-          "type": "string"
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "WidgetDetailsOptions": {
+    "capacityForecast": {
       "type": "object",
       "properties": {
-        "reduceOptions": {
-          "type": "object",
-          "properties": {
-            "calcs": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityTrend"
+          }
+        },
+        "cluster": {
+          "$ref": "#/definitions/capacityTrend"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "generatedAt": {
+          "type": "string"
+        },
+        "interval": {
+          "description": "interval of the usage samples, empty when the sampling is disabled",
+          "type": "string"
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityTrend"
           }
         }
       }
     },
-    "WidgetDetailsOptionsReduceOptions": {
+    "capacityTrend": {
       "type": "object",
       "properties": {
-        "calcs": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "capacity": {
+          "description": "usable capacity of pools, the raw drive space less the standard parity of their erasure sets, or hard quota of buckets, 0 when unlimited",
+          "type": "integer",
+          "format": "int64"
+        },
+        "daysUntilFull": {
+          "type": "number"
+        },
+        "fit": {
+          "description": "coefficient of determination of the fitted trend, from 0 to 1",
+          "type": "number"
+        },
+        "fullDate": {
+          "type": "string"
+        },
+        "growthPerDay": {
+          "description": "bytes per day of the fitted linear trend",
+          "type": "number"
+        },
+        "name": {
+          "type": "string"
+        },
+        "samples": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "trend": {
+          "type": "string",
+          "enum": [
+            "growing",
+            "stable",
+            "shrinking",
+            "insufficientData"
+          ]
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "WidgetOptions": {
+    "changeUserPasswordRequest": {
       "type": "object",
+      "required": [
+        "selectedUser",
+        "newSecretKey"
+      ],
       "properties": {
-        "reduceOptions": {
-          "type": "object",
-          "properties": {
-            "calcs": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          }
+        "newSecretKey": {
+          "type": "string"
+        },
+        "selectedUser": {
+          "type": "string"
         }
       }
     },
-    "WidgetOptionsReduceOptions": {
+    "checkVersionResponse": {
       "type": "object",
       "properties": {
-        "calcs": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "current_version": {
+          "type": "string"
+        },
+        "latest_version": {
+          "type": "string"
         }
       }
     },
-    "aUserPolicyResponse": {
+    "configDescription": {
       "type": "object",
       "properties": {
-        "policy": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
           "type": "string"
         }
       }
     },
-    "accessRule": {
+    "configuration": {
       "type": "object",
       "properties": {
-        "access": {
-          "type": "string"
+        "key_values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
         },
-        "prefix": {
+        "name": {
           "type": "string"
         }
       }
     },
-    "accountChangePasswordRequest": {
+    "configurationKV": {
       "type": "object",
-      "required": [
-        "current_secret_key",
-        "new_secret_key"
-      ],
       "properties": {
-        "current_secret_key": {
+        "key": {
           "type": "string"
         },
-        "new_secret_key": {
+        "value": {
           "type": "string"
         }
       }
     },
-    "addBucketLifecycle": {
-      "type": "object",
+    "createRemoteBucket": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "sourceBucket",
+        "targetBucket"
+      ],
       "properties": {
-        "disable": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
         },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
+        "bandwidth": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
+          "format": "int64"
         },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
+        "healthCheckPeriod": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
+          "format": "int32"
         },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+        "region": {
           "type": "string"
         },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
-          "type": "string"
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
         },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
+        "sourceBucket": {
           "type": "string"
         },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "type": {
-          "description": "ILM Rule type (Expiry or transition)",
+        "syncMode": {
           "type": "string",
+          "default": "async",
           "enum": [
-            "expiry",
-            "transition"
+            "async",
+            "sync"
           ]
-        }
-      }
-    },
-    "addBucketReplication": {
-      "type": "object",
-      "properties": {
-        "arn": {
+        },
+        "targetBucket": {
           "type": "string"
         },
-        "destination_bucket": {
+        "targetURL": {
           "type": "string"
         }
       }
     },
-    "addGroupRequest": {
+    "dashboardExport": {
       "type": "object",
-      "required": [
-        "group",
-        "members"
-      ],
       "properties": {
-        "group": {
-          "type": "string"
+        "version": {
+          "type": "integer",
+          "format": "int32"
         },
-        "members": {
+        "widgets": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/dashboardWidget"
           }
         }
       }
     },
-    "addMultiBucketLifecycle": {
+    "dashboardWidget": {
       "type": "object",
       "required": [
-        "buckets",
-        "type"
+        "title",
+        "type",
+        "targets"
       ],
       "properties": {
-        "buckets": {
+        "calcs": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
+        "gridPos": {
+          "$ref": "#/definitions/dashboardWidgetGridPos"
         },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+        "id": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
+          "format": "int32"
         },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
+        "maxDataPoints": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
+          "format": "int32"
         },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
+        "owner": {
+          "type": "string"
+        },
+        "position": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
+          "format": "int32"
         },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
+        "scope": {
+          "type": "string",
+          "enum": [
+            "user",
+            "global"
+          ]
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidgetTarget"
+          }
+        },
+        "title": {
           "type": "string"
         },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+        "type": {
+          "type": "string"
+        },
+        "updated": {
+          "type": "string"
+        }
+      }
+    },
+    "dashboardWidgetGridPos": {
+      "type": "object",
+      "properties": {
+        "h": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "w": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "x": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "y": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "dashboardWidgetList": {
+      "type": "object",
+      "properties": {
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
+          }
+        }
+      }
+    },
+    "dashboardWidgetTarget": {
+      "type": "object",
+      "required": [
+        "expr"
+      ],
+      "properties": {
+        "expr": {
+          "description": "PromQL expression, $__query is replaced by the job and extra labels selector",
           "type": "string"
         },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
-          "type": "string"
+        "initialTime": {
+          "description": "start of the default range in minutes, relative to now",
+          "type": "integer",
+          "format": "int64"
         },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
+        "legendFormat": {
           "type": "string"
         },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+        "step": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "type": {
-          "description": "ILM Rule type (Expiry or transition)",
-          "type": "string",
-          "enum": [
-            "expiry",
-            "transition"
-          ]
+          "format": "int32"
         }
       }
     },
-    "addPolicyRequest": {
+    "dashboardWidgetsOrder": {
       "type": "object",
       "required": [
-        "name",
-        "policy"
+        "scope",
+        "ids"
       ],
       "properties": {
-        "name": {
-          "type": "string"
+        "ids": {
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
         },
-        "policy": {
-          "type": "string"
+        "scope": {
+          "type": "string",
+          "enum": [
+            "user",
+            "global"
+          ]
         }
       }
     },
-    "addServiceAccountPolicyRequest": {
+    "deleteFile": {
       "type": "object",
-      "required": [
-        "policy"
-      ],
       "properties": {
-        "policy": {
+        "path": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        },
+        "versionID": {
           "type": "string"
         }
       }
     },
-    "addUserRequest": {
+    "effectivePermission": {
       "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey",
-        "groups",
-        "policies"
-      ],
       "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "groups": {
+        "allowedActions": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "policies": {
+        "bucket": {
+          "type": "string"
+        },
+        "conditional": {
+          "description": "some statements on the resource only apply under conditions",
+          "type": "boolean"
+        },
+        "deniedActions": {
+          "description": "actions allowed by some statement but removed by a Deny statement",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "secretKey": {
+        "entity": {
           "type": "string"
-        }
-      }
-    },
-    "adminInfoResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "integer"
         },
-        "objects": {
-          "type": "integer"
+        "entityType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount"
+          ]
         },
-        "prometheusNotReady": {
-          "type": "boolean"
+        "parentUser": {
+          "type": "string"
         },
-        "servers": {
+        "policies": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/serverProperties"
+            "type": "string"
           }
         },
-        "usage": {
-          "type": "integer"
+        "resource": {
+          "description": "bucket or bucket/prefix pattern, empty for admin actions",
+          "type": "string"
         },
-        "widgets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widget"
-          }
+        "scope": {
+          "type": "string",
+          "enum": [
+            "admin",
+            "bucket",
+            "object"
+          ]
         }
       }
     },
-    "arnsResponse": {
+    "effectivePermissionsReport": {
       "type": "object",
       "properties": {
-        "arns": {
+        "generatedAt": {
+          "type": "string"
+        },
+        "permissions": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/effectivePermission"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "bucket": {
+    "erasureTopology": {
       "type": "object",
-      "required": [
-        "name"
-      ],
       "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
-        },
-        "creation_date": {
-          "type": "string"
-        },
-        "definition": {
-          "type": "string"
+        "degradedSets": {
+          "type": "integer",
+          "format": "int64"
         },
-        "details": {
-          "type": "object",
-          "properties": {
-            "locking": {
-              "type": "boolean"
-            },
-            "quota": {
-              "type": "object",
-              "properties": {
-                "quota": {
-                  "type": "integer",
-                  "format": "int64"
-                },
-                "type": {
-                  "type": "string",
-                  "enum": [
-                    "hard"
-                  ]
-                }
-              }
-            },
-            "replication": {
-              "type": "boolean"
-            },
-            "tags": {
-              "type": "object",
-              "additionalProperties": {
-                "type": "string"
-              }
-            },
-            "versioning": {
-              "type": "boolean"
-            },
-            "versioningSuspended": {
-              "type": "boolean"
-            }
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyPool"
           }
         },
-        "name": {
-          "type": "string",
-          "minLength": 3
+        "reducedParity": {
+          "type": "integer",
+          "format": "int32"
         },
-        "objects": {
+        "standardParity": {
           "type": "integer",
-          "format": "int64"
+          "format": "int32"
         },
-        "rw_access": {
-          "type": "object",
-          "properties": {
-            "read": {
-              "type": "boolean"
-            },
-            "write": {
-              "type": "boolean"
-            }
+        "unassignedDrives": {
+          "description": "drives not assigned to an erasure set, usually offline",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyDrive"
           }
         },
-        "size": {
+        "unavailableSets": {
           "type": "integer",
           "format": "int64"
         }
       }
     },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketEncryptionInfo": {
+    "error": {
       "type": "object",
+      "required": [
+        "message",
+        "detailedMessage"
+      ],
       "properties": {
-        "algorithm": {
-          "type": "string"
+        "code": {
+          "type": "integer",
+          "format": "int32"
         },
-        "kmsMasterKeyID": {
+        "detailedMessage": {
           "type": "string"
-        }
-      }
-    },
-    "bucketEncryptionRequest": {
-      "type": "object",
-      "properties": {
-        "encType": {
-          "$ref": "#/definitions/bucketEncryptionType"
         },
-        "kmsKeyID": {
+        "message": {
           "type": "string"
         }
       }
     },
-    "bucketEncryptionType": {
-      "type": "string",
-      "default": "sse-s3",
-      "enum": [
-        "sse-s3",
-        "sse-kms"
-      ]
-    },
-    "bucketEventRequest": {
+    "expirationResponse": {
       "type": "object",
-      "required": [
-        "configuration"
-      ],
       "properties": {
-        "configuration": {
-          "$ref": "#/definitions/notificationConfig"
+        "date": {
+          "type": "string"
         },
-        "ignoreExisting": {
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "delete_marker": {
           "type": "boolean"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "bucketLifecycleResponse": {
+    "getBucketRetentionConfig": {
       "type": "object",
       "properties": {
-        "lifecycle": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/objectBucketLifecycle"
-          }
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "bucketObLockingResponse": {
+    "group": {
       "type": "object",
       "properties": {
-        "object_locking_enabled": {
-          "type": "boolean"
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
         }
       }
     },
-    "bucketObject": {
+    "healJob": {
       "type": "object",
       "properties": {
-        "content_type": {
+        "bucket": {
           "type": "string"
         },
-        "etag": {
-          "type": "string"
+        "bytesScanned": {
+          "type": "integer",
+          "format": "int64"
         },
-        "expiration": {
+        "clientToken": {
           "type": "string"
         },
-        "expiration_rule_id": {
+        "endTime": {
           "type": "string"
         },
-        "is_delete_marker": {
-          "type": "boolean"
-        },
-        "is_latest": {
-          "type": "boolean"
+        "errors": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "last_modified": {
+        "failureDetail": {
           "type": "string"
         },
-        "legal_hold_status": {
-          "type": "string"
+        "healthAfterCols": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
         },
-        "metadata": {
+        "healthBeforeCols": {
           "type": "object",
           "additionalProperties": {
-            "type": "string"
+            "type": "integer",
+            "format": "int64"
           }
         },
-        "name": {
+        "itemsHealed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "itemsScanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsHealed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsScanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "owner": {
+          "description": "access key of the session that started the job, only it can reattach to the job",
           "type": "string"
         },
-        "retention_mode": {
+        "prefix": {
           "type": "string"
         },
-        "retention_until_date": {
+        "startTime": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "running",
+            "finished",
+            "stopped",
+            "failed"
+          ]
+        }
+      }
+    },
+    "healthReport": {
+      "type": "object",
+      "properties": {
+        "id": {
           "type": "string"
         },
         "size": {
           "type": "integer",
           "format": "int64"
         },
-        "tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "user_metadata": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "user_tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "version_id": {
+        "time": {
           "type": "string"
         }
       }
     },
-    "bucketQuota": {
+    "healthReportChange": {
       "type": "object",
       "properties": {
-        "quota": {
-          "type": "integer"
+        "after": {
+          "type": "string"
         },
-        "type": {
+        "before": {
+          "type": "string"
+        },
+        "category": {
           "type": "string",
           "enum": [
-            "hard"
+            "server",
+            "drive",
+            "config"
+          ]
+        },
+        "field": {
+          "type": "string"
+        },
+        "item": {
+          "description": "server endpoint, drive endpoint or config key",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "changed"
           ]
         }
       }
     },
-    "bucketReplicationDestination": {
+    "healthReportDiff": {
       "type": "object",
       "properties": {
-        "bucket": {
+        "base": {
+          "type": "string"
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/healthReportChange"
+          }
+        },
+        "target": {
           "type": "string"
         }
       }
     },
-    "bucketReplicationResponse": {
+    "iamEntity": {
+      "type": "string",
+      "pattern": "^[\\w+=,.@-]{1,64}$"
+    },
+    "iamImportChange": {
       "type": "object",
       "properties": {
-        "rules": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "unchanged"
+          ]
+        },
+        "details": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/bucketReplicationRule"
+            "type": "string"
           }
+        },
+        "kind": {
+          "type": "string",
+          "enum": [
+            "policy",
+            "user",
+            "group",
+            "serviceAccount"
+          ]
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "bucketReplicationRule": {
+    "iamImportRequest": {
       "type": "object",
+      "required": [
+        "bundle"
+      ],
       "properties": {
-        "bandwidth": {
+        "bundle": {
+          "description": "exported bundle, as JSON or YAML",
           "type": "string"
         },
-        "delete_marker_replication": {
-          "type": "boolean"
-        },
-        "deletes_replication": {
-          "type": "boolean"
-        },
-        "destination": {
-          "$ref": "#/definitions/bucketReplicationDestination"
-        },
-        "existingObjects": {
+        "dryRun": {
+          "description": "only report the changes the import would make",
           "type": "boolean"
+        }
+      }
+    },
+    "iamImportResult": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamImportChange"
+          }
         },
-        "healthCheckPeriod": {
-          "type": "integer"
-        },
-        "id": {
-          "type": "string"
+        "created": {
+          "type": "integer",
+          "format": "int64"
         },
-        "metadata_replication": {
+        "dryRun": {
           "type": "boolean"
         },
-        "prefix": {
+        "secretsId": {
+          "description": "id to download the secret keys generated for the created users and service accounts, they can be downloaded once",
           "type": "string"
         },
-        "priority": {
+        "unchanged": {
           "type": "integer",
-          "format": "int32"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "Enabled",
-            "Disabled"
-          ]
-        },
-        "storageClass": {
-          "type": "string"
+          "format": "int64"
         },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
+        "updated": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "iamPolicy": {
+      "type": "object",
+      "properties": {
+        "statement": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamPolicyStatement"
+          }
         },
-        "tags": {
+        "version": {
           "type": "string"
         }
       }
     },
-    "bucketReplicationRuleList": {
+    "iamPolicyStatement": {
       "type": "object",
       "properties": {
-        "rules": {
+        "action": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "condition": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "object"
+          }
+        },
+        "effect": {
+          "type": "string"
+        },
+        "resource": {
           "type": "array",
           "items": {
             "type": "string"
@@ -12114,118 +19382,140 @@ func init() {
         }
       }
     },
-    "bucketVersioningResponse": {
+    "importDashboardRequest": {
       "type": "object",
+      "required": [
+        "dashboard"
+      ],
       "properties": {
-        "is_versioned": {
+        "dashboard": {
+          "$ref": "#/definitions/dashboardExport"
+        },
+        "replace": {
+          "description": "replace the widgets of the scope instead of appending to them",
           "type": "boolean"
+        },
+        "scope": {
+          "type": "string",
+          "enum": [
+            "user",
+            "global"
+          ]
         }
       }
     },
-    "bulkUserGroups": {
+    "license": {
       "type": "object",
-      "required": [
-        "users",
-        "groups"
-      ],
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "account_id": {
+          "type": "integer"
+        },
+        "email": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string"
+        },
+        "organization": {
+          "type": "string"
         },
-        "users": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "plan": {
+          "type": "string"
+        },
+        "storage_capacity": {
+          "type": "integer"
         }
       }
     },
-    "changeUserPasswordRequest": {
+    "lifecycleTag": {
       "type": "object",
-      "required": [
-        "selectedUser",
-        "newSecretKey"
-      ],
       "properties": {
-        "newSecretKey": {
+        "key": {
           "type": "string"
         },
-        "selectedUser": {
+        "value": {
           "type": "string"
         }
       }
     },
-    "checkVersionResponse": {
+    "listAccessRulesResponse": {
       "type": "object",
       "properties": {
-        "current_version": {
-          "type": "string"
+        "accessRules": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/accessRule"
+          }
         },
-        "latest_version": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of policies"
         }
       }
     },
-    "configDescription": {
+    "listBucketEventsResponse": {
       "type": "object",
       "properties": {
-        "description": {
-          "type": "string"
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationConfig"
+          }
         },
-        "key": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of bucket events"
         }
       }
     },
-    "configuration": {
+    "listBucketsResponse": {
       "type": "object",
       "properties": {
-        "key_values": {
+        "buckets": {
           "type": "array",
+          "title": "list of resulting buckets",
           "items": {
-            "$ref": "#/definitions/configurationKV"
+            "$ref": "#/definitions/bucket"
           }
         },
-        "name": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of buckets accessible to the user"
         }
       }
     },
-    "configurationKV": {
+    "listConfigResponse": {
       "type": "object",
       "properties": {
-        "key": {
-          "type": "string"
+        "configurations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configDescription"
+          }
         },
-        "value": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of configurations"
         }
       }
     },
-    "createRemoteBucket": {
+    "listExternalBucketsParams": {
       "required": [
         "accessKey",
         "secretKey",
         "targetURL",
-        "sourceBucket",
-        "targetBucket"
+        "useTLS"
       ],
       "properties": {
         "accessKey": {
           "type": "string",
           "minLength": 3
         },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
         "region": {
           "type": "string"
         },
@@ -12233,927 +19523,1185 @@ func init() {
           "type": "string",
           "minLength": 8
         },
-        "sourceBucket": {
-          "type": "string"
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "targetBucket": {
-          "type": "string"
-        },
         "targetURL": {
           "type": "string"
+        },
+        "useTLS": {
+          "type": "boolean"
         }
       }
     },
-    "deleteFile": {
+    "listGroupsResponse": {
       "type": "object",
       "properties": {
-        "path": {
-          "type": "string"
-        },
-        "recursive": {
-          "type": "boolean"
+        "groups": {
+          "type": "array",
+          "title": "list of groups",
+          "items": {
+            "type": "string"
+          }
         },
-        "versionID": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of groups"
         }
       }
     },
-    "error": {
+    "listHealJobsResponse": {
       "type": "object",
-      "required": [
-        "message",
-        "detailedMessage"
-      ],
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "detailedMessage": {
-          "type": "string"
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/healJob"
+          }
+        }
+      }
+    },
+    "listHealthReportsResponse": {
+      "type": "object",
+      "properties": {
+        "reports": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/healthReport"
+          }
         },
-        "message": {
+        "schedule": {
+          "description": "interval of the scheduled collections, empty when disabled",
           "type": "string"
         }
       }
     },
-    "expirationResponse": {
+    "listObjectsResponse": {
       "type": "object",
       "properties": {
-        "date": {
-          "type": "string"
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
         },
-        "days": {
+        "total": {
           "type": "integer",
-          "format": "int64"
-        },
-        "delete_marker": {
-          "type": "boolean"
+          "format": "int64",
+          "title": "number of objects"
+        }
+      }
+    },
+    "listPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
         },
-        "noncurrent_expiration_days": {
+        "total": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "title": "total number of policies"
         }
       }
     },
-    "getBucketRetentionConfig": {
+    "listRemoteBucketsResponse": {
       "type": "object",
       "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
+        "buckets": {
+          "type": "array",
+          "title": "list of remote buckets",
+          "items": {
+            "$ref": "#/definitions/remoteBucket"
+          }
         },
-        "validity": {
+        "total": {
           "type": "integer",
-          "format": "int32"
+          "format": "int64",
+          "title": "number of remote buckets accessible to user"
         }
       }
     },
-    "group": {
+    "listSpeedtestResultsResponse": {
       "type": "object",
       "properties": {
-        "members": {
+        "results": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/speedtestResult"
           }
+        }
+      }
+    },
+    "listTraceRecordingsResponse": {
+      "type": "object",
+      "properties": {
+        "recordings": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/traceRecording"
+          }
+        }
+      }
+    },
+    "listUsersResponse": {
+      "type": "object",
+      "properties": {
+        "users": {
+          "type": "array",
+          "title": "list of resulting users",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
+        }
+      }
+    },
+    "logSearchResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "object",
+          "title": "list of log search responses"
+        }
+      }
+    },
+    "loginDetails": {
+      "type": "object",
+      "properties": {
+        "loginStrategy": {
+          "type": "string",
+          "enum": [
+            "form",
+            "redirect",
+            "service-account",
+            "redirect-service-account"
+          ]
         },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "status": {
+        "redirect": {
           "type": "string"
         }
       }
     },
-    "iamEntity": {
-      "type": "string",
-      "pattern": "^[\\w+=,.@-]{1,64}$"
-    },
-    "iamPolicy": {
+    "loginOauth2AuthRequest": {
       "type": "object",
+      "required": [
+        "state",
+        "code"
+      ],
       "properties": {
-        "statement": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/iamPolicyStatement"
-          }
+        "code": {
+          "type": "string"
         },
-        "version": {
+        "state": {
           "type": "string"
         }
       }
     },
-    "iamPolicyStatement": {
+    "loginRequest": {
       "type": "object",
       "properties": {
-        "action": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "accessKey": {
+          "type": "string"
         },
-        "condition": {
+        "features": {
           "type": "object",
-          "additionalProperties": {
-            "type": "object"
+          "properties": {
+            "hide_menu": {
+              "type": "boolean"
+            }
           }
         },
-        "effect": {
+        "secretKey": {
           "type": "string"
         },
-        "resource": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "sts": {
+          "type": "string"
         }
       }
     },
-    "license": {
+    "loginResponse": {
       "type": "object",
       "properties": {
-        "account_id": {
-          "type": "integer"
-        },
-        "email": {
+        "sessionId": {
           "type": "string"
+        }
+      }
+    },
+    "makeBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "locking": {
+          "type": "boolean"
         },
-        "expires_at": {
+        "name": {
           "type": "string"
         },
-        "organization": {
-          "type": "string"
+        "quota": {
+          "$ref": "#/definitions/setBucketQuota"
         },
-        "plan": {
-          "type": "string"
+        "retention": {
+          "$ref": "#/definitions/putBucketRetentionRequest"
         },
-        "storage_capacity": {
-          "type": "integer"
+        "versioning": {
+          "type": "boolean"
         }
       }
     },
-    "lifecycleTag": {
+    "metadata": {
       "type": "object",
       "properties": {
-        "key": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
+        "objectMetadata": {
+          "type": "object",
+          "additionalProperties": true
         }
       }
     },
-    "listAccessRulesResponse": {
-      "type": "object",
+    "multiBucketReplication": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "bucketsRelation"
+      ],
       "properties": {
-        "accessRules": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bucketsRelation": {
           "type": "array",
-          "title": "list of policies",
+          "minLength": 1,
           "items": {
-            "$ref": "#/definitions/accessRule"
+            "$ref": "#/definitions/multiBucketsRelation"
           }
         },
-        "total": {
+        "healthCheckPeriod": {
           "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
+          "format": "int32"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "region": {
+          "type": "string"
+        },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
         }
       }
     },
-    "listBucketEventsResponse": {
-      "type": "object",
+    "multiBucketReplicationEdit": {
       "properties": {
-        "events": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationConfig"
-          }
+        "arn": {
+          "type": "string"
         },
-        "total": {
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
           "type": "integer",
-          "format": "int64",
-          "title": "total number of bucket events"
+          "format": "int32",
+          "default": 0
+        },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateExistingObjects": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
+        "ruleState": {
+          "type": "boolean"
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "tags": {
+          "type": "string",
+          "default": ""
         }
       }
     },
-    "listBucketsResponse": {
+    "multiBucketResponseItem": {
       "type": "object",
       "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of resulting buckets",
-          "items": {
-            "$ref": "#/definitions/bucket"
-          }
+        "errorString": {
+          "type": "string"
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of buckets accessible to the user"
+        "originBucket": {
+          "type": "string"
+        },
+        "targetBucket": {
+          "type": "string"
         }
       }
     },
-    "listConfigResponse": {
+    "multiBucketResponseState": {
       "type": "object",
       "properties": {
-        "configurations": {
+        "replicationState": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/configDescription"
+            "$ref": "#/definitions/multiBucketResponseItem"
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of configurations"
         }
       }
     },
-    "listExternalBucketsParams": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "useTLS"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "region": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "targetURL": {
+    "multiBucketsRelation": {
+      "type": "object",
+      "properties": {
+        "destinationBucket": {
           "type": "string"
         },
-        "useTLS": {
-          "type": "boolean"
+        "originBucket": {
+          "type": "string"
         }
       }
     },
-    "listGroupsResponse": {
-      "type": "object",
+    "multiLifecycleResult": {
       "properties": {
-        "groups": {
+        "results": {
           "type": "array",
-          "title": "list of groups",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/multicycleResultItem"
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of groups"
         }
       }
     },
-    "listObjectsResponse": {
+    "multicycleResultItem": {
       "type": "object",
       "properties": {
-        "objects": {
-          "type": "array",
-          "title": "list of resulting objects",
-          "items": {
-            "$ref": "#/definitions/bucketObject"
-          }
+        "bucketName": {
+          "type": "string"
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of objects"
+        "error": {
+          "type": "string"
         }
       }
     },
-    "listPoliciesResponse": {
+    "nofiticationService": {
+      "type": "string",
+      "enum": [
+        "webhook",
+        "amqp",
+        "kafka",
+        "mqtt",
+        "nats",
+        "nsq",
+        "mysql",
+        "postgres",
+        "elasticsearch",
+        "redis"
+      ]
+    },
+    "notifEndpointResponse": {
       "type": "object",
       "properties": {
-        "policies": {
+        "notification_endpoints": {
           "type": "array",
-          "title": "list of policies",
           "items": {
-            "$ref": "#/definitions/policy"
+            "$ref": "#/definitions/notificationEndpointItem"
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
         }
       }
     },
-    "listRemoteBucketsResponse": {
+    "notificationConfig": {
       "type": "object",
+      "required": [
+        "arn"
+      ],
       "properties": {
-        "buckets": {
+        "arn": {
+          "type": "string"
+        },
+        "events": {
           "type": "array",
-          "title": "list of remote buckets",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
           "items": {
-            "$ref": "#/definitions/remoteBucket"
+            "$ref": "#/definitions/notificationEventType"
           }
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of remote buckets accessible to user"
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "listUsersResponse": {
+    "notificationDeleteRequest": {
       "type": "object",
+      "required": [
+        "events",
+        "prefix",
+        "suffix"
+      ],
       "properties": {
-        "users": {
+        "events": {
           "type": "array",
-          "title": "list of resulting users",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "minLength": 1,
           "items": {
-            "$ref": "#/definitions/user"
+            "$ref": "#/definitions/notificationEventType"
           }
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "logSearchResponse": {
+    "notificationEndpoint": {
       "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
       "properties": {
-        "results": {
+        "account_id": {
+          "type": "string"
+        },
+        "properties": {
           "type": "object",
-          "title": "list of log search responses"
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "loginDetails": {
+    "notificationEndpointItem": {
       "type": "object",
       "properties": {
-        "loginStrategy": {
-          "type": "string",
-          "enum": [
-            "form",
-            "redirect",
-            "service-account",
-            "redirect-service-account"
-          ]
+        "account_id": {
+          "type": "string"
         },
-        "redirect": {
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        },
+        "status": {
           "type": "string"
         }
       }
     },
-    "loginOauth2AuthRequest": {
+    "notificationEventType": {
+      "type": "string",
+      "enum": [
+        "put",
+        "delete",
+        "get"
+      ]
+    },
+    "objectBucketLifecycle": {
       "type": "object",
-      "required": [
-        "state",
-        "code"
-      ],
       "properties": {
-        "code": {
+        "expiration": {
+          "$ref": "#/definitions/expirationResponse"
+        },
+        "id": {
           "type": "string"
         },
-        "state": {
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
           "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "transition": {
+          "$ref": "#/definitions/transitionResponse"
         }
       }
     },
-    "loginRequest": {
+    "objectLegalHoldStatus": {
+      "type": "string",
+      "enum": [
+        "enabled",
+        "disabled"
+      ]
+    },
+    "objectRetentionMode": {
+      "type": "string",
+      "enum": [
+        "governance",
+        "compliance"
+      ]
+    },
+    "objectRetentionUnit": {
+      "type": "string",
+      "enum": [
+        "days",
+        "years"
+      ]
+    },
+    "peerInfo": {
       "type": "object",
       "properties": {
-        "accessKey": {
+        "deploymentID": {
           "type": "string"
         },
-        "features": {
-          "type": "object",
-          "properties": {
-            "hide_menu": {
-              "type": "boolean"
-            }
-          }
-        },
-        "secretKey": {
+        "endpoint": {
           "type": "string"
         },
-        "sts": {
+        "name": {
           "type": "string"
         }
       }
     },
-    "loginResponse": {
+    "peerInfoRemove": {
       "type": "object",
+      "required": [
+        "sites"
+      ],
       "properties": {
-        "sessionId": {
-          "type": "string"
+        "all": {
+          "type": "boolean"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "makeBucketRequest": {
+    "peerSite": {
       "type": "object",
-      "required": [
-        "name"
-      ],
       "properties": {
-        "locking": {
-          "type": "boolean"
+        "accessKey": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
         },
         "name": {
           "type": "string"
         },
-        "quota": {
-          "$ref": "#/definitions/setBucketQuota"
-        },
-        "retention": {
-          "$ref": "#/definitions/putBucketRetentionRequest"
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "peerSiteEditResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
         },
-        "versioning": {
+        "success": {
           "type": "boolean"
         }
       }
     },
-    "metadata": {
+    "peerSiteRemoveResponse": {
       "type": "object",
       "properties": {
-        "objectMetadata": {
-          "type": "object",
-          "additionalProperties": true
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
         }
       }
     },
-    "multiBucketReplication": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "bucketsRelation"
-      ],
+    "permissionResource": {
+      "type": "object",
       "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
+        "conditionOperator": {
+          "type": "string"
         },
-        "bucketsRelation": {
+        "prefixes": {
           "type": "array",
-          "minLength": 1,
           "items": {
-            "$ref": "#/definitions/multiBucketsRelation"
+            "type": "string"
           }
         },
-        "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "priority": {
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "region": {
-          "type": "string"
-        },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
-        },
-        "replicateMetadata": {
-          "type": "boolean"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "storageClass": {
-          "type": "string",
-          "default": ""
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "tags": {
-          "type": "string"
-        },
-        "targetURL": {
+        "resource": {
           "type": "string"
         }
       }
     },
-    "multiBucketReplicationEdit": {
+    "policy": {
+      "type": "object",
       "properties": {
-        "arn": {
+        "name": {
           "type": "string"
         },
-        "prefix": {
+        "policy": {
           "type": "string"
-        },
-        "priority": {
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
-        },
-        "replicateExistingObjects": {
-          "type": "boolean"
-        },
-        "replicateMetadata": {
-          "type": "boolean"
-        },
-        "ruleState": {
-          "type": "boolean"
-        },
-        "storageClass": {
-          "type": "string",
-          "default": ""
-        },
-        "tags": {
-          "type": "string",
-          "default": ""
         }
       }
     },
-    "multiBucketResponseItem": {
+    "policyArgs": {
       "type": "object",
       "properties": {
-        "errorString": {
+        "action": {
           "type": "string"
         },
-        "originBucket": {
+        "bucket_name": {
           "type": "string"
         },
-        "targetBucket": {
+        "id": {
           "type": "string"
         }
       }
     },
-    "multiBucketResponseState": {
+    "policyEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group"
+      ]
+    },
+    "policyHistory": {
       "type": "object",
       "properties": {
-        "replicationState": {
+        "name": {
+          "type": "string"
+        },
+        "revisions": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/multiBucketResponseItem"
+            "$ref": "#/definitions/policyRevision"
           }
         }
       }
     },
-    "multiBucketsRelation": {
+    "policyLintIssue": {
       "type": "object",
       "properties": {
-        "destinationBucket": {
+        "code": {
+          "type": "string",
+          "enum": [
+            "invalidJSON",
+            "invalidVersion",
+            "invalidEffect",
+            "emptyAction",
+            "unknownAction",
+            "invalidResource",
+            "unknownBucket",
+            "invalidCondition",
+            "invalidConditionKey",
+            "invalidStatement",
+            "shadowedStatement",
+            "broadPermission",
+            "notAttached"
+          ]
+        },
+        "message": {
           "type": "string"
         },
-        "originBucket": {
+        "severity": {
+          "type": "string",
+          "enum": [
+            "error",
+            "warning",
+            "info"
+          ]
+        },
+        "statement": {
+          "description": "position of the statement starting at 1, 0 for issues of the whole policy",
+          "type": "integer",
+          "format": "int32"
+        },
+        "suggestion": {
+          "type": "string"
+        },
+        "value": {
+          "description": "offending action, resource or condition",
           "type": "string"
         }
       }
     },
-    "multiLifecycleResult": {
+    "policyLintRequest": {
+      "type": "object",
+      "required": [
+        "policy"
+      ],
       "properties": {
-        "results": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/multicycleResultItem"
-          }
+        "name": {
+          "description": "name the policy is saved as, used to report whether it is attached",
+          "type": "string"
+        },
+        "policy": {
+          "description": "policy document as JSON",
+          "type": "string"
         }
       }
     },
-    "multicycleResultItem": {
+    "policyLintResult": {
       "type": "object",
       "properties": {
-        "bucketName": {
-          "type": "string"
+        "issues": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyLintIssue"
+          }
         },
-        "error": {
-          "type": "string"
+        "unusedPolicies": {
+          "description": "existing policies attached to no user or group",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "valid": {
+          "description": "the policy has no errors and can be saved",
+          "type": "boolean"
         }
       }
     },
-    "nofiticationService": {
-      "type": "string",
-      "enum": [
-        "webhook",
-        "amqp",
-        "kafka",
-        "mqtt",
-        "nats",
-        "nsq",
-        "mysql",
-        "postgres",
-        "elasticsearch",
-        "redis"
-      ]
-    },
-    "notifEndpointResponse": {
+    "policyRevision": {
       "type": "object",
       "properties": {
-        "notification_endpoints": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "baseline",
+            "create",
+            "update",
+            "rollback",
+            "delete"
+          ]
+        },
+        "author": {
+          "description": "access key of the user who saved the revision, empty for revisions saved outside of the console",
+          "type": "string"
+        },
+        "diff": {
+          "description": "changes from the previous revision",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/notificationEndpointItem"
+            "type": "string"
+          }
+        },
+        "groups": {
+          "description": "groups the policy was attached to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "description": "policy document as JSON, empty once the policy is deleted",
+          "type": "string"
+        },
+        "restoredRevision": {
+          "description": "revision restored by a rollback",
+          "type": "integer",
+          "format": "int32"
+        },
+        "revision": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "users": {
+          "description": "users the policy was attached to",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "notificationConfig": {
+    "policyRevisionDiff": {
       "type": "object",
-      "required": [
-        "arn"
-      ],
       "properties": {
-        "arn": {
-          "type": "string"
+        "addedGroups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "addedUsers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "events": {
+        "diff": {
           "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
           "items": {
-            "$ref": "#/definitions/notificationEventType"
+            "type": "string"
           }
         },
-        "id": {
+        "from": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "name": {
           "type": "string"
         },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
+        "removedGroups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
+        "removedUsers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "to": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "notificationDeleteRequest": {
+    "policySimulationPolicy": {
       "type": "object",
-      "required": [
-        "events",
-        "prefix",
-        "suffix"
-      ],
       "properties": {
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
-          }
+        "group": {
+          "description": "group the policy is attached to when the source is group",
+          "type": "string"
         },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
+        "missing": {
+          "description": "the policy is attached but does not exist",
+          "type": "boolean"
         },
-        "suffix": {
+        "name": {
+          "type": "string"
+        },
+        "source": {
           "type": "string",
-          "title": "filter event associated to the specified suffix"
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount",
+            "session"
+          ]
         }
       }
     },
-    "notificationEndpoint": {
+    "policySimulationRequest": {
       "type": "object",
       "required": [
-        "service",
-        "account_id",
-        "properties"
+        "principal",
+        "action"
       ],
       "properties": {
-        "account_id": {
+        "action": {
+          "description": "action to evaluate, e.g. s3:GetObject or admin:ServerInfo",
           "type": "string"
         },
-        "properties": {
+        "conditions": {
+          "description": "condition values the statements are evaluated with, e.g. aws:SourceIp",
           "type": "object",
           "additionalProperties": {
-            "type": "string"
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
           }
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
-        }
-      }
-    },
-    "notificationEndpointItem": {
-      "type": "object",
-      "properties": {
-        "account_id": {
+        "principal": {
+          "description": "access key of the user or service account",
           "type": "string"
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
+        "resource": {
+          "description": "resource ARN, e.g. arn:aws:s3:::bucket/object, not needed for admin actions",
+          "type": "string"
         },
-        "status": {
+        "sessionPolicy": {
+          "description": "optional session policy document restricting the principal, as used by temporary credentials",
           "type": "string"
         }
       }
     },
-    "notificationEventType": {
-      "type": "string",
-      "enum": [
-        "put",
-        "delete",
-        "get"
-      ]
-    },
-    "objectBucketLifecycle": {
+    "policySimulationResult": {
       "type": "object",
       "properties": {
-        "expiration": {
-          "$ref": "#/definitions/expirationResponse"
+        "action": {
+          "type": "string"
         },
-        "id": {
+        "allowed": {
+          "type": "boolean"
+        },
+        "bucket": {
           "type": "string"
         },
-        "prefix": {
+        "decidingStatement": {
+          "$ref": "#/definitions/policySimulationStatement"
+        },
+        "decision": {
+          "type": "string",
+          "enum": [
+            "allowed",
+            "explicitDeny",
+            "implicitDeny"
+          ]
+        },
+        "matchedStatements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policySimulationStatement"
+          }
+        },
+        "object": {
           "type": "string"
         },
-        "status": {
+        "parentUser": {
           "type": "string"
         },
-        "tags": {
+        "policies": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/lifecycleTag"
+            "$ref": "#/definitions/policySimulationPolicy"
           }
         },
-        "transition": {
-          "$ref": "#/definitions/transitionResponse"
+        "principal": {
+          "type": "string"
+        },
+        "principalType": {
+          "type": "string",
+          "enum": [
+            "user",
+            "serviceAccount"
+          ]
+        },
+        "reason": {
+          "type": "string"
         }
       }
     },
-    "objectLegalHoldStatus": {
-      "type": "string",
-      "enum": [
-        "enabled",
-        "disabled"
-      ]
-    },
-    "objectRetentionMode": {
-      "type": "string",
-      "enum": [
-        "governance",
-        "compliance"
-      ]
-    },
-    "objectRetentionUnit": {
-      "type": "string",
-      "enum": [
-        "days",
-        "years"
-      ]
-    },
-    "peerInfo": {
+    "policySimulationStatement": {
       "type": "object",
       "properties": {
-        "deploymentID": {
+        "effect": {
           "type": "string"
         },
-        "endpoint": {
+        "group": {
           "type": "string"
         },
-        "name": {
+        "index": {
+          "description": "position of the statement in its policy",
+          "type": "integer",
+          "format": "int32"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "sid": {
+          "type": "string"
+        },
+        "source": {
+          "type": "string",
+          "enum": [
+            "user",
+            "group",
+            "serviceAccount",
+            "session"
+          ]
+        },
+        "statement": {
+          "description": "statement as JSON",
           "type": "string"
         }
       }
     },
-    "peerInfoRemove": {
+    "policyTemplate": {
       "type": "object",
       "required": [
-        "sites"
+        "id",
+        "name",
+        "policy"
       ],
       "properties": {
-        "all": {
+        "builtin": {
           "type": "boolean"
         },
-        "sites": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
-      }
-    },
-    "peerSite": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
+        "description": {
           "type": "string"
         },
-        "endpoint": {
+        "id": {
           "type": "string"
         },
         "name": {
           "type": "string"
         },
-        "secretKey": {
+        "parameters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyTemplateParameter"
+          }
+        },
+        "policy": {
+          "description": "policy document as JSON with {{name}} placeholders",
           "type": "string"
         }
       }
     },
-    "peerSiteEditResponse": {
+    "policyTemplateList": {
       "type": "object",
       "properties": {
-        "errorDetail": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        },
-        "success": {
-          "type": "boolean"
+        "templates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyTemplate"
+          }
         }
       }
     },
-    "peerSiteRemoveResponse": {
+    "policyTemplateParameter": {
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "errorDetail": {
+        "default": {
           "type": "string"
         },
-        "status": {
+        "description": {
           "type": "string"
+        },
+        "name": {
+          "description": "referenced in the template as {{name}}",
+          "type": "string"
+        },
+        "required": {
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string",
+          "default": "string",
+          "enum": [
+            "bucket",
+            "prefix",
+            "string"
+          ]
         }
       }
     },
-    "permissionResource": {
+    "policyTemplateRenderRequest": {
       "type": "object",
       "properties": {
-        "conditionOperator": {
+        "groups": {
+          "description": "groups to attach the saved policy to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "name to save the rendered policy as, the policy is only rendered when empty",
           "type": "string"
         },
-        "prefixes": {
+        "users": {
+          "description": "users to attach the saved policy to",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "resource": {
-          "type": "string"
+        "values": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         }
       }
     },
-    "policy": {
+    "policyTemplateRenderResult": {
       "type": "object",
       "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
         "name": {
           "type": "string"
         },
         "policy": {
           "type": "string"
-        }
-      }
-    },
-    "policyArgs": {
-      "type": "object",
-      "properties": {
-        "action": {
-          "type": "string"
         },
-        "bucket_name": {
-          "type": "string"
+        "saved": {
+          "type": "boolean"
         },
-        "id": {
-          "type": "string"
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "policyEntity": {
-      "type": "string",
-      "default": "user",
-      "enum": [
-        "user",
-        "group"
-      ]
-    },
     "prefixAccessPair": {
       "type": "object",
       "properties": {
@@ -13207,6 +20755,49 @@ func init() {
         }
       }
     },
+    "prometheusCheck": {
+      "type": "object",
+      "properties": {
+        "authType": {
+          "type": "string",
+          "enum": [
+            "none",
+            "basic",
+            "bearer"
+          ]
+        },
+        "clientCertificate": {
+          "type": "boolean"
+        },
+        "configured": {
+          "type": "boolean"
+        },
+        "error": {
+          "type": "string"
+        },
+        "latency": {
+          "description": "latency of the check in milliseconds",
+          "type": "integer",
+          "format": "int64"
+        },
+        "reachable": {
+          "type": "boolean"
+        },
+        "statusCode": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "tls": {
+          "type": "boolean"
+        },
+        "url": {
+          "type": "string"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
     "putBucketRetentionRequest": {
       "type": "object",
       "required": [
@@ -13383,6 +20974,9 @@ func init() {
         "availableSpace": {
           "type": "integer"
         },
+        "diskIndex": {
+          "type": "integer"
+        },
         "drivePath": {
           "type": "string"
         },
@@ -13395,9 +20989,15 @@ func init() {
         "model": {
           "type": "string"
         },
+        "poolIndex": {
+          "type": "integer"
+        },
         "rootDisk": {
           "type": "boolean"
         },
+        "setIndex": {
+          "type": "integer"
+        },
         "state": {
           "type": "string"
         },
@@ -13461,6 +21061,72 @@ func init() {
         }
       }
     },
+    "serviceAccountExpiry": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "deleteAt": {
+          "description": "when the disabled service account is deleted",
+          "type": "string"
+        },
+        "disabledAt": {
+          "type": "string"
+        },
+        "error": {
+          "description": "last error disabling or deleting the service account, retried by the scheduler",
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "rotatedFrom": {
+          "type": "string"
+        },
+        "rotatedTo": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "active",
+            "disabled"
+          ]
+        }
+      }
+    },
+    "serviceAccountExpiryList": {
+      "type": "object",
+      "properties": {
+        "accounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountExpiry"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "serviceAccountExpiryRequest": {
+      "type": "object",
+      "properties": {
+        "expiresAt": {
+          "description": "RFC 3339 time, leave it and expiresIn empty to remove the expiration",
+          "type": "string"
+        },
+        "expiresIn": {
+          "description": "duration like 720h, used when expiresAt is empty",
+          "type": "string"
+        }
+      }
+    },
     "serviceAccountRequest": {
       "type": "object",
       "properties": {
@@ -13485,10 +21151,104 @@ func init() {
         }
       }
     },
+    "serviceAccountRotateRequest": {
+      "type": "object",
+      "properties": {
+        "expiresIn": {
+          "description": "expiration of the new credentials, as a duration like 720h",
+          "type": "string"
+        },
+        "gracePeriod": {
+          "description": "how long the old credentials stay valid",
+          "type": "string",
+          "default": "24h"
+        }
+      }
+    },
+    "serviceAccountRotation": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "description": "access key of the new service account, MinIO keeps a single secret key per service account so a rotation issues a new access key as well",
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string"
+        },
+        "previousAccessKey": {
+          "description": "access key of the rotated service account, disabled and then deleted once it expires",
+          "type": "string"
+        },
+        "previousExpiresAt": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountUsage": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "apiCalls": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "lastUsed": {
+          "type": "string"
+        },
+        "neverUsed": {
+          "description": "no request was seen since the usage is tracked",
+          "type": "boolean"
+        },
+        "parentUser": {
+          "type": "string"
+        },
+        "sourceIps": {
+          "description": "most recent client addresses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "totalCalls": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "serviceAccountUsageList": {
+      "type": "object",
+      "properties": {
+        "accounts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceAccountUsage"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "trackedSince": {
+          "description": "when the console started tracking the usage, empty when it isn't tracked",
+          "type": "string"
+        }
+      }
+    },
     "serviceAccounts": {
       "type": "array",
       "items": {
-        "type": "string"
+        "$ref": "#/definitions/serviceAccountUsage"
       }
     },
     "sessionResponse": {
@@ -13719,49 +21479,172 @@ func init() {
         "serviceAccountAccessKey": {
           "type": "string"
         },
-        "sites": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/peerInfo"
-          }
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerInfo"
+          }
+        }
+      }
+    },
+    "siteReplicationStatusResponse": {
+      "type": "object",
+      "properties": {
+        "bucketStats": {
+          "type": "object"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "groupStats": {
+          "type": "object"
+        },
+        "maxBuckets": {
+          "type": "integer"
+        },
+        "maxGroups": {
+          "type": "integer"
+        },
+        "maxPolicies": {
+          "type": "integer"
+        },
+        "maxUsers": {
+          "type": "integer"
+        },
+        "policyStats": {
+          "type": "object"
+        },
+        "sites": {
+          "type": "object"
+        },
+        "statsSummary": {
+          "type": "object"
+        },
+        "userStats": {
+          "type": "object"
+        }
+      }
+    },
+    "speedtestComparison": {
+      "type": "object",
+      "properties": {
+        "base": {
+          "$ref": "#/definitions/speedtestResult"
+        },
+        "metrics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestMetricDiff"
+          }
+        },
+        "parametersChanged": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "target": {
+          "$ref": "#/definitions/speedtestResult"
+        }
+      }
+    },
+    "speedtestMetric": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "description": "server or drive the metric belongs to, empty for cluster totals",
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "description": "metric identifier, e.g. put.throughput or drive.read",
+          "type": "string"
+        },
+        "unit": {
+          "type": "string"
+        },
+        "value": {
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "speedtestMetricDiff": {
+      "type": "object",
+      "properties": {
+        "base": {
+          "type": "number",
+          "format": "double"
+        },
+        "delta": {
+          "type": "number",
+          "format": "double"
+        },
+        "deltaPercent": {
+          "description": "change relative to base, empty when base is 0",
+          "type": "number",
+          "format": "double"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "unchanged",
+            "changed",
+            "added",
+            "removed"
+          ]
+        },
+        "target": {
+          "type": "number",
+          "format": "double"
+        },
+        "unit": {
+          "type": "string"
         }
       }
     },
-    "siteReplicationStatusResponse": {
+    "speedtestResult": {
       "type": "object",
       "properties": {
-        "bucketStats": {
-          "type": "object"
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "groupStats": {
-          "type": "object"
-        },
-        "maxBuckets": {
-          "type": "integer"
-        },
-        "maxGroups": {
-          "type": "integer"
+        "endTime": {
+          "type": "string"
         },
-        "maxPolicies": {
-          "type": "integer"
+        "error": {
+          "type": "string"
         },
-        "maxUsers": {
-          "type": "integer"
+        "id": {
+          "type": "string"
         },
-        "policyStats": {
-          "type": "object"
+        "metrics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/speedtestMetric"
+          }
         },
-        "sites": {
-          "type": "object"
+        "mode": {
+          "type": "string",
+          "enum": [
+            "object",
+            "drive",
+            "net"
+          ]
         },
-        "statsSummary": {
-          "type": "object"
+        "parameters": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         },
-        "userStats": {
-          "type": "object"
+        "startTime": {
+          "type": "string"
         }
       }
     },
@@ -13886,160 +21769,471 @@ func init() {
         }
       }
     },
-    "tier": {
+    "tier": {
+      "type": "object",
+      "properties": {
+        "azure": {
+          "type": "object",
+          "$ref": "#/definitions/tier_azure"
+        },
+        "gcs": {
+          "type": "object",
+          "$ref": "#/definitions/tier_gcs"
+        },
+        "s3": {
+          "type": "object",
+          "$ref": "#/definitions/tier_s3"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "s3",
+            "gcs",
+            "azure",
+            "unsupported"
+          ]
+        }
+      }
+    },
+    "tierCredentialsRequest": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "creds": {
+          "description": "a base64 encoded value",
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "tierListResponse": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tier"
+          }
+        }
+      }
+    },
+    "tier_azure": {
+      "type": "object",
+      "properties": {
+        "accountkey": {
+          "type": "string"
+        },
+        "accountname": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "string"
+        }
+      }
+    },
+    "tier_gcs": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "creds": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "string"
+        }
+      }
+    },
+    "tier_s3": {
+      "type": "object",
+      "properties": {
+        "accesskey": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretkey": {
+          "type": "string"
+        },
+        "storageclass": {
+          "type": "string"
+        },
+        "usage": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "string"
+        }
+      }
+    },
+    "topologyDrive": {
+      "type": "object",
+      "properties": {
+        "availableSpace": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "freeInodes": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healing": {
+          "$ref": "#/definitions/topologyDriveHealing"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "model": {
+          "type": "string"
+        },
+        "operations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyDriveOperation"
+          }
+        },
+        "path": {
+          "type": "string"
+        },
+        "readLatency": {
+          "type": "number"
+        },
+        "readThroughput": {
+          "type": "number"
+        },
+        "server": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "totalSpace": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "usedSpace": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "utilization": {
+          "type": "number"
+        },
+        "uuid": {
+          "type": "string"
+        },
+        "writeLatency": {
+          "type": "number"
+        },
+        "writeThroughput": {
+          "type": "number"
+        }
+      }
+    },
+    "topologyDriveHealing": {
       "type": "object",
       "properties": {
-        "azure": {
-          "type": "object",
-          "$ref": "#/definitions/tier_azure"
+        "bytesDone": {
+          "type": "integer",
+          "format": "int64"
         },
-        "gcs": {
-          "type": "object",
-          "$ref": "#/definitions/tier_gcs"
+        "bytesFailed": {
+          "type": "integer",
+          "format": "int64"
         },
-        "s3": {
-          "type": "object",
-          "$ref": "#/definitions/tier_s3"
+        "currentBucket": {
+          "type": "string"
         },
-        "type": {
-          "type": "string",
-          "enum": [
-            "s3",
-            "gcs",
-            "azure",
-            "unsupported"
-          ]
+        "currentObject": {
+          "type": "string"
+        },
+        "healedBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "itemsFailed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "itemsHealed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastUpdate": {
+          "type": "string"
+        },
+        "objectsTotalCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objectsTotalSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "progress": {
+          "description": "percentage of the objects healed",
+          "type": "number"
+        },
+        "queuedBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "started": {
+          "type": "string"
         }
       }
     },
-    "tierCredentialsRequest": {
+    "topologyDriveOperation": {
       "type": "object",
       "properties": {
-        "access_key": {
-          "type": "string"
+        "avgLatency": {
+          "description": "average latency in milliseconds",
+          "type": "number"
         },
-        "creds": {
-          "description": "a base64 encoded value",
-          "type": "string"
+        "count": {
+          "description": "operations on the last minute",
+          "type": "integer",
+          "format": "int64"
         },
-        "secret_key": {
+        "name": {
           "type": "string"
         }
       }
     },
-    "tierListResponse": {
+    "topologyPool": {
       "type": "object",
       "properties": {
-        "items": {
+        "healingDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "offlineDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "onlineDrives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "servers": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/tier"
+            "type": "string"
+          }
+        },
+        "sets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologySet"
           }
         }
       }
     },
-    "tier_azure": {
+    "topologySet": {
       "type": "object",
       "properties": {
-        "accountkey": {
-          "type": "string"
-        },
-        "accountname": {
-          "type": "string"
-        },
-        "bucket": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/topologyDrive"
+          }
         },
-        "objects": {
-          "type": "string"
+        "healingDrives": {
+          "type": "integer",
+          "format": "int64"
         },
-        "prefix": {
-          "type": "string"
+        "index": {
+          "type": "integer",
+          "format": "int32"
         },
-        "region": {
-          "type": "string"
+        "offlineDrives": {
+          "type": "integer",
+          "format": "int64"
         },
-        "usage": {
-          "type": "string"
+        "onlineDrives": {
+          "type": "integer",
+          "format": "int64"
         },
-        "versions": {
-          "type": "string"
+        "status": {
+          "type": "string",
+          "enum": [
+            "healthy",
+            "healing",
+            "degraded",
+            "unavailable"
+          ]
         }
       }
     },
-    "tier_gcs": {
+    "traceApiStats": {
       "type": "object",
       "properties": {
-        "bucket": {
-          "type": "string"
-        },
-        "creds": {
+        "api": {
           "type": "string"
         },
-        "endpoint": {
-          "type": "string"
+        "count": {
+          "type": "integer",
+          "format": "int64"
         },
-        "name": {
-          "type": "string"
+        "errorRate": {
+          "type": "number",
+          "format": "double"
         },
-        "objects": {
-          "type": "string"
+        "errors": {
+          "type": "integer",
+          "format": "int64"
         },
-        "prefix": {
-          "type": "string"
+        "p50": {
+          "description": "latency in milliseconds",
+          "type": "number",
+          "format": "double"
         },
-        "region": {
-          "type": "string"
+        "p90": {
+          "description": "latency in milliseconds",
+          "type": "number",
+          "format": "double"
         },
-        "usage": {
+        "p99": {
+          "description": "latency in milliseconds",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "traceClientStats": {
+      "type": "object",
+      "properties": {
+        "client": {
           "type": "string"
         },
-        "versions": {
-          "type": "string"
+        "count": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "tier_s3": {
+    "traceRecording": {
       "type": "object",
       "properties": {
-        "accesskey": {
+        "endTime": {
           "type": "string"
         },
-        "bucket": {
-          "type": "string"
+        "entries": {
+          "type": "integer",
+          "format": "int64"
         },
-        "endpoint": {
+        "id": {
           "type": "string"
         },
-        "name": {
+        "query": {
+          "description": "trace options the session was recorded with",
           "type": "string"
         },
-        "objects": {
-          "type": "string"
+        "size": {
+          "type": "integer",
+          "format": "int64"
         },
-        "prefix": {
+        "startTime": {
           "type": "string"
+        }
+      }
+    },
+    "traceRecordingSummary": {
+      "type": "object",
+      "properties": {
+        "apis": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/traceApiStats"
+          }
         },
-        "region": {
-          "type": "string"
+        "errorRate": {
+          "type": "number",
+          "format": "double"
         },
-        "secretkey": {
-          "type": "string"
+        "errors": {
+          "type": "integer",
+          "format": "int64"
         },
-        "storageclass": {
+        "id": {
           "type": "string"
         },
-        "usage": {
-          "type": "string"
+        "topClients": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/traceClientStats"
+          }
         },
-        "versions": {
-          "type": "string"
+        "totalCalls": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -14246,6 +22440,102 @@ func init() {
         }
       }
     },
+    "usersImportRequest": {
+      "type": "object",
+      "required": [
+        "data"
+      ],
+      "properties": {
+        "data": {
+          "description": "CSV with the columns access_key, secret_key, groups, policies and status, groups and policies separated by semicolons, or a JSON array of users. Missing columns or fields leave existing users unchanged",
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "format": {
+          "type": "string",
+          "default": "csv",
+          "enum": [
+            "csv",
+            "json"
+          ]
+        }
+      }
+    },
+    "usersImportResult": {
+      "type": "object",
+      "properties": {
+        "applied": {
+          "description": "false when a row is invalid, nothing is applied then",
+          "type": "boolean"
+        },
+        "created": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/usersImportRow"
+          }
+        },
+        "secretsId": {
+          "description": "id to download the generated secret keys, they can be downloaded once",
+          "type": "string"
+        },
+        "unchanged": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "updated": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "usersImportRow": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "action": {
+          "type": "string",
+          "enum": [
+            "create",
+            "update",
+            "unchanged",
+            "invalid",
+            "failed"
+          ]
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "type": "string"
+        },
+        "row": {
+          "description": "position of the user in the data starting at 1",
+          "type": "integer",
+          "format": "int32"
+        },
+        "secretGenerated": {
+          "type": "boolean"
+        }
+      }
+    },
     "widget": {
       "type": "object",
       "properties": {
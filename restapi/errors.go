@@ -69,6 +69,40 @@ var (
 	ErrDeletingEncryptionConfig         = errors.New("error disabling tenant encryption")
 	ErrEncryptionConfigNotFound         = errors.New("encryption configuration not found")
 	ErrPolicyNotFound                   = errors.New("policy does not exist")
+	ErrInvalidLogSearchFilter           = errors.New("invalid log search filter, filters must be in the form key:value")
+	ErrTraceRecordingNotFound           = errors.New("trace recording not found")
+	ErrTraceRecordingInProgress         = errors.New("the trace session is still being recorded")
+	ErrHealJobNotFound                  = errors.New("heal job not found")
+	ErrSpeedtestResultNotFound          = errors.New("speedtest result not found")
+	ErrSpeedtestModeMismatch            = errors.New("only speedtest results of the same mode can be compared")
+	ErrHealthReportNotFound             = errors.New("health report not found")
+	ErrHealthReportVersion              = errors.New("health report version not supported")
+	ErrDashboardWidgetNotFound          = errors.New("dashboard widget not found")
+	ErrDashboardWidgetScope             = errors.New("the scope of a dashboard widget can't be changed")
+	ErrDashboardWidgetsOrder            = errors.New("the order must list every widget of the scope once")
+	ErrDashboardVersion                 = errors.New("dashboard version not supported")
+	ErrAlertRuleNotFound                = errors.New("alert rule not found")
+	ErrAlertChannelNotFound             = errors.New("alert channel not found")
+	ErrAlertNotFound                    = errors.New("alert not found")
+	ErrPrincipalNotFound                = errors.New("user or service account does not exist")
+	ErrIAMBundleVersion                 = errors.New("IAM bundle version not supported")
+	ErrIAMBundleInvalid                 = errors.New("invalid IAM bundle")
+	ErrPolicyRevisionNotFound           = errors.New("policy revision not found")
+	ErrPolicyTemplateNotFound           = errors.New("policy template not found")
+	ErrPolicyTemplateExists             = errors.New("a policy template with the same id already exists")
+	ErrPolicyTemplateBuiltin            = errors.New("built-in policy templates can't be changed")
+	ErrInvalidPolicyTemplate            = errors.New("invalid policy template")
+	ErrInvalidAccessGrant               = errors.New("invalid access grant")
+	ErrAccessGrantsDisabled             = errors.New("access grants are disabled, the console has no credentials to detach the expired grants")
+	ErrAccessGrantNotFound              = errors.New("access grant not found")
+	ErrAccessGrantEnded                 = errors.New("the access grant has ended already")
+	ErrInvalidUsersImport               = errors.New("invalid users import data")
+	ErrImportedSecretsNotFound          = errors.New("the generated secret keys were downloaded already or expired")
+	ErrServiceAccountNotFound           = errors.New("service account not found")
+	ErrInvalidServiceAccountExpiry      = errors.New("invalid service account expiration")
+	ErrServiceAccountExpiryDisabled     = errors.New("service account expiration is disabled, the console has no credentials to disable the expired service accounts")
+	ErrServiceAccountUsageDisabled      = errors.New("service account usage is not tracked, the console has no credentials to read the trace stream")
+	ErrInvalidStaleServiceAccounts      = errors.New("invalid stale service accounts query")
 )
 
 // ErrorWithContext :
@@ -174,6 +208,142 @@ func ErrorWithContext(ctx context.Context, err ...interface{}) *models.Error {
 				errorCode = 404
 				errorMessage = ErrPolicyNotFound.Error()
 			}
+			if errors.Is(err1, ErrInvalidLogSearchFilter) {
+				errorCode = 400
+				errorMessage = ErrInvalidLogSearchFilter.Error()
+			}
+			if errors.Is(err1, ErrTraceRecordingNotFound) {
+				errorCode = 404
+				errorMessage = ErrTraceRecordingNotFound.Error()
+			}
+			if errors.Is(err1, ErrTraceRecordingInProgress) {
+				errorCode = 409
+				errorMessage = ErrTraceRecordingInProgress.Error()
+			}
+			if errors.Is(err1, ErrHealJobNotFound) {
+				errorCode = 404
+				errorMessage = ErrHealJobNotFound.Error()
+			}
+			if errors.Is(err1, ErrSpeedtestResultNotFound) {
+				errorCode = 404
+				errorMessage = ErrSpeedtestResultNotFound.Error()
+			}
+			if errors.Is(err1, ErrSpeedtestModeMismatch) {
+				errorCode = 400
+				errorMessage = ErrSpeedtestModeMismatch.Error()
+			}
+			if errors.Is(err1, ErrHealthReportNotFound) {
+				errorCode = 404
+				errorMessage = ErrHealthReportNotFound.Error()
+			}
+			if errors.Is(err1, ErrHealthReportVersion) {
+				errorCode = 400
+				errorMessage = ErrHealthReportVersion.Error()
+			}
+			if errors.Is(err1, ErrDashboardWidgetNotFound) {
+				errorCode = 404
+				errorMessage = ErrDashboardWidgetNotFound.Error()
+			}
+			if errors.Is(err1, ErrDashboardWidgetScope) {
+				errorCode = 400
+				errorMessage = ErrDashboardWidgetScope.Error()
+			}
+			if errors.Is(err1, ErrDashboardWidgetsOrder) {
+				errorCode = 400
+				errorMessage = ErrDashboardWidgetsOrder.Error()
+			}
+			if errors.Is(err1, ErrDashboardVersion) {
+				errorCode = 400
+				errorMessage = ErrDashboardVersion.Error()
+			}
+			if errors.Is(err1, ErrAlertRuleNotFound) {
+				errorCode = 404
+				errorMessage = ErrAlertRuleNotFound.Error()
+			}
+			if errors.Is(err1, ErrAlertChannelNotFound) {
+				errorCode = 404
+				errorMessage = ErrAlertChannelNotFound.Error()
+			}
+			if errors.Is(err1, ErrAlertNotFound) {
+				errorCode = 404
+				errorMessage = ErrAlertNotFound.Error()
+			}
+			if errors.Is(err1, ErrPrincipalNotFound) {
+				errorCode = 404
+				errorMessage = ErrPrincipalNotFound.Error()
+			}
+			if errors.Is(err1, ErrIAMBundleVersion) {
+				errorCode = 400
+				errorMessage = ErrIAMBundleVersion.Error()
+			}
+			if errors.Is(err1, ErrIAMBundleInvalid) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrPolicyRevisionNotFound) {
+				errorCode = 404
+				errorMessage = ErrPolicyRevisionNotFound.Error()
+			}
+			if errors.Is(err1, ErrPolicyTemplateNotFound) {
+				errorCode = 404
+				errorMessage = ErrPolicyTemplateNotFound.Error()
+			}
+			if errors.Is(err1, ErrPolicyTemplateExists) {
+				errorCode = 400
+				errorMessage = ErrPolicyTemplateExists.Error()
+			}
+			if errors.Is(err1, ErrPolicyTemplateBuiltin) {
+				errorCode = 400
+				errorMessage = ErrPolicyTemplateBuiltin.Error()
+			}
+			if errors.Is(err1, ErrInvalidPolicyTemplate) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrInvalidAccessGrant) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrAccessGrantsDisabled) {
+				errorCode = 400
+				errorMessage = ErrAccessGrantsDisabled.Error()
+			}
+			if errors.Is(err1, ErrAccessGrantNotFound) {
+				errorCode = 404
+				errorMessage = ErrAccessGrantNotFound.Error()
+			}
+			if errors.Is(err1, ErrAccessGrantEnded) {
+				errorCode = 400
+				errorMessage = ErrAccessGrantEnded.Error()
+			}
+			if errors.Is(err1, ErrInvalidUsersImport) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrImportedSecretsNotFound) {
+				errorCode = 404
+				errorMessage = ErrImportedSecretsNotFound.Error()
+			}
+			if errors.Is(err1, ErrServiceAccountNotFound) {
+				errorCode = 404
+				errorMessage = ErrServiceAccountNotFound.Error()
+			}
+			if errors.Is(err1, ErrInvalidServiceAccountExpiry) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
+			if errors.Is(err1, ErrServiceAccountExpiryDisabled) {
+				errorCode = 400
+				errorMessage = ErrServiceAccountExpiryDisabled.Error()
+			}
+			if errors.Is(err1, ErrServiceAccountUsageDisabled) {
+				errorCode = 400
+				errorMessage = ErrServiceAccountUsageDisabled.Error()
+			}
+			if errors.Is(err1, ErrInvalidStaleServiceAccounts) {
+				errorCode = 400
+				errorMessage = err1.Error()
+			}
 			if madmin.ToErrorResponse(err1).Code == "AccessDenied" {
 				errorCode = 403
 				errorMessage = ErrAccessDenied.Error()
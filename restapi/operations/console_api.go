@@ -43,6 +43,7 @@ import (
 	"github.com/GuinsooLab/console/restapi/operations/bucket"
 	"github.com/GuinsooLab/console/restapi/operations/configuration"
 	"github.com/GuinsooLab/console/restapi/operations/group"
+	"github.com/GuinsooLab/console/restapi/operations/heal"
 	"github.com/GuinsooLab/console/restapi/operations/inspect"
 	"github.com/GuinsooLab/console/restapi/operations/logging"
 	"github.com/GuinsooLab/console/restapi/operations/object"
@@ -51,9 +52,11 @@ import (
 	"github.com/GuinsooLab/console/restapi/operations/service"
 	"github.com/GuinsooLab/console/restapi/operations/service_account"
 	"github.com/GuinsooLab/console/restapi/operations/site_replication"
+	"github.com/GuinsooLab/console/restapi/operations/speedtest"
 	"github.com/GuinsooLab/console/restapi/operations/subnet"
 	"github.com/GuinsooLab/console/restapi/operations/system"
 	"github.com/GuinsooLab/console/restapi/operations/tiering"
+	"github.com/GuinsooLab/console/restapi/operations/trace"
 	"github.com/GuinsooLab/console/restapi/operations/user"
 )
 
@@ -87,6 +90,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AccountAccountChangePasswordHandler: account.AccountChangePasswordHandlerFunc(func(params account.AccountChangePasswordParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation account.AccountChangePassword has not yet been implemented")
 		}),
+		SystemAcknowledgeAlertHandler: system.AcknowledgeAlertHandlerFunc(func(params system.AcknowledgeAlertParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.AcknowledgeAlert has not yet been implemented")
+		}),
 		BucketAddBucketLifecycleHandler: bucket.AddBucketLifecycleHandlerFunc(func(params bucket.AddBucketLifecycleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.AddBucketLifecycle has not yet been implemented")
 		}),
@@ -126,24 +132,45 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserBulkUpdateUsersGroupsHandler: user.BulkUpdateUsersGroupsHandlerFunc(func(params user.BulkUpdateUsersGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.BulkUpdateUsersGroups has not yet been implemented")
 		}),
+		SystemCapacityForecastHandler: system.CapacityForecastHandlerFunc(func(params system.CapacityForecastParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CapacityForecast has not yet been implemented")
+		}),
 		AccountChangeUserPasswordHandler: account.ChangeUserPasswordHandlerFunc(func(params account.ChangeUserPasswordParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation account.ChangeUserPassword has not yet been implemented")
 		}),
 		SystemCheckMinIOVersionHandler: system.CheckMinIOVersionHandlerFunc(func(params system.CheckMinIOVersionParams) middleware.Responder {
 			return middleware.NotImplemented("operation system.CheckMinIOVersion has not yet been implemented")
 		}),
+		SystemCheckPrometheusHandler: system.CheckPrometheusHandlerFunc(func(params system.CheckPrometheusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CheckPrometheus has not yet been implemented")
+		}),
 		UserCheckUserServiceAccountsHandler: user.CheckUserServiceAccountsHandlerFunc(func(params user.CheckUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CheckUserServiceAccounts has not yet been implemented")
 		}),
+		SpeedtestCompareSpeedtestResultsHandler: speedtest.CompareSpeedtestResultsHandlerFunc(func(params speedtest.CompareSpeedtestResultsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.CompareSpeedtestResults has not yet been implemented")
+		}),
 		ConfigurationConfigInfoHandler: configuration.ConfigInfoHandlerFunc(func(params configuration.ConfigInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ConfigInfo has not yet been implemented")
 		}),
 		UserCreateAUserServiceAccountHandler: user.CreateAUserServiceAccountHandlerFunc(func(params user.CreateAUserServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.CreateAUserServiceAccount has not yet been implemented")
 		}),
+		PolicyCreateAccessGrantHandler: policy.CreateAccessGrantHandlerFunc(func(params policy.CreateAccessGrantParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.CreateAccessGrant has not yet been implemented")
+		}),
+		SystemCreateAlertRuleHandler: system.CreateAlertRuleHandlerFunc(func(params system.CreateAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CreateAlertRule has not yet been implemented")
+		}),
 		BucketCreateBucketEventHandler: bucket.CreateBucketEventHandlerFunc(func(params bucket.CreateBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.CreateBucketEvent has not yet been implemented")
 		}),
+		SystemCreateDashboardWidgetHandler: system.CreateDashboardWidgetHandlerFunc(func(params system.CreateDashboardWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.CreateDashboardWidget has not yet been implemented")
+		}),
+		PolicyCreatePolicyTemplateHandler: policy.CreatePolicyTemplateHandlerFunc(func(params policy.CreatePolicyTemplateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.CreatePolicyTemplate has not yet been implemented")
+		}),
 		ServiceAccountCreateServiceAccountHandler: service_account.CreateServiceAccountHandlerFunc(func(params service_account.CreateServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.CreateServiceAccount has not yet been implemented")
 		}),
@@ -159,6 +186,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketDeleteAccessRuleWithBucketHandler: bucket.DeleteAccessRuleWithBucketHandlerFunc(func(params bucket.DeleteAccessRuleWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAccessRuleWithBucket has not yet been implemented")
 		}),
+		SystemDeleteAlertRuleHandler: system.DeleteAlertRuleHandlerFunc(func(params system.DeleteAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DeleteAlertRule has not yet been implemented")
+		}),
 		BucketDeleteAllReplicationRulesHandler: bucket.DeleteAllReplicationRulesHandlerFunc(func(params bucket.DeleteAllReplicationRulesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteAllReplicationRules has not yet been implemented")
 		}),
@@ -174,6 +204,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketDeleteBucketReplicationRuleHandler: bucket.DeleteBucketReplicationRuleHandlerFunc(func(params bucket.DeleteBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteBucketReplicationRule has not yet been implemented")
 		}),
+		SystemDeleteDashboardWidgetHandler: system.DeleteDashboardWidgetHandlerFunc(func(params system.DeleteDashboardWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DeleteDashboardWidget has not yet been implemented")
+		}),
 		ObjectDeleteMultipleObjectsHandler: object.DeleteMultipleObjectsHandlerFunc(func(params object.DeleteMultipleObjectsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DeleteMultipleObjects has not yet been implemented")
 		}),
@@ -186,6 +219,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ObjectDeleteObjectRetentionHandler: object.DeleteObjectRetentionHandlerFunc(func(params object.DeleteObjectRetentionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DeleteObjectRetention has not yet been implemented")
 		}),
+		PolicyDeletePolicyTemplateHandler: policy.DeletePolicyTemplateHandlerFunc(func(params policy.DeletePolicyTemplateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.DeletePolicyTemplate has not yet been implemented")
+		}),
 		BucketDeleteRemoteBucketHandler: bucket.DeleteRemoteBucketHandlerFunc(func(params bucket.DeleteRemoteBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DeleteRemoteBucket has not yet been implemented")
 		}),
@@ -195,18 +231,57 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ServiceAccountDeleteServiceAccountHandler: service_account.DeleteServiceAccountHandlerFunc(func(params service_account.DeleteServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.DeleteServiceAccount has not yet been implemented")
 		}),
+		SpeedtestDeleteSpeedtestResultHandler: speedtest.DeleteSpeedtestResultHandlerFunc(func(params speedtest.DeleteSpeedtestResultParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.DeleteSpeedtestResult has not yet been implemented")
+		}),
+		TraceDeleteTraceRecordingHandler: trace.DeleteTraceRecordingHandlerFunc(func(params trace.DeleteTraceRecordingParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation trace.DeleteTraceRecording has not yet been implemented")
+		}),
+		SystemDiffHealthReportsHandler: system.DiffHealthReportsHandlerFunc(func(params system.DiffHealthReportsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DiffHealthReports has not yet been implemented")
+		}),
+		PolicyDiffPolicyRevisionsHandler: policy.DiffPolicyRevisionsHandlerFunc(func(params policy.DiffPolicyRevisionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.DiffPolicyRevisions has not yet been implemented")
+		}),
 		BucketDisableBucketEncryptionHandler: bucket.DisableBucketEncryptionHandlerFunc(func(params bucket.DisableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.DisableBucketEncryption has not yet been implemented")
 		}),
+		LoggingDownloadConsoleLogsHandler: logging.DownloadConsoleLogsHandlerFunc(func(params logging.DownloadConsoleLogsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation logging.DownloadConsoleLogs has not yet been implemented")
+		}),
+		SystemDownloadHealthReportHandler: system.DownloadHealthReportHandlerFunc(func(params system.DownloadHealthReportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.DownloadHealthReport has not yet been implemented")
+		}),
+		UserDownloadImportedUsersSecretsHandler: user.DownloadImportedUsersSecretsHandlerFunc(func(params user.DownloadImportedUsersSecretsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.DownloadImportedUsersSecrets has not yet been implemented")
+		}),
 		ObjectDownloadObjectHandler: object.DownloadObjectHandlerFunc(func(params object.DownloadObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.DownloadObject has not yet been implemented")
 		}),
+		TraceDownloadTraceRecordingHandler: trace.DownloadTraceRecordingHandlerFunc(func(params trace.DownloadTraceRecordingParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation trace.DownloadTraceRecording has not yet been implemented")
+		}),
 		TieringEditTierCredentialsHandler: tiering.EditTierCredentialsHandlerFunc(func(params tiering.EditTierCredentialsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.EditTierCredentials has not yet been implemented")
 		}),
+		PolicyEffectivePermissionsHandler: policy.EffectivePermissionsHandlerFunc(func(params policy.EffectivePermissionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.EffectivePermissions has not yet been implemented")
+		}),
 		BucketEnableBucketEncryptionHandler: bucket.EnableBucketEncryptionHandlerFunc(func(params bucket.EnableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.EnableBucketEncryption has not yet been implemented")
 		}),
+		SystemErasureTopologyHandler: system.ErasureTopologyHandlerFunc(func(params system.ErasureTopologyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ErasureTopology has not yet been implemented")
+		}),
+		SystemExportDashboardHandler: system.ExportDashboardHandlerFunc(func(params system.ExportDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ExportDashboard has not yet been implemented")
+		}),
+		PolicyExportEffectivePermissionsHandler: policy.ExportEffectivePermissionsHandlerFunc(func(params policy.ExportEffectivePermissionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ExportEffectivePermissions has not yet been implemented")
+		}),
+		PolicyExportIAMHandler: policy.ExportIAMHandlerFunc(func(params policy.ExportIAMParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ExportIAM has not yet been implemented")
+		}),
 		BucketGetBucketEncryptionInfoHandler: bucket.GetBucketEncryptionInfoHandlerFunc(func(params bucket.GetBucketEncryptionInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.GetBucketEncryptionInfo has not yet been implemented")
 		}),
@@ -249,6 +324,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SiteReplicationGetSiteReplicationStatusHandler: site_replication.GetSiteReplicationStatusHandlerFunc(func(params site_replication.GetSiteReplicationStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.GetSiteReplicationStatus has not yet been implemented")
 		}),
+		SpeedtestGetSpeedtestResultHandler: speedtest.GetSpeedtestResultHandlerFunc(func(params speedtest.GetSpeedtestResultParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.GetSpeedtestResult has not yet been implemented")
+		}),
 		TieringGetTierHandler: tiering.GetTierHandlerFunc(func(params tiering.GetTierParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.GetTier has not yet been implemented")
 		}),
@@ -261,15 +339,42 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		GroupGroupInfoHandler: group.GroupInfoHandlerFunc(func(params group.GroupInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.GroupInfo has not yet been implemented")
 		}),
+		SystemImportDashboardHandler: system.ImportDashboardHandlerFunc(func(params system.ImportDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ImportDashboard has not yet been implemented")
+		}),
+		PolicyImportIAMHandler: policy.ImportIAMHandlerFunc(func(params policy.ImportIAMParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ImportIAM has not yet been implemented")
+		}),
+		UserImportUsersHandler: user.ImportUsersHandlerFunc(func(params user.ImportUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ImportUsers has not yet been implemented")
+		}),
 		InspectInspectHandler: inspect.InspectHandlerFunc(func(params inspect.InspectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation inspect.Inspect has not yet been implemented")
 		}),
+		PolicyLintPolicyHandler: policy.LintPolicyHandlerFunc(func(params policy.LintPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.LintPolicy has not yet been implemented")
+		}),
 		UserListAUserServiceAccountsHandler: user.ListAUserServiceAccountsHandlerFunc(func(params user.ListAUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.ListAUserServiceAccounts has not yet been implemented")
 		}),
+		UserListAUserServiceAccountsUsageHandler: user.ListAUserServiceAccountsUsageHandlerFunc(func(params user.ListAUserServiceAccountsUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user.ListAUserServiceAccountsUsage has not yet been implemented")
+		}),
+		PolicyListAccessGrantsHandler: policy.ListAccessGrantsHandlerFunc(func(params policy.ListAccessGrantsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ListAccessGrants has not yet been implemented")
+		}),
 		BucketListAccessRulesWithBucketHandler: bucket.ListAccessRulesWithBucketHandlerFunc(func(params bucket.ListAccessRulesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListAccessRulesWithBucket has not yet been implemented")
 		}),
+		SystemListAlertChannelsHandler: system.ListAlertChannelsHandlerFunc(func(params system.ListAlertChannelsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListAlertChannels has not yet been implemented")
+		}),
+		SystemListAlertRulesHandler: system.ListAlertRulesHandlerFunc(func(params system.ListAlertRulesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListAlertRules has not yet been implemented")
+		}),
+		SystemListAlertsHandler: system.ListAlertsHandlerFunc(func(params system.ListAlertsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListAlerts has not yet been implemented")
+		}),
 		BucketListBucketEventsHandler: bucket.ListBucketEventsHandlerFunc(func(params bucket.ListBucketEventsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListBucketEvents has not yet been implemented")
 		}),
@@ -279,6 +384,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ConfigurationListConfigHandler: configuration.ListConfigHandlerFunc(func(params configuration.ListConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ListConfig has not yet been implemented")
 		}),
+		SystemListDashboardWidgetsHandler: system.ListDashboardWidgetsHandlerFunc(func(params system.ListDashboardWidgetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListDashboardWidgets has not yet been implemented")
+		}),
+		ServiceAccountListExpiringServiceAccountsHandler: service_account.ListExpiringServiceAccountsHandlerFunc(func(params service_account.ListExpiringServiceAccountsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.ListExpiringServiceAccounts has not yet been implemented")
+		}),
 		BucketListExternalBucketsHandler: bucket.ListExternalBucketsHandlerFunc(func(params bucket.ListExternalBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListExternalBuckets has not yet been implemented")
 		}),
@@ -288,6 +399,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		PolicyListGroupsForPolicyHandler: policy.ListGroupsForPolicyHandlerFunc(func(params policy.ListGroupsForPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.ListGroupsForPolicy has not yet been implemented")
 		}),
+		HealListHealJobsHandler: heal.ListHealJobsHandlerFunc(func(params heal.ListHealJobsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation heal.ListHealJobs has not yet been implemented")
+		}),
+		SystemListHealthReportsHandler: system.ListHealthReportsHandlerFunc(func(params system.ListHealthReportsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.ListHealthReports has not yet been implemented")
+		}),
 		SystemListNodesHandler: system.ListNodesHandlerFunc(func(params system.ListNodesParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation system.ListNodes has not yet been implemented")
 		}),
@@ -300,12 +417,30 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		BucketListPoliciesWithBucketHandler: bucket.ListPoliciesWithBucketHandlerFunc(func(params bucket.ListPoliciesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListPoliciesWithBucket has not yet been implemented")
 		}),
+		PolicyListPolicyRevisionsHandler: policy.ListPolicyRevisionsHandlerFunc(func(params policy.ListPolicyRevisionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ListPolicyRevisions has not yet been implemented")
+		}),
+		PolicyListPolicyTemplatesHandler: policy.ListPolicyTemplatesHandlerFunc(func(params policy.ListPolicyTemplatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.ListPolicyTemplates has not yet been implemented")
+		}),
 		BucketListRemoteBucketsHandler: bucket.ListRemoteBucketsHandlerFunc(func(params bucket.ListRemoteBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.ListRemoteBuckets has not yet been implemented")
 		}),
+		SpeedtestListSpeedtestResultsHandler: speedtest.ListSpeedtestResultsHandlerFunc(func(params speedtest.ListSpeedtestResultsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation speedtest.ListSpeedtestResults has not yet been implemented")
+		}),
+		ServiceAccountListStaleServiceAccountsHandler: service_account.ListStaleServiceAccountsHandlerFunc(func(params service_account.ListStaleServiceAccountsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.ListStaleServiceAccounts has not yet been implemented")
+		}),
+		TraceListTraceRecordingsHandler: trace.ListTraceRecordingsHandlerFunc(func(params trace.ListTraceRecordingsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation trace.ListTraceRecordings has not yet been implemented")
+		}),
 		ServiceAccountListUserServiceAccountsHandler: service_account.ListUserServiceAccountsHandlerFunc(func(params service_account.ListUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.ListUserServiceAccounts has not yet been implemented")
 		}),
+		ServiceAccountListUserServiceAccountsUsageHandler: service_account.ListUserServiceAccountsUsageHandlerFunc(func(params service_account.ListUserServiceAccountsUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.ListUserServiceAccountsUsage has not yet been implemented")
+		}),
 		UserListUsersHandler: user.ListUsersHandlerFunc(func(params user.ListUsersParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.ListUsers has not yet been implemented")
 		}),
@@ -318,6 +453,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		LoggingLogSearchHandler: logging.LogSearchHandlerFunc(func(params logging.LogSearchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation logging.LogSearch has not yet been implemented")
 		}),
+		LoggingLogSearchExportHandler: logging.LogSearchExportHandlerFunc(func(params logging.LogSearchExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation logging.LogSearchExport has not yet been implemented")
+		}),
 		AuthLoginHandler: auth.LoginHandlerFunc(func(params auth.LoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation auth.Login has not yet been implemented")
 		}),
@@ -336,6 +474,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		ConfigurationNotificationEndpointListHandler: configuration.NotificationEndpointListHandlerFunc(func(params configuration.NotificationEndpointListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.NotificationEndpointList has not yet been implemented")
 		}),
+		SystemOrderDashboardWidgetsHandler: system.OrderDashboardWidgetsHandlerFunc(func(params system.OrderDashboardWidgetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.OrderDashboardWidgets has not yet been implemented")
+		}),
 		PolicyPolicyInfoHandler: policy.PolicyInfoHandlerFunc(func(params policy.PolicyInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.PolicyInfo has not yet been implemented")
 		}),
@@ -375,18 +516,33 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserRemoveUserHandler: user.RemoveUserHandlerFunc(func(params user.RemoveUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user.RemoveUser has not yet been implemented")
 		}),
+		PolicyRenderPolicyTemplateHandler: policy.RenderPolicyTemplateHandlerFunc(func(params policy.RenderPolicyTemplateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.RenderPolicyTemplate has not yet been implemented")
+		}),
 		ConfigurationResetConfigHandler: configuration.ResetConfigHandlerFunc(func(params configuration.ResetConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation configuration.ResetConfig has not yet been implemented")
 		}),
 		ServiceRestartServiceHandler: service.RestartServiceHandlerFunc(func(params service.RestartServiceParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service.RestartService has not yet been implemented")
 		}),
+		PolicyRevokeAccessGrantHandler: policy.RevokeAccessGrantHandlerFunc(func(params policy.RevokeAccessGrantParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.RevokeAccessGrant has not yet been implemented")
+		}),
+		PolicyRollbackPolicyHandler: policy.RollbackPolicyHandlerFunc(func(params policy.RollbackPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.RollbackPolicy has not yet been implemented")
+		}),
+		ServiceAccountRotateServiceAccountHandler: service_account.RotateServiceAccountHandlerFunc(func(params service_account.RotateServiceAccountParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.RotateServiceAccount has not yet been implemented")
+		}),
 		AuthSessionCheckHandler: auth.SessionCheckHandlerFunc(func(params auth.SessionCheckParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation auth.SessionCheck has not yet been implemented")
 		}),
 		BucketSetAccessRuleWithBucketHandler: bucket.SetAccessRuleWithBucketHandlerFunc(func(params bucket.SetAccessRuleWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.SetAccessRuleWithBucket has not yet been implemented")
 		}),
+		SystemSetAlertChannelsHandler: system.SetAlertChannelsHandlerFunc(func(params system.SetAlertChannelsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.SetAlertChannels has not yet been implemented")
+		}),
 		BucketSetBucketQuotaHandler: bucket.SetBucketQuotaHandlerFunc(func(params bucket.SetBucketQuotaParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.SetBucketQuota has not yet been implemented")
 		}),
@@ -408,12 +564,21 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		PolicySetPolicyMultipleHandler: policy.SetPolicyMultipleHandlerFunc(func(params policy.SetPolicyMultipleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation policy.SetPolicyMultiple has not yet been implemented")
 		}),
+		ServiceAccountSetServiceAccountExpiryHandler: service_account.SetServiceAccountExpiryHandlerFunc(func(params service_account.SetServiceAccountExpiryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation service_account.SetServiceAccountExpiry has not yet been implemented")
+		}),
 		ServiceAccountSetServiceAccountPolicyHandler: service_account.SetServiceAccountPolicyHandlerFunc(func(params service_account.SetServiceAccountPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation service_account.SetServiceAccountPolicy has not yet been implemented")
 		}),
 		ObjectShareObjectHandler: object.ShareObjectHandlerFunc(func(params object.ShareObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation object.ShareObject has not yet been implemented")
 		}),
+		SystemSilenceAlertRuleHandler: system.SilenceAlertRuleHandlerFunc(func(params system.SilenceAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.SilenceAlertRule has not yet been implemented")
+		}),
+		PolicySimulatePolicyHandler: policy.SimulatePolicyHandlerFunc(func(params policy.SimulatePolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation policy.SimulatePolicy has not yet been implemented")
+		}),
 		SiteReplicationSiteReplicationEditHandler: site_replication.SiteReplicationEditHandlerFunc(func(params site_replication.SiteReplicationEditParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation site_replication.SiteReplicationEdit has not yet been implemented")
 		}),
@@ -438,12 +603,27 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		SubnetSubnetRegisterHandler: subnet.SubnetRegisterHandlerFunc(func(params subnet.SubnetRegisterParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation subnet.SubnetRegister has not yet been implemented")
 		}),
+		SystemTestAlertChannelHandler: system.TestAlertChannelHandlerFunc(func(params system.TestAlertChannelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.TestAlertChannel has not yet been implemented")
+		}),
 		TieringTiersListHandler: tiering.TiersListHandlerFunc(func(params tiering.TiersListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation tiering.TiersList has not yet been implemented")
 		}),
+		TraceTraceRecordingSummaryHandler: trace.TraceRecordingSummaryHandlerFunc(func(params trace.TraceRecordingSummaryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation trace.TraceRecordingSummary has not yet been implemented")
+		}),
+		SystemUnsilenceAlertRuleHandler: system.UnsilenceAlertRuleHandlerFunc(func(params system.UnsilenceAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.UnsilenceAlertRule has not yet been implemented")
+		}),
+		SystemUpdateAlertRuleHandler: system.UpdateAlertRuleHandlerFunc(func(params system.UpdateAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.UpdateAlertRule has not yet been implemented")
+		}),
 		BucketUpdateBucketLifecycleHandler: bucket.UpdateBucketLifecycleHandlerFunc(func(params bucket.UpdateBucketLifecycleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation bucket.UpdateBucketLifecycle has not yet been implemented")
 		}),
+		SystemUpdateDashboardWidgetHandler: system.UpdateDashboardWidgetHandlerFunc(func(params system.UpdateDashboardWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation system.UpdateDashboardWidget has not yet been implemented")
+		}),
 		GroupUpdateGroupHandler: group.UpdateGroupHandlerFunc(func(params group.UpdateGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation group.UpdateGroup has not yet been implemented")
 		}),
@@ -516,6 +696,8 @@ type ConsoleAPI struct {
 
 	// AccountAccountChangePasswordHandler sets the operation handler for the account change password operation
 	AccountAccountChangePasswordHandler account.AccountChangePasswordHandler
+	// SystemAcknowledgeAlertHandler sets the operation handler for the acknowledge alert operation
+	SystemAcknowledgeAlertHandler system.AcknowledgeAlertHandler
 	// BucketAddBucketLifecycleHandler sets the operation handler for the add bucket lifecycle operation
 	BucketAddBucketLifecycleHandler bucket.AddBucketLifecycleHandler
 	// GroupAddGroupHandler sets the operation handler for the add group operation
@@ -542,18 +724,32 @@ type ConsoleAPI struct {
 	BucketBucketSetPolicyHandler bucket.BucketSetPolicyHandler
 	// UserBulkUpdateUsersGroupsHandler sets the operation handler for the bulk update users groups operation
 	UserBulkUpdateUsersGroupsHandler user.BulkUpdateUsersGroupsHandler
+	// SystemCapacityForecastHandler sets the operation handler for the capacity forecast operation
+	SystemCapacityForecastHandler system.CapacityForecastHandler
 	// AccountChangeUserPasswordHandler sets the operation handler for the change user password operation
 	AccountChangeUserPasswordHandler account.ChangeUserPasswordHandler
 	// SystemCheckMinIOVersionHandler sets the operation handler for the check min i o version operation
 	SystemCheckMinIOVersionHandler system.CheckMinIOVersionHandler
+	// SystemCheckPrometheusHandler sets the operation handler for the check prometheus operation
+	SystemCheckPrometheusHandler system.CheckPrometheusHandler
 	// UserCheckUserServiceAccountsHandler sets the operation handler for the check user service accounts operation
 	UserCheckUserServiceAccountsHandler user.CheckUserServiceAccountsHandler
+	// SpeedtestCompareSpeedtestResultsHandler sets the operation handler for the compare speedtest results operation
+	SpeedtestCompareSpeedtestResultsHandler speedtest.CompareSpeedtestResultsHandler
 	// ConfigurationConfigInfoHandler sets the operation handler for the config info operation
 	ConfigurationConfigInfoHandler configuration.ConfigInfoHandler
 	// UserCreateAUserServiceAccountHandler sets the operation handler for the create a user service account operation
 	UserCreateAUserServiceAccountHandler user.CreateAUserServiceAccountHandler
+	// PolicyCreateAccessGrantHandler sets the operation handler for the create access grant operation
+	PolicyCreateAccessGrantHandler policy.CreateAccessGrantHandler
+	// SystemCreateAlertRuleHandler sets the operation handler for the create alert rule operation
+	SystemCreateAlertRuleHandler system.CreateAlertRuleHandler
 	// BucketCreateBucketEventHandler sets the operation handler for the create bucket event operation
 	BucketCreateBucketEventHandler bucket.CreateBucketEventHandler
+	// SystemCreateDashboardWidgetHandler sets the operation handler for the create dashboard widget operation
+	SystemCreateDashboardWidgetHandler system.CreateDashboardWidgetHandler
+	// PolicyCreatePolicyTemplateHandler sets the operation handler for the create policy template operation
+	PolicyCreatePolicyTemplateHandler policy.CreatePolicyTemplateHandler
 	// ServiceAccountCreateServiceAccountHandler sets the operation handler for the create service account operation
 	ServiceAccountCreateServiceAccountHandler service_account.CreateServiceAccountHandler
 	// UserCreateServiceAccountCredentialsHandler sets the operation handler for the create service account credentials operation
@@ -564,6 +760,8 @@ type ConsoleAPI struct {
 	SystemDashboardWidgetDetailsHandler system.DashboardWidgetDetailsHandler
 	// BucketDeleteAccessRuleWithBucketHandler sets the operation handler for the delete access rule with bucket operation
 	BucketDeleteAccessRuleWithBucketHandler bucket.DeleteAccessRuleWithBucketHandler
+	// SystemDeleteAlertRuleHandler sets the operation handler for the delete alert rule operation
+	SystemDeleteAlertRuleHandler system.DeleteAlertRuleHandler
 	// BucketDeleteAllReplicationRulesHandler sets the operation handler for the delete all replication rules operation
 	BucketDeleteAllReplicationRulesHandler bucket.DeleteAllReplicationRulesHandler
 	// BucketDeleteBucketHandler sets the operation handler for the delete bucket operation
@@ -574,6 +772,8 @@ type ConsoleAPI struct {
 	BucketDeleteBucketLifecycleRuleHandler bucket.DeleteBucketLifecycleRuleHandler
 	// BucketDeleteBucketReplicationRuleHandler sets the operation handler for the delete bucket replication rule operation
 	BucketDeleteBucketReplicationRuleHandler bucket.DeleteBucketReplicationRuleHandler
+	// SystemDeleteDashboardWidgetHandler sets the operation handler for the delete dashboard widget operation
+	SystemDeleteDashboardWidgetHandler system.DeleteDashboardWidgetHandler
 	// ObjectDeleteMultipleObjectsHandler sets the operation handler for the delete multiple objects operation
 	ObjectDeleteMultipleObjectsHandler object.DeleteMultipleObjectsHandler
 	// ServiceAccountDeleteMultipleServiceAccountsHandler sets the operation handler for the delete multiple service accounts operation
@@ -582,20 +782,48 @@ type ConsoleAPI struct {
 	ObjectDeleteObjectHandler object.DeleteObjectHandler
 	// ObjectDeleteObjectRetentionHandler sets the operation handler for the delete object retention operation
 	ObjectDeleteObjectRetentionHandler object.DeleteObjectRetentionHandler
+	// PolicyDeletePolicyTemplateHandler sets the operation handler for the delete policy template operation
+	PolicyDeletePolicyTemplateHandler policy.DeletePolicyTemplateHandler
 	// BucketDeleteRemoteBucketHandler sets the operation handler for the delete remote bucket operation
 	BucketDeleteRemoteBucketHandler bucket.DeleteRemoteBucketHandler
 	// BucketDeleteSelectedReplicationRulesHandler sets the operation handler for the delete selected replication rules operation
 	BucketDeleteSelectedReplicationRulesHandler bucket.DeleteSelectedReplicationRulesHandler
 	// ServiceAccountDeleteServiceAccountHandler sets the operation handler for the delete service account operation
 	ServiceAccountDeleteServiceAccountHandler service_account.DeleteServiceAccountHandler
+	// SpeedtestDeleteSpeedtestResultHandler sets the operation handler for the delete speedtest result operation
+	SpeedtestDeleteSpeedtestResultHandler speedtest.DeleteSpeedtestResultHandler
+	// TraceDeleteTraceRecordingHandler sets the operation handler for the delete trace recording operation
+	TraceDeleteTraceRecordingHandler trace.DeleteTraceRecordingHandler
+	// SystemDiffHealthReportsHandler sets the operation handler for the diff health reports operation
+	SystemDiffHealthReportsHandler system.DiffHealthReportsHandler
+	// PolicyDiffPolicyRevisionsHandler sets the operation handler for the diff policy revisions operation
+	PolicyDiffPolicyRevisionsHandler policy.DiffPolicyRevisionsHandler
 	// BucketDisableBucketEncryptionHandler sets the operation handler for the disable bucket encryption operation
 	BucketDisableBucketEncryptionHandler bucket.DisableBucketEncryptionHandler
+	// LoggingDownloadConsoleLogsHandler sets the operation handler for the download console logs operation
+	LoggingDownloadConsoleLogsHandler logging.DownloadConsoleLogsHandler
+	// SystemDownloadHealthReportHandler sets the operation handler for the download health report operation
+	SystemDownloadHealthReportHandler system.DownloadHealthReportHandler
+	// UserDownloadImportedUsersSecretsHandler sets the operation handler for the download imported users secrets operation
+	UserDownloadImportedUsersSecretsHandler user.DownloadImportedUsersSecretsHandler
 	// ObjectDownloadObjectHandler sets the operation handler for the download object operation
 	ObjectDownloadObjectHandler object.DownloadObjectHandler
+	// TraceDownloadTraceRecordingHandler sets the operation handler for the download trace recording operation
+	TraceDownloadTraceRecordingHandler trace.DownloadTraceRecordingHandler
 	// TieringEditTierCredentialsHandler sets the operation handler for the edit tier credentials operation
 	TieringEditTierCredentialsHandler tiering.EditTierCredentialsHandler
+	// PolicyEffectivePermissionsHandler sets the operation handler for the effective permissions operation
+	PolicyEffectivePermissionsHandler policy.EffectivePermissionsHandler
 	// BucketEnableBucketEncryptionHandler sets the operation handler for the enable bucket encryption operation
 	BucketEnableBucketEncryptionHandler bucket.EnableBucketEncryptionHandler
+	// SystemErasureTopologyHandler sets the operation handler for the erasure topology operation
+	SystemErasureTopologyHandler system.ErasureTopologyHandler
+	// SystemExportDashboardHandler sets the operation handler for the export dashboard operation
+	SystemExportDashboardHandler system.ExportDashboardHandler
+	// PolicyExportEffectivePermissionsHandler sets the operation handler for the export effective permissions operation
+	PolicyExportEffectivePermissionsHandler policy.ExportEffectivePermissionsHandler
+	// PolicyExportIAMHandler sets the operation handler for the export i a m operation
+	PolicyExportIAMHandler policy.ExportIAMHandler
 	// BucketGetBucketEncryptionInfoHandler sets the operation handler for the get bucket encryption info operation
 	BucketGetBucketEncryptionInfoHandler bucket.GetBucketEncryptionInfoHandler
 	// BucketGetBucketLifecycleHandler sets the operation handler for the get bucket lifecycle operation
@@ -624,6 +852,8 @@ type ConsoleAPI struct {
 	SiteReplicationGetSiteReplicationInfoHandler site_replication.GetSiteReplicationInfoHandler
 	// SiteReplicationGetSiteReplicationStatusHandler sets the operation handler for the get site replication status operation
 	SiteReplicationGetSiteReplicationStatusHandler site_replication.GetSiteReplicationStatusHandler
+	// SpeedtestGetSpeedtestResultHandler sets the operation handler for the get speedtest result operation
+	SpeedtestGetSpeedtestResultHandler speedtest.GetSpeedtestResultHandler
 	// TieringGetTierHandler sets the operation handler for the get tier operation
 	TieringGetTierHandler tiering.GetTierHandler
 	// UserGetUserInfoHandler sets the operation handler for the get user info operation
@@ -632,24 +862,50 @@ type ConsoleAPI struct {
 	PolicyGetUserPolicyHandler policy.GetUserPolicyHandler
 	// GroupGroupInfoHandler sets the operation handler for the group info operation
 	GroupGroupInfoHandler group.GroupInfoHandler
+	// SystemImportDashboardHandler sets the operation handler for the import dashboard operation
+	SystemImportDashboardHandler system.ImportDashboardHandler
+	// PolicyImportIAMHandler sets the operation handler for the import i a m operation
+	PolicyImportIAMHandler policy.ImportIAMHandler
+	// UserImportUsersHandler sets the operation handler for the import users operation
+	UserImportUsersHandler user.ImportUsersHandler
 	// InspectInspectHandler sets the operation handler for the inspect operation
 	InspectInspectHandler inspect.InspectHandler
+	// PolicyLintPolicyHandler sets the operation handler for the lint policy operation
+	PolicyLintPolicyHandler policy.LintPolicyHandler
 	// UserListAUserServiceAccountsHandler sets the operation handler for the list a user service accounts operation
 	UserListAUserServiceAccountsHandler user.ListAUserServiceAccountsHandler
+	// UserListAUserServiceAccountsUsageHandler sets the operation handler for the list a user service accounts usage operation
+	UserListAUserServiceAccountsUsageHandler user.ListAUserServiceAccountsUsageHandler
+	// PolicyListAccessGrantsHandler sets the operation handler for the list access grants operation
+	PolicyListAccessGrantsHandler policy.ListAccessGrantsHandler
 	// BucketListAccessRulesWithBucketHandler sets the operation handler for the list access rules with bucket operation
 	BucketListAccessRulesWithBucketHandler bucket.ListAccessRulesWithBucketHandler
+	// SystemListAlertChannelsHandler sets the operation handler for the list alert channels operation
+	SystemListAlertChannelsHandler system.ListAlertChannelsHandler
+	// SystemListAlertRulesHandler sets the operation handler for the list alert rules operation
+	SystemListAlertRulesHandler system.ListAlertRulesHandler
+	// SystemListAlertsHandler sets the operation handler for the list alerts operation
+	SystemListAlertsHandler system.ListAlertsHandler
 	// BucketListBucketEventsHandler sets the operation handler for the list bucket events operation
 	BucketListBucketEventsHandler bucket.ListBucketEventsHandler
 	// BucketListBucketsHandler sets the operation handler for the list buckets operation
 	BucketListBucketsHandler bucket.ListBucketsHandler
 	// ConfigurationListConfigHandler sets the operation handler for the list config operation
 	ConfigurationListConfigHandler configuration.ListConfigHandler
+	// SystemListDashboardWidgetsHandler sets the operation handler for the list dashboard widgets operation
+	SystemListDashboardWidgetsHandler system.ListDashboardWidgetsHandler
+	// ServiceAccountListExpiringServiceAccountsHandler sets the operation handler for the list expiring service accounts operation
+	ServiceAccountListExpiringServiceAccountsHandler service_account.ListExpiringServiceAccountsHandler
 	// BucketListExternalBucketsHandler sets the operation handler for the list external buckets operation
 	BucketListExternalBucketsHandler bucket.ListExternalBucketsHandler
 	// GroupListGroupsHandler sets the operation handler for the list groups operation
 	GroupListGroupsHandler group.ListGroupsHandler
 	// PolicyListGroupsForPolicyHandler sets the operation handler for the list groups for policy operation
 	PolicyListGroupsForPolicyHandler policy.ListGroupsForPolicyHandler
+	// HealListHealJobsHandler sets the operation handler for the list heal jobs operation
+	HealListHealJobsHandler heal.ListHealJobsHandler
+	// SystemListHealthReportsHandler sets the operation handler for the list health reports operation
+	SystemListHealthReportsHandler system.ListHealthReportsHandler
 	// SystemListNodesHandler sets the operation handler for the list nodes operation
 	SystemListNodesHandler system.ListNodesHandler
 	// ObjectListObjectsHandler sets the operation handler for the list objects operation
@@ -658,10 +914,22 @@ type ConsoleAPI struct {
 	PolicyListPoliciesHandler policy.ListPoliciesHandler
 	// BucketListPoliciesWithBucketHandler sets the operation handler for the list policies with bucket operation
 	BucketListPoliciesWithBucketHandler bucket.ListPoliciesWithBucketHandler
+	// PolicyListPolicyRevisionsHandler sets the operation handler for the list policy revisions operation
+	PolicyListPolicyRevisionsHandler policy.ListPolicyRevisionsHandler
+	// PolicyListPolicyTemplatesHandler sets the operation handler for the list policy templates operation
+	PolicyListPolicyTemplatesHandler policy.ListPolicyTemplatesHandler
 	// BucketListRemoteBucketsHandler sets the operation handler for the list remote buckets operation
 	BucketListRemoteBucketsHandler bucket.ListRemoteBucketsHandler
+	// SpeedtestListSpeedtestResultsHandler sets the operation handler for the list speedtest results operation
+	SpeedtestListSpeedtestResultsHandler speedtest.ListSpeedtestResultsHandler
+	// ServiceAccountListStaleServiceAccountsHandler sets the operation handler for the list stale service accounts operation
+	ServiceAccountListStaleServiceAccountsHandler service_account.ListStaleServiceAccountsHandler
+	// TraceListTraceRecordingsHandler sets the operation handler for the list trace recordings operation
+	TraceListTraceRecordingsHandler trace.ListTraceRecordingsHandler
 	// ServiceAccountListUserServiceAccountsHandler sets the operation handler for the list user service accounts operation
 	ServiceAccountListUserServiceAccountsHandler service_account.ListUserServiceAccountsHandler
+	// ServiceAccountListUserServiceAccountsUsageHandler sets the operation handler for the list user service accounts usage operation
+	ServiceAccountListUserServiceAccountsUsageHandler service_account.ListUserServiceAccountsUsageHandler
 	// UserListUsersHandler sets the operation handler for the list users operation
 	UserListUsersHandler user.ListUsersHandler
 	// PolicyListUsersForPolicyHandler sets the operation handler for the list users for policy operation
@@ -670,6 +938,8 @@ type ConsoleAPI struct {
 	BucketListUsersWithAccessToBucketHandler bucket.ListUsersWithAccessToBucketHandler
 	// LoggingLogSearchHandler sets the operation handler for the log search operation
 	LoggingLogSearchHandler logging.LogSearchHandler
+	// LoggingLogSearchExportHandler sets the operation handler for the log search export operation
+	LoggingLogSearchExportHandler logging.LogSearchExportHandler
 	// AuthLoginHandler sets the operation handler for the login operation
 	AuthLoginHandler auth.LoginHandler
 	// AuthLoginDetailHandler sets the operation handler for the login detail operation
@@ -682,6 +952,8 @@ type ConsoleAPI struct {
 	BucketMakeBucketHandler bucket.MakeBucketHandler
 	// ConfigurationNotificationEndpointListHandler sets the operation handler for the notification endpoint list operation
 	ConfigurationNotificationEndpointListHandler configuration.NotificationEndpointListHandler
+	// SystemOrderDashboardWidgetsHandler sets the operation handler for the order dashboard widgets operation
+	SystemOrderDashboardWidgetsHandler system.OrderDashboardWidgetsHandler
 	// PolicyPolicyInfoHandler sets the operation handler for the policy info operation
 	PolicyPolicyInfoHandler policy.PolicyInfoHandler
 	// ObjectPostBucketsBucketNameObjectsUploadHandler sets the operation handler for the post buckets bucket name objects upload operation
@@ -708,14 +980,24 @@ type ConsoleAPI struct {
 	PolicyRemovePolicyHandler policy.RemovePolicyHandler
 	// UserRemoveUserHandler sets the operation handler for the remove user operation
 	UserRemoveUserHandler user.RemoveUserHandler
+	// PolicyRenderPolicyTemplateHandler sets the operation handler for the render policy template operation
+	PolicyRenderPolicyTemplateHandler policy.RenderPolicyTemplateHandler
 	// ConfigurationResetConfigHandler sets the operation handler for the reset config operation
 	ConfigurationResetConfigHandler configuration.ResetConfigHandler
 	// ServiceRestartServiceHandler sets the operation handler for the restart service operation
 	ServiceRestartServiceHandler service.RestartServiceHandler
+	// PolicyRevokeAccessGrantHandler sets the operation handler for the revoke access grant operation
+	PolicyRevokeAccessGrantHandler policy.RevokeAccessGrantHandler
+	// PolicyRollbackPolicyHandler sets the operation handler for the rollback policy operation
+	PolicyRollbackPolicyHandler policy.RollbackPolicyHandler
+	// ServiceAccountRotateServiceAccountHandler sets the operation handler for the rotate service account operation
+	ServiceAccountRotateServiceAccountHandler service_account.RotateServiceAccountHandler
 	// AuthSessionCheckHandler sets the operation handler for the session check operation
 	AuthSessionCheckHandler auth.SessionCheckHandler
 	// BucketSetAccessRuleWithBucketHandler sets the operation handler for the set access rule with bucket operation
 	BucketSetAccessRuleWithBucketHandler bucket.SetAccessRuleWithBucketHandler
+	// SystemSetAlertChannelsHandler sets the operation handler for the set alert channels operation
+	SystemSetAlertChannelsHandler system.SetAlertChannelsHandler
 	// BucketSetBucketQuotaHandler sets the operation handler for the set bucket quota operation
 	BucketSetBucketQuotaHandler bucket.SetBucketQuotaHandler
 	// BucketSetBucketRetentionConfigHandler sets the operation handler for the set bucket retention config operation
@@ -730,10 +1012,16 @@ type ConsoleAPI struct {
 	PolicySetPolicyHandler policy.SetPolicyHandler
 	// PolicySetPolicyMultipleHandler sets the operation handler for the set policy multiple operation
 	PolicySetPolicyMultipleHandler policy.SetPolicyMultipleHandler
+	// ServiceAccountSetServiceAccountExpiryHandler sets the operation handler for the set service account expiry operation
+	ServiceAccountSetServiceAccountExpiryHandler service_account.SetServiceAccountExpiryHandler
 	// ServiceAccountSetServiceAccountPolicyHandler sets the operation handler for the set service account policy operation
 	ServiceAccountSetServiceAccountPolicyHandler service_account.SetServiceAccountPolicyHandler
 	// ObjectShareObjectHandler sets the operation handler for the share object operation
 	ObjectShareObjectHandler object.ShareObjectHandler
+	// SystemSilenceAlertRuleHandler sets the operation handler for the silence alert rule operation
+	SystemSilenceAlertRuleHandler system.SilenceAlertRuleHandler
+	// PolicySimulatePolicyHandler sets the operation handler for the simulate policy operation
+	PolicySimulatePolicyHandler policy.SimulatePolicyHandler
 	// SiteReplicationSiteReplicationEditHandler sets the operation handler for the site replication edit operation
 	SiteReplicationSiteReplicationEditHandler site_replication.SiteReplicationEditHandler
 	// SiteReplicationSiteReplicationInfoAddHandler sets the operation handler for the site replication info add operation
@@ -750,10 +1038,20 @@ type ConsoleAPI struct {
 	SubnetSubnetRegTokenHandler subnet.SubnetRegTokenHandler
 	// SubnetSubnetRegisterHandler sets the operation handler for the subnet register operation
 	SubnetSubnetRegisterHandler subnet.SubnetRegisterHandler
+	// SystemTestAlertChannelHandler sets the operation handler for the test alert channel operation
+	SystemTestAlertChannelHandler system.TestAlertChannelHandler
 	// TieringTiersListHandler sets the operation handler for the tiers list operation
 	TieringTiersListHandler tiering.TiersListHandler
+	// TraceTraceRecordingSummaryHandler sets the operation handler for the trace recording summary operation
+	TraceTraceRecordingSummaryHandler trace.TraceRecordingSummaryHandler
+	// SystemUnsilenceAlertRuleHandler sets the operation handler for the unsilence alert rule operation
+	SystemUnsilenceAlertRuleHandler system.UnsilenceAlertRuleHandler
+	// SystemUpdateAlertRuleHandler sets the operation handler for the update alert rule operation
+	SystemUpdateAlertRuleHandler system.UpdateAlertRuleHandler
 	// BucketUpdateBucketLifecycleHandler sets the operation handler for the update bucket lifecycle operation
 	BucketUpdateBucketLifecycleHandler bucket.UpdateBucketLifecycleHandler
+	// SystemUpdateDashboardWidgetHandler sets the operation handler for the update dashboard widget operation
+	SystemUpdateDashboardWidgetHandler system.UpdateDashboardWidgetHandler
 	// GroupUpdateGroupHandler sets the operation handler for the update group operation
 	GroupUpdateGroupHandler group.UpdateGroupHandler
 	// BucketUpdateMultiBucketReplicationHandler sets the operation handler for the update multi bucket replication operation
@@ -855,6 +1153,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AccountAccountChangePasswordHandler == nil {
 		unregistered = append(unregistered, "account.AccountChangePasswordHandler")
 	}
+	if o.SystemAcknowledgeAlertHandler == nil {
+		unregistered = append(unregistered, "system.AcknowledgeAlertHandler")
+	}
 	if o.BucketAddBucketLifecycleHandler == nil {
 		unregistered = append(unregistered, "bucket.AddBucketLifecycleHandler")
 	}
@@ -894,24 +1195,45 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserBulkUpdateUsersGroupsHandler == nil {
 		unregistered = append(unregistered, "user.BulkUpdateUsersGroupsHandler")
 	}
+	if o.SystemCapacityForecastHandler == nil {
+		unregistered = append(unregistered, "system.CapacityForecastHandler")
+	}
 	if o.AccountChangeUserPasswordHandler == nil {
 		unregistered = append(unregistered, "account.ChangeUserPasswordHandler")
 	}
 	if o.SystemCheckMinIOVersionHandler == nil {
 		unregistered = append(unregistered, "system.CheckMinIOVersionHandler")
 	}
+	if o.SystemCheckPrometheusHandler == nil {
+		unregistered = append(unregistered, "system.CheckPrometheusHandler")
+	}
 	if o.UserCheckUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.CheckUserServiceAccountsHandler")
 	}
+	if o.SpeedtestCompareSpeedtestResultsHandler == nil {
+		unregistered = append(unregistered, "speedtest.CompareSpeedtestResultsHandler")
+	}
 	if o.ConfigurationConfigInfoHandler == nil {
 		unregistered = append(unregistered, "configuration.ConfigInfoHandler")
 	}
 	if o.UserCreateAUserServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user.CreateAUserServiceAccountHandler")
 	}
+	if o.PolicyCreateAccessGrantHandler == nil {
+		unregistered = append(unregistered, "policy.CreateAccessGrantHandler")
+	}
+	if o.SystemCreateAlertRuleHandler == nil {
+		unregistered = append(unregistered, "system.CreateAlertRuleHandler")
+	}
 	if o.BucketCreateBucketEventHandler == nil {
 		unregistered = append(unregistered, "bucket.CreateBucketEventHandler")
 	}
+	if o.SystemCreateDashboardWidgetHandler == nil {
+		unregistered = append(unregistered, "system.CreateDashboardWidgetHandler")
+	}
+	if o.PolicyCreatePolicyTemplateHandler == nil {
+		unregistered = append(unregistered, "policy.CreatePolicyTemplateHandler")
+	}
 	if o.ServiceAccountCreateServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.CreateServiceAccountHandler")
 	}
@@ -927,6 +1249,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketDeleteAccessRuleWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAccessRuleWithBucketHandler")
 	}
+	if o.SystemDeleteAlertRuleHandler == nil {
+		unregistered = append(unregistered, "system.DeleteAlertRuleHandler")
+	}
 	if o.BucketDeleteAllReplicationRulesHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteAllReplicationRulesHandler")
 	}
@@ -942,6 +1267,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketDeleteBucketReplicationRuleHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteBucketReplicationRuleHandler")
 	}
+	if o.SystemDeleteDashboardWidgetHandler == nil {
+		unregistered = append(unregistered, "system.DeleteDashboardWidgetHandler")
+	}
 	if o.ObjectDeleteMultipleObjectsHandler == nil {
 		unregistered = append(unregistered, "object.DeleteMultipleObjectsHandler")
 	}
@@ -954,6 +1282,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ObjectDeleteObjectRetentionHandler == nil {
 		unregistered = append(unregistered, "object.DeleteObjectRetentionHandler")
 	}
+	if o.PolicyDeletePolicyTemplateHandler == nil {
+		unregistered = append(unregistered, "policy.DeletePolicyTemplateHandler")
+	}
 	if o.BucketDeleteRemoteBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.DeleteRemoteBucketHandler")
 	}
@@ -963,18 +1294,57 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ServiceAccountDeleteServiceAccountHandler == nil {
 		unregistered = append(unregistered, "service_account.DeleteServiceAccountHandler")
 	}
+	if o.SpeedtestDeleteSpeedtestResultHandler == nil {
+		unregistered = append(unregistered, "speedtest.DeleteSpeedtestResultHandler")
+	}
+	if o.TraceDeleteTraceRecordingHandler == nil {
+		unregistered = append(unregistered, "trace.DeleteTraceRecordingHandler")
+	}
+	if o.SystemDiffHealthReportsHandler == nil {
+		unregistered = append(unregistered, "system.DiffHealthReportsHandler")
+	}
+	if o.PolicyDiffPolicyRevisionsHandler == nil {
+		unregistered = append(unregistered, "policy.DiffPolicyRevisionsHandler")
+	}
 	if o.BucketDisableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "bucket.DisableBucketEncryptionHandler")
 	}
+	if o.LoggingDownloadConsoleLogsHandler == nil {
+		unregistered = append(unregistered, "logging.DownloadConsoleLogsHandler")
+	}
+	if o.SystemDownloadHealthReportHandler == nil {
+		unregistered = append(unregistered, "system.DownloadHealthReportHandler")
+	}
+	if o.UserDownloadImportedUsersSecretsHandler == nil {
+		unregistered = append(unregistered, "user.DownloadImportedUsersSecretsHandler")
+	}
 	if o.ObjectDownloadObjectHandler == nil {
 		unregistered = append(unregistered, "object.DownloadObjectHandler")
 	}
+	if o.TraceDownloadTraceRecordingHandler == nil {
+		unregistered = append(unregistered, "trace.DownloadTraceRecordingHandler")
+	}
 	if o.TieringEditTierCredentialsHandler == nil {
 		unregistered = append(unregistered, "tiering.EditTierCredentialsHandler")
 	}
+	if o.PolicyEffectivePermissionsHandler == nil {
+		unregistered = append(unregistered, "policy.EffectivePermissionsHandler")
+	}
 	if o.BucketEnableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "bucket.EnableBucketEncryptionHandler")
 	}
+	if o.SystemErasureTopologyHandler == nil {
+		unregistered = append(unregistered, "system.ErasureTopologyHandler")
+	}
+	if o.SystemExportDashboardHandler == nil {
+		unregistered = append(unregistered, "system.ExportDashboardHandler")
+	}
+	if o.PolicyExportEffectivePermissionsHandler == nil {
+		unregistered = append(unregistered, "policy.ExportEffectivePermissionsHandler")
+	}
+	if o.PolicyExportIAMHandler == nil {
+		unregistered = append(unregistered, "policy.ExportIAMHandler")
+	}
 	if o.BucketGetBucketEncryptionInfoHandler == nil {
 		unregistered = append(unregistered, "bucket.GetBucketEncryptionInfoHandler")
 	}
@@ -1017,6 +1387,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SiteReplicationGetSiteReplicationStatusHandler == nil {
 		unregistered = append(unregistered, "site_replication.GetSiteReplicationStatusHandler")
 	}
+	if o.SpeedtestGetSpeedtestResultHandler == nil {
+		unregistered = append(unregistered, "speedtest.GetSpeedtestResultHandler")
+	}
 	if o.TieringGetTierHandler == nil {
 		unregistered = append(unregistered, "tiering.GetTierHandler")
 	}
@@ -1029,15 +1402,42 @@ func (o *ConsoleAPI) Validate() error {
 	if o.GroupGroupInfoHandler == nil {
 		unregistered = append(unregistered, "group.GroupInfoHandler")
 	}
+	if o.SystemImportDashboardHandler == nil {
+		unregistered = append(unregistered, "system.ImportDashboardHandler")
+	}
+	if o.PolicyImportIAMHandler == nil {
+		unregistered = append(unregistered, "policy.ImportIAMHandler")
+	}
+	if o.UserImportUsersHandler == nil {
+		unregistered = append(unregistered, "user.ImportUsersHandler")
+	}
 	if o.InspectInspectHandler == nil {
 		unregistered = append(unregistered, "inspect.InspectHandler")
 	}
+	if o.PolicyLintPolicyHandler == nil {
+		unregistered = append(unregistered, "policy.LintPolicyHandler")
+	}
 	if o.UserListAUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "user.ListAUserServiceAccountsHandler")
 	}
+	if o.UserListAUserServiceAccountsUsageHandler == nil {
+		unregistered = append(unregistered, "user.ListAUserServiceAccountsUsageHandler")
+	}
+	if o.PolicyListAccessGrantsHandler == nil {
+		unregistered = append(unregistered, "policy.ListAccessGrantsHandler")
+	}
 	if o.BucketListAccessRulesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListAccessRulesWithBucketHandler")
 	}
+	if o.SystemListAlertChannelsHandler == nil {
+		unregistered = append(unregistered, "system.ListAlertChannelsHandler")
+	}
+	if o.SystemListAlertRulesHandler == nil {
+		unregistered = append(unregistered, "system.ListAlertRulesHandler")
+	}
+	if o.SystemListAlertsHandler == nil {
+		unregistered = append(unregistered, "system.ListAlertsHandler")
+	}
 	if o.BucketListBucketEventsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListBucketEventsHandler")
 	}
@@ -1047,6 +1447,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ConfigurationListConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ListConfigHandler")
 	}
+	if o.SystemListDashboardWidgetsHandler == nil {
+		unregistered = append(unregistered, "system.ListDashboardWidgetsHandler")
+	}
+	if o.ServiceAccountListExpiringServiceAccountsHandler == nil {
+		unregistered = append(unregistered, "service_account.ListExpiringServiceAccountsHandler")
+	}
 	if o.BucketListExternalBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListExternalBucketsHandler")
 	}
@@ -1056,6 +1462,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.PolicyListGroupsForPolicyHandler == nil {
 		unregistered = append(unregistered, "policy.ListGroupsForPolicyHandler")
 	}
+	if o.HealListHealJobsHandler == nil {
+		unregistered = append(unregistered, "heal.ListHealJobsHandler")
+	}
+	if o.SystemListHealthReportsHandler == nil {
+		unregistered = append(unregistered, "system.ListHealthReportsHandler")
+	}
 	if o.SystemListNodesHandler == nil {
 		unregistered = append(unregistered, "system.ListNodesHandler")
 	}
@@ -1068,12 +1480,30 @@ func (o *ConsoleAPI) Validate() error {
 	if o.BucketListPoliciesWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.ListPoliciesWithBucketHandler")
 	}
+	if o.PolicyListPolicyRevisionsHandler == nil {
+		unregistered = append(unregistered, "policy.ListPolicyRevisionsHandler")
+	}
+	if o.PolicyListPolicyTemplatesHandler == nil {
+		unregistered = append(unregistered, "policy.ListPolicyTemplatesHandler")
+	}
 	if o.BucketListRemoteBucketsHandler == nil {
 		unregistered = append(unregistered, "bucket.ListRemoteBucketsHandler")
 	}
+	if o.SpeedtestListSpeedtestResultsHandler == nil {
+		unregistered = append(unregistered, "speedtest.ListSpeedtestResultsHandler")
+	}
+	if o.ServiceAccountListStaleServiceAccountsHandler == nil {
+		unregistered = append(unregistered, "service_account.ListStaleServiceAccountsHandler")
+	}
+	if o.TraceListTraceRecordingsHandler == nil {
+		unregistered = append(unregistered, "trace.ListTraceRecordingsHandler")
+	}
 	if o.ServiceAccountListUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "service_account.ListUserServiceAccountsHandler")
 	}
+	if o.ServiceAccountListUserServiceAccountsUsageHandler == nil {
+		unregistered = append(unregistered, "service_account.ListUserServiceAccountsUsageHandler")
+	}
 	if o.UserListUsersHandler == nil {
 		unregistered = append(unregistered, "user.ListUsersHandler")
 	}
@@ -1086,6 +1516,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.LoggingLogSearchHandler == nil {
 		unregistered = append(unregistered, "logging.LogSearchHandler")
 	}
+	if o.LoggingLogSearchExportHandler == nil {
+		unregistered = append(unregistered, "logging.LogSearchExportHandler")
+	}
 	if o.AuthLoginHandler == nil {
 		unregistered = append(unregistered, "auth.LoginHandler")
 	}
@@ -1104,6 +1537,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.ConfigurationNotificationEndpointListHandler == nil {
 		unregistered = append(unregistered, "configuration.NotificationEndpointListHandler")
 	}
+	if o.SystemOrderDashboardWidgetsHandler == nil {
+		unregistered = append(unregistered, "system.OrderDashboardWidgetsHandler")
+	}
 	if o.PolicyPolicyInfoHandler == nil {
 		unregistered = append(unregistered, "policy.PolicyInfoHandler")
 	}
@@ -1143,18 +1579,33 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserRemoveUserHandler == nil {
 		unregistered = append(unregistered, "user.RemoveUserHandler")
 	}
+	if o.PolicyRenderPolicyTemplateHandler == nil {
+		unregistered = append(unregistered, "policy.RenderPolicyTemplateHandler")
+	}
 	if o.ConfigurationResetConfigHandler == nil {
 		unregistered = append(unregistered, "configuration.ResetConfigHandler")
 	}
 	if o.ServiceRestartServiceHandler == nil {
 		unregistered = append(unregistered, "service.RestartServiceHandler")
 	}
+	if o.PolicyRevokeAccessGrantHandler == nil {
+		unregistered = append(unregistered, "policy.RevokeAccessGrantHandler")
+	}
+	if o.PolicyRollbackPolicyHandler == nil {
+		unregistered = append(unregistered, "policy.RollbackPolicyHandler")
+	}
+	if o.ServiceAccountRotateServiceAccountHandler == nil {
+		unregistered = append(unregistered, "service_account.RotateServiceAccountHandler")
+	}
 	if o.AuthSessionCheckHandler == nil {
 		unregistered = append(unregistered, "auth.SessionCheckHandler")
 	}
 	if o.BucketSetAccessRuleWithBucketHandler == nil {
 		unregistered = append(unregistered, "bucket.SetAccessRuleWithBucketHandler")
 	}
+	if o.SystemSetAlertChannelsHandler == nil {
+		unregistered = append(unregistered, "system.SetAlertChannelsHandler")
+	}
 	if o.BucketSetBucketQuotaHandler == nil {
 		unregistered = append(unregistered, "bucket.SetBucketQuotaHandler")
 	}
@@ -1176,12 +1627,21 @@ func (o *ConsoleAPI) Validate() error {
 	if o.PolicySetPolicyMultipleHandler == nil {
 		unregistered = append(unregistered, "policy.SetPolicyMultipleHandler")
 	}
+	if o.ServiceAccountSetServiceAccountExpiryHandler == nil {
+		unregistered = append(unregistered, "service_account.SetServiceAccountExpiryHandler")
+	}
 	if o.ServiceAccountSetServiceAccountPolicyHandler == nil {
 		unregistered = append(unregistered, "service_account.SetServiceAccountPolicyHandler")
 	}
 	if o.ObjectShareObjectHandler == nil {
 		unregistered = append(unregistered, "object.ShareObjectHandler")
 	}
+	if o.SystemSilenceAlertRuleHandler == nil {
+		unregistered = append(unregistered, "system.SilenceAlertRuleHandler")
+	}
+	if o.PolicySimulatePolicyHandler == nil {
+		unregistered = append(unregistered, "policy.SimulatePolicyHandler")
+	}
 	if o.SiteReplicationSiteReplicationEditHandler == nil {
 		unregistered = append(unregistered, "site_replication.SiteReplicationEditHandler")
 	}
@@ -1206,12 +1666,27 @@ func (o *ConsoleAPI) Validate() error {
 	if o.SubnetSubnetRegisterHandler == nil {
 		unregistered = append(unregistered, "subnet.SubnetRegisterHandler")
 	}
+	if o.SystemTestAlertChannelHandler == nil {
+		unregistered = append(unregistered, "system.TestAlertChannelHandler")
+	}
 	if o.TieringTiersListHandler == nil {
 		unregistered = append(unregistered, "tiering.TiersListHandler")
 	}
+	if o.TraceTraceRecordingSummaryHandler == nil {
+		unregistered = append(unregistered, "trace.TraceRecordingSummaryHandler")
+	}
+	if o.SystemUnsilenceAlertRuleHandler == nil {
+		unregistered = append(unregistered, "system.UnsilenceAlertRuleHandler")
+	}
+	if o.SystemUpdateAlertRuleHandler == nil {
+		unregistered = append(unregistered, "system.UpdateAlertRuleHandler")
+	}
 	if o.BucketUpdateBucketLifecycleHandler == nil {
 		unregistered = append(unregistered, "bucket.UpdateBucketLifecycleHandler")
 	}
+	if o.SystemUpdateDashboardWidgetHandler == nil {
+		unregistered = append(unregistered, "system.UpdateDashboardWidgetHandler")
+	}
 	if o.GroupUpdateGroupHandler == nil {
 		unregistered = append(unregistered, "group.UpdateGroupHandler")
 	}
@@ -1335,6 +1810,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/alerts/history/{alertId}/acknowledge"] = system.NewAcknowledgeAlert(o.context, o.SystemAcknowledgeAlertHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/lifecycle"] = bucket.NewAddBucketLifecycle(o.context, o.BucketAddBucketLifecycleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1384,6 +1863,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/users-groups-bulk"] = user.NewBulkUpdateUsersGroups(o.context, o.UserBulkUpdateUsersGroupsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/capacity/forecast"] = system.NewCapacityForecast(o.context, o.SystemCapacityForecastHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1392,6 +1875,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/check-version"] = system.NewCheckMinIOVersion(o.context, o.SystemCheckMinIOVersionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/info/prometheus"] = system.NewCheckPrometheus(o.context, o.SystemCheckPrometheusHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1399,6 +1886,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/speedtest/compare"] = speedtest.NewCompareSpeedtestResults(o.context, o.SpeedtestCompareSpeedtestResultsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/configs/{name}"] = configuration.NewConfigInfo(o.context, o.ConfigurationConfigInfoHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1407,10 +1898,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/grants"] = policy.NewCreateAccessGrant(o.context, o.PolicyCreateAccessGrantHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/rules"] = system.NewCreateAlertRule(o.context, o.SystemCreateAlertRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/events"] = bucket.NewCreateBucketEvent(o.context, o.BucketCreateBucketEventHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/dashboard/widgets"] = system.NewCreateDashboardWidget(o.context, o.SystemCreateDashboardWidgetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy-templates"] = policy.NewCreatePolicyTemplate(o.context, o.PolicyCreatePolicyTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/service-accounts"] = service_account.NewCreateServiceAccount(o.context, o.ServiceAccountCreateServiceAccountHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1431,6 +1938,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/alerts/rules/{ruleId}"] = system.NewDeleteAlertRule(o.context, o.SystemDeleteAlertRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/delete-all-replication-rules"] = bucket.NewDeleteAllReplicationRules(o.context, o.BucketDeleteAllReplicationRulesHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1448,6 +1959,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/replication/{rule_id}"] = bucket.NewDeleteBucketReplicationRule(o.context, o.BucketDeleteBucketReplicationRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/dashboard/widgets/{widgetId}"] = system.NewDeleteDashboardWidget(o.context, o.SystemDeleteDashboardWidgetHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1467,6 +1982,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/policy-templates/{id}"] = policy.NewDeletePolicyTemplate(o.context, o.PolicyDeletePolicyTemplateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/remote-buckets/{source-bucket-name}/{arn}"] = bucket.NewDeleteRemoteBucket(o.context, o.BucketDeleteRemoteBucketHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1476,6 +1995,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/service-accounts/{access_key}"] = service_account.NewDeleteServiceAccount(o.context, o.ServiceAccountDeleteServiceAccountHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/speedtest/results/{id}"] = speedtest.NewDeleteSpeedtestResult(o.context, o.SpeedtestDeleteSpeedtestResultHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/trace/recordings/{id}"] = trace.NewDeleteTraceRecording(o.context, o.TraceDeleteTraceRecordingHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/health/reports/diff"] = system.NewDiffHealthReports(o.context, o.SystemDiffHealthReportsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/policy/{name}/history/diff"] = policy.NewDiffPolicyRevisions(o.context, o.PolicyDiffPolicyRevisionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1483,11 +2018,31 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/console/logs/download"] = logging.NewDownloadConsoleLogs(o.context, o.LoggingDownloadConsoleLogsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/health/reports/{id}/download"] = system.NewDownloadHealthReport(o.context, o.SystemDownloadHealthReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/import/secrets/{id}"] = user.NewDownloadImportedUsersSecrets(o.context, o.UserDownloadImportedUsersSecretsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/download"] = object.NewDownloadObject(o.context, o.ObjectDownloadObjectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/trace/recordings/{id}/download"] = trace.NewDownloadTraceRecording(o.context, o.TraceDownloadTraceRecordingHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/admin/tiers/{type}/{name}/credentials"] = tiering.NewEditTierCredentials(o.context, o.TieringEditTierCredentialsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/iam/effective-permissions"] = policy.NewEffectivePermissions(o.context, o.PolicyEffectivePermissionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1495,6 +2050,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/info/topology"] = system.NewErasureTopology(o.context, o.SystemErasureTopologyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboard/export"] = system.NewExportDashboard(o.context, o.SystemExportDashboardHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/iam/effective-permissions/export"] = policy.NewExportEffectivePermissions(o.context, o.PolicyExportEffectivePermissionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/iam/export"] = policy.NewExportIAM(o.context, o.PolicyExportIAMHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/encryption/info"] = bucket.NewGetBucketEncryptionInfo(o.context, o.BucketGetBucketEncryptionInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1551,6 +2122,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/speedtest/results/{id}"] = speedtest.NewGetSpeedtestResult(o.context, o.SpeedtestGetSpeedtestResultHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/tiers/{type}/{name}"] = tiering.NewGetTier(o.context, o.TieringGetTierHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1564,10 +2139,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/group/{name}"] = group.NewGroupInfo(o.context, o.GroupGroupInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/dashboard/import"] = system.NewImportDashboard(o.context, o.SystemImportDashboardHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/iam/import"] = policy.NewImportIAM(o.context, o.PolicyImportIAMHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/import"] = user.NewImportUsers(o.context, o.UserImportUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/inspect"] = inspect.NewInspect(o.context, o.InspectInspectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policies/lint"] = policy.NewLintPolicy(o.context, o.PolicyLintPolicyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1575,10 +2166,30 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/user/{name}/service-accounts/usage"] = user.NewListAUserServiceAccountsUsage(o.context, o.UserListAUserServiceAccountsUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/grants"] = policy.NewListAccessGrants(o.context, o.PolicyListAccessGrantsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/bucket/{bucket}/access-rules"] = bucket.NewListAccessRulesWithBucket(o.context, o.BucketListAccessRulesWithBucketHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/alerts/channels"] = system.NewListAlertChannels(o.context, o.SystemListAlertChannelsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/rules"] = system.NewListAlertRules(o.context, o.SystemListAlertRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/history"] = system.NewListAlerts(o.context, o.SystemListAlertsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/events"] = bucket.NewListBucketEvents(o.context, o.BucketListBucketEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1588,6 +2199,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/configs"] = configuration.NewListConfig(o.context, o.ConfigurationListConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboard/widgets"] = system.NewListDashboardWidgets(o.context, o.SystemListDashboardWidgetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/service-accounts/expiring"] = service_account.NewListExpiringServiceAccounts(o.context, o.ServiceAccountListExpiringServiceAccountsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1603,6 +2222,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/heal/jobs"] = heal.NewListHealJobs(o.context, o.HealListHealJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/health/reports"] = system.NewListHealthReports(o.context, o.SystemListHealthReportsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/nodes"] = system.NewListNodes(o.context, o.SystemListNodesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1619,14 +2246,38 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/policy/{name}/history"] = policy.NewListPolicyRevisions(o.context, o.PolicyListPolicyRevisionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/policy-templates"] = policy.NewListPolicyTemplates(o.context, o.PolicyListPolicyTemplatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/remote-buckets"] = bucket.NewListRemoteBuckets(o.context, o.BucketListRemoteBucketsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/speedtest/results"] = speedtest.NewListSpeedtestResults(o.context, o.SpeedtestListSpeedtestResultsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/service-accounts/stale"] = service_account.NewListStaleServiceAccounts(o.context, o.ServiceAccountListStaleServiceAccountsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/trace/recordings"] = trace.NewListTraceRecordings(o.context, o.TraceListTraceRecordingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/service-accounts"] = service_account.NewListUserServiceAccounts(o.context, o.ServiceAccountListUserServiceAccountsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/service-accounts/usage"] = service_account.NewListUserServiceAccountsUsage(o.context, o.ServiceAccountListUserServiceAccountsUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/users"] = user.NewListUsers(o.context, o.UserListUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1640,6 +2291,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/logs/search"] = logging.NewLogSearch(o.context, o.LoggingLogSearchHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/logs/search/export"] = logging.NewLogSearchExport(o.context, o.LoggingLogSearchExportHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1664,6 +2319,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/notification_endpoints"] = configuration.NewNotificationEndpointList(o.context, o.ConfigurationNotificationEndpointListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/dashboard/order"] = system.NewOrderDashboardWidgets(o.context, o.SystemOrderDashboardWidgetsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1719,11 +2378,27 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/policy-templates/{id}/render"] = policy.NewRenderPolicyTemplate(o.context, o.PolicyRenderPolicyTemplateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/configs/{name}/reset"] = configuration.NewResetConfig(o.context, o.ConfigurationResetConfigHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/service/restart"] = service.NewRestartService(o.context, o.ServiceRestartServiceHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/grants/{id}"] = policy.NewRevokeAccessGrant(o.context, o.PolicyRevokeAccessGrantHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policy/{name}/history/{revision}/rollback"] = policy.NewRollbackPolicy(o.context, o.PolicyRollbackPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/service-accounts/{access_key}/rotate"] = service_account.NewRotateServiceAccount(o.context, o.ServiceAccountRotateServiceAccountHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1735,6 +2410,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/alerts/channels"] = system.NewSetAlertChannels(o.context, o.SystemSetAlertChannelsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/buckets/{name}/quota"] = bucket.NewSetBucketQuota(o.context, o.BucketSetBucketQuotaHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -1763,11 +2442,23 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/service-accounts/{access_key}/expiry"] = service_account.NewSetServiceAccountExpiry(o.context, o.ServiceAccountSetServiceAccountExpiryHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/service-accounts/{access_key}/policy"] = service_account.NewSetServiceAccountPolicy(o.context, o.ServiceAccountSetServiceAccountPolicyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/share"] = object.NewShareObject(o.context, o.ObjectShareObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/rules/{ruleId}/silence"] = system.NewSilenceAlertRule(o.context, o.SystemSilenceAlertRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/policies/simulate"] = policy.NewSimulatePolicy(o.context, o.PolicySimulatePolicyHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1800,10 +2491,26 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/subnet/register"] = subnet.NewSubnetRegister(o.context, o.SubnetSubnetRegisterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/channels/{name}/test"] = system.NewTestAlertChannel(o.context, o.SystemTestAlertChannelHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/admin/tiers"] = tiering.NewTiersList(o.context, o.TieringTiersListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/trace/recordings/{id}/summary"] = trace.NewTraceRecordingSummary(o.context, o.TraceTraceRecordingSummaryHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/alerts/rules/{ruleId}/silence"] = system.NewUnsilenceAlertRule(o.context, o.SystemUnsilenceAlertRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/alerts/rules/{ruleId}"] = system.NewUpdateAlertRule(o.context, o.SystemUpdateAlertRuleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1811,6 +2518,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/dashboard/widgets/{widgetId}"] = system.NewUpdateDashboardWidget(o.context, o.SystemUpdateDashboardWidgetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/group/{name}"] = group.NewUpdateGroup(o.context, o.GroupUpdateGroupHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
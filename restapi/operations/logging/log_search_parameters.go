@@ -43,6 +43,7 @@ func NewLogSearchParams() LogSearchParams {
 		orderDefault    = string("timeDesc")
 		pageNoDefault   = int32(0)
 		pageSizeDefault = int32(10)
+		qDefault        = string("reqinfo")
 	)
 
 	return LogSearchParams{
@@ -51,6 +52,8 @@ func NewLogSearchParams() LogSearchParams {
 		PageNo: &pageNoDefault,
 
 		PageSize: &pageSizeDefault,
+
+		Q: &qDefault,
 	}
 }
 
@@ -83,6 +86,15 @@ type LogSearchParams struct {
 	  Default: 10
 	*/
 	PageSize *int32
+	/*Query type, request info or raw log entries
+	  In: query
+	  Default: "reqinfo"
+	*/
+	Q *string
+	/*
+	  In: query
+	*/
+	TimeEnd *string
 	/*
 	  In: query
 	*/
@@ -120,6 +132,16 @@ func (o *LogSearchParams) BindRequest(r *http.Request, route *middleware.Matched
 		res = append(res, err)
 	}
 
+	qQ, qhkQ, _ := qs.GetOK("q")
+	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTimeEnd, qhkTimeEnd, _ := qs.GetOK("timeEnd")
+	if err := o.bindTimeEnd(qTimeEnd, qhkTimeEnd, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qTimeStart, qhkTimeStart, _ := qs.GetOK("timeStart")
 	if err := o.bindTimeStart(qTimeStart, qhkTimeStart, route.Formats); err != nil {
 		res = append(res, err)
@@ -233,6 +255,57 @@ func (o *LogSearchParams) bindPageSize(rawData []string, hasKey bool, formats st
 	return nil
 }
 
+// bindQ binds and validates parameter Q from query.
+func (o *LogSearchParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewLogSearchParams()
+		return nil
+	}
+	o.Q = &raw
+
+	if err := o.validateQ(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateQ carries on validations for parameter Q
+func (o *LogSearchParams) validateQ(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("q", "query", *o.Q, []interface{}{"reqinfo", "raw"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindTimeEnd binds and validates parameter TimeEnd from query.
+func (o *LogSearchParams) bindTimeEnd(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.TimeEnd = &raw
+
+	return nil
+}
+
 // bindTimeStart binds and validates parameter TimeStart from query.
 func (o *LogSearchParams) bindTimeStart(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -36,6 +36,8 @@ type LogSearchURL struct {
 	Order     *string
 	PageNo    *int32
 	PageSize  *int32
+	Q         *string
+	TimeEnd   *string
 	TimeStart *string
 
 	_basePath string
@@ -110,6 +112,22 @@ func (o *LogSearchURL) Build() (*url.URL, error) {
 		qs.Set("pageSize", pageSizeQ)
 	}
 
+	var qQ string
+	if o.Q != nil {
+		qQ = *o.Q
+	}
+	if qQ != "" {
+		qs.Set("q", qQ)
+	}
+
+	var timeEndQ string
+	if o.TimeEnd != nil {
+		timeEndQ = *o.TimeEnd
+	}
+	if timeEndQ != "" {
+		qs.Set("timeEnd", timeEndQ)
+	}
+
 	var timeStartQ string
 	if o.TimeStart != nil {
 		timeStartQ = *o.TimeStart
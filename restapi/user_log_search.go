@@ -18,18 +18,30 @@ package restapi
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/GuinsooLab/console/models"
 	"github.com/GuinsooLab/console/restapi/operations"
 	logApi "github.com/GuinsooLab/console/restapi/operations/logging"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
 	iampolicy "github.com/minio/pkg/iam/policy"
 )
 
+// logSearchExportPageSize is the number of entries requested to the Log Search API
+// on every round trip while streaming an export
+const logSearchExportPageSize = 1000
+
 func registerLogSearchHandlers(api *operations.ConsoleAPI) {
 	// log search
 	api.LoggingLogSearchHandler = logApi.LogSearchHandlerFunc(func(params logApi.LogSearchParams, session *models.Principal) middleware.Responder {
@@ -39,55 +51,121 @@ func registerLogSearchHandlers(api *operations.ConsoleAPI) {
 		}
 		return logApi.NewLogSearchOK().WithPayload(searchResp)
 	})
+	// log search export
+	api.LoggingLogSearchExportHandler = logApi.LogSearchExportHandlerFunc(func(params logApi.LogSearchExportParams, session *models.Principal) middleware.Responder {
+		query, err := getLogSearchExportQuery(session, params)
+		if err != nil {
+			return logApi.NewLogSearchExportDefault(int(err.Code)).WithPayload(err)
+		}
+		return middleware.ResponderFunc(processLogSearchExportResponse(params.HTTPRequest.Context(), query, *params.Format))
+	})
+}
+
+// logSearchQuery holds the parameters sent to the Log Search API
+type logSearchQuery struct {
+	queryType string
+	filters   []string
+	order     string
+	timeStart string
+	timeEnd   string
+	pageSize  int32
+	pageNo    int32
 }
 
 // getLogSearchResponse performs a query to Log Search if Enabled
 func getLogSearchResponse(session *models.Principal, params logApi.LogSearchParams) (*models.LogSearchResponse, *models.Error) {
 	ctx, cancel := context.WithCancel(params.HTTPRequest.Context())
 	defer cancel()
-	sessionResp, err := getSessionResponse(ctx, session)
+	if err := validateLogSearchAccess(ctx, session); err != nil {
+		return nil, err
+	}
+
+	query := logSearchQuery{
+		queryType: *params.Q,
+		filters:   params.Fp,
+		order:     *params.Order,
+		timeStart: swag.StringValue(params.TimeStart),
+		timeEnd:   swag.StringValue(params.TimeEnd),
+		pageSize:  *params.PageSize,
+		pageNo:    *params.PageNo,
+	}
+	endpoint, err := buildLogSearchURL(getLogSearchURL(), getLogSearchAPIToken(), query)
 	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+
+	response, errLogSearch := logSearch(endpoint)
+	if errLogSearch != nil {
+		return nil, ErrorWithContext(ctx, errLogSearch)
+	}
+	return response, nil
+}
+
+// getLogSearchExportQuery validates the export request and returns the query to stream
+func getLogSearchExportQuery(session *models.Principal, params logApi.LogSearchExportParams) (*logSearchQuery, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if err := validateLogSearchAccess(ctx, session); err != nil {
 		return nil, err
 	}
-	var allowedToQueryLogSearchAPI bool
-	if permissions, ok := sessionResp.Permissions[ConsoleResourceName]; ok {
-		for _, permission := range permissions {
-			if permission == iampolicy.HealthInfoAdminAction {
-				allowedToQueryLogSearchAPI = true
-				break
-			}
-		}
+	if err := validateLogSearchFilters(params.Fp); err != nil {
+		return nil, ErrorWithContext(ctx, err)
 	}
+	return &logSearchQuery{
+		queryType: *params.Q,
+		filters:   params.Fp,
+		order:     *params.Order,
+		timeStart: swag.StringValue(params.TimeStart),
+		timeEnd:   swag.StringValue(params.TimeEnd),
+		pageSize:  logSearchExportPageSize,
+	}, nil
+}
+
+// validateLogSearchAccess verifies the session is allowed to query the Log Search API
+func validateLogSearchAccess(ctx context.Context, session *models.Principal) *models.Error {
+	return validateSessionAdminAction(ctx, session, iampolicy.HealthInfoAdminAction, "The Log Search API not available.")
+}
 
-	if !allowedToQueryLogSearchAPI {
-		return nil, &models.Error{
-			Code:            int32(403),
-			Message:         swag.String("Forbidden"),
-			DetailedMessage: swag.String("The Log Search API not available."),
+// validateLogSearchFilters makes sure every filter parameter is in the `key:value` form
+// expected by the Log Search API
+func validateLogSearchFilters(filters []string) error {
+	for _, fp := range filters {
+		key, value, found := strings.Cut(fp, ":")
+		if !found || key == "" || value == "" {
+			return ErrInvalidLogSearchFilter
 		}
 	}
+	return nil
+}
 
-	token := getLogSearchAPIToken()
-	endpoint := fmt.Sprintf("%s/api/query?token=%s&q=reqinfo", getLogSearchURL(), token)
-	for _, fp := range params.Fp {
-		endpoint = fmt.Sprintf("%s&fp=%s", endpoint, fp)
+// buildLogSearchURL builds the Log Search API query endpoint, every parameter is escaped
+func buildLogSearchURL(baseURL, token string, query logSearchQuery) (string, error) {
+	if err := validateLogSearchFilters(query.filters); err != nil {
+		return "", err
+	}
+	endpoint, err := url.Parse(strings.TrimSuffix(baseURL, "/") + "/api/query")
+	if err != nil {
+		return "", err
 	}
 
-	endpoint = fmt.Sprintf("%s&%s=ok", endpoint, *params.Order)
-
-	// timeStart
-	if params.TimeStart != nil && *params.TimeStart != "" {
-		endpoint = fmt.Sprintf("%s&timeStart=%s", endpoint, *params.TimeStart)
+	values := url.Values{}
+	values.Set("token", token)
+	values.Set("q", query.queryType)
+	for _, fp := range query.filters {
+		values.Add("fp", fp)
+	}
+	values.Set(query.order, "ok")
+	if query.timeStart != "" {
+		values.Set("timeStart", query.timeStart)
+	}
+	if query.timeEnd != "" {
+		values.Set("timeEnd", query.timeEnd)
 	}
 	// page size and page number
-	endpoint = fmt.Sprintf("%s&pageSize=%d", endpoint, *params.PageSize)
-	endpoint = fmt.Sprintf("%s&pageNo=%d", endpoint, *params.PageNo)
+	values.Set("pageSize", strconv.Itoa(int(query.pageSize)))
+	values.Set("pageNo", strconv.Itoa(int(query.pageNo)))
 
-	response, errLogSearch := logSearch(endpoint)
-	if errLogSearch != nil {
-		return nil, ErrorWithContext(ctx, errLogSearch)
-	}
-	return response, nil
+	endpoint.RawQuery = values.Encode()
+	return endpoint.String(), nil
 }
 
 func logSearch(endpoint string) (*models.LogSearchResponse, error) {
@@ -111,3 +189,155 @@ func logSearch(endpoint string) (*models.LogSearchResponse, error) {
 		Results: results,
 	}, nil
 }
+
+func processLogSearchExportResponse(ctx context.Context, query *logSearchQuery, format string) func(w http.ResponseWriter, _ runtime.Producer) {
+	return func(w http.ResponseWriter, _ runtime.Producer) {
+		contentType := "application/x-ndjson"
+		if format == "csv" {
+			contentType = "text/csv"
+		}
+		fileName := fmt.Sprintf("logs-%s.%s", time.Now().UTC().Format("20060102150405"), format)
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", fileName))
+
+		err := exportLogSearch(ctx, getLogSearchURL(), getLogSearchAPIToken(), *query, newLogSearchExportWriter(format, w))
+		if err != nil {
+			LogError("Unable to export all the logs: %v", err)
+		}
+	}
+}
+
+// exportLogSearch requests the Log Search API page by page and hands every entry to the
+// export writer as soon as it is decoded, so the whole result set is never held in memory
+func exportLogSearch(ctx context.Context, baseURL, token string, query logSearchQuery, writer logSearchExportWriter) error {
+	for pageNo := int32(0); ; pageNo++ {
+		query.pageNo = pageNo
+		endpoint, err := buildLogSearchURL(baseURL, token, query)
+		if err != nil {
+			return err
+		}
+		count, err := streamLogSearchPage(ctx, endpoint, writer.write)
+		if err != nil {
+			return err
+		}
+		if count < int(query.pageSize) {
+			break
+		}
+	}
+	return writer.flush()
+}
+
+// streamLogSearchPage decodes a single page of results one entry at a time
+func streamLogSearchPage(ctx context.Context, endpoint string, fn func(entry map[string]interface{}) error) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := GetConsoleHTTPClient().Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("the Log Search API cannot be reached. Please review the URL and try again %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return 0, fmt.Errorf("error retrieving logs: %s", http.StatusText(resp.StatusCode))
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	token, err := decoder.Token()
+	if err != nil {
+		return 0, err
+	}
+	// the Log Search API replies with `null` when there are no results
+	if token == nil {
+		return 0, nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return 0, fmt.Errorf("unexpected Log Search API response")
+	}
+	count := 0
+	for decoder.More() {
+		var entry map[string]interface{}
+		if err = decoder.Decode(&entry); err != nil {
+			return count, err
+		}
+		if err = fn(entry); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// logSearchExportWriter serializes log entries into the requested export format
+type logSearchExportWriter interface {
+	write(entry map[string]interface{}) error
+	flush() error
+}
+
+func newLogSearchExportWriter(format string, w io.Writer) logSearchExportWriter {
+	if format == "csv" {
+		return &logSearchCSVWriter{w: csv.NewWriter(w)}
+	}
+	return &logSearchNDJSONWriter{enc: json.NewEncoder(w)}
+}
+
+type logSearchNDJSONWriter struct {
+	enc *json.Encoder
+}
+
+func (n *logSearchNDJSONWriter) write(entry map[string]interface{}) error {
+	return n.enc.Encode(entry)
+}
+
+func (n *logSearchNDJSONWriter) flush() error {
+	return nil
+}
+
+// logSearchCSVWriter takes the columns from the first entry it receives, the Log Search API
+// returns the same set of fields for every entry of a given query type
+type logSearchCSVWriter struct {
+	w       *csv.Writer
+	columns []string
+}
+
+func (c *logSearchCSVWriter) write(entry map[string]interface{}) error {
+	if c.columns == nil {
+		for column := range entry {
+			c.columns = append(c.columns, column)
+		}
+		sort.Strings(c.columns)
+		if err := c.w.Write(c.columns); err != nil {
+			return err
+		}
+	}
+	record := make([]string, len(c.columns))
+	for i, column := range c.columns {
+		record[i] = logSearchCSVValue(entry[column])
+	}
+	return c.w.Write(record)
+}
+
+func (c *logSearchCSVWriter) flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func logSearchCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
@@ -17,14 +17,20 @@
 package restapi
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/GuinsooLab/console/models"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestLogSearch(t *testing.T) {
@@ -136,3 +142,148 @@ func TestLogSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildLogSearchURL(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name           string
+		query          logSearchQuery
+		expectedValues url.Values
+		expectedErr    error
+	}{
+		{
+			name: "request info query with time range",
+			query: logSearchQuery{
+				queryType: "reqinfo",
+				filters:   []string{"bucket:my bucket", "api_name:PutObject"},
+				order:     "timeDesc",
+				timeStart: "2022-01-02T15:04:05Z",
+				timeEnd:   "2022-01-03T15:04:05Z",
+				pageSize:  10,
+				pageNo:    2,
+			},
+			expectedValues: url.Values{
+				"token":     []string{"a&token"},
+				"q":         []string{"reqinfo"},
+				"fp":        []string{"bucket:my bucket", "api_name:PutObject"},
+				"timeDesc":  []string{"ok"},
+				"timeStart": []string{"2022-01-02T15:04:05Z"},
+				"timeEnd":   []string{"2022-01-03T15:04:05Z"},
+				"pageSize":  []string{"10"},
+				"pageNo":    []string{"2"},
+			},
+		},
+		{
+			name: "raw query without time range",
+			query: logSearchQuery{
+				queryType: "raw",
+				order:     "timeAsc",
+				pageSize:  10,
+			},
+			expectedValues: url.Values{
+				"token":    []string{"a&token"},
+				"q":        []string{"raw"},
+				"timeAsc":  []string{"ok"},
+				"pageSize": []string{"10"},
+				"pageNo":   []string{"0"},
+			},
+		},
+		{
+			name: "invalid filter",
+			query: logSearchQuery{
+				queryType: "reqinfo",
+				filters:   []string{"bucket"},
+				order:     "timeDesc",
+			},
+			expectedErr: ErrInvalidLogSearchFilter,
+		},
+		{
+			name: "filter without value",
+			query: logSearchQuery{
+				queryType: "reqinfo",
+				filters:   []string{"bucket:"},
+				order:     "timeDesc",
+			},
+			expectedErr: ErrInvalidLogSearchFilter,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, err := buildLogSearchURL("http://logsearch:8080/", "a&token", tt.query)
+			if tt.expectedErr != nil {
+				assert.True(errors.Is(err, tt.expectedErr), fmt.Sprintf("buildLogSearchURL() error: `%v`, wantErr: `%v`", err, tt.expectedErr))
+				return
+			}
+			assert.Nil(err)
+			u, err := url.Parse(endpoint)
+			assert.Nil(err)
+			assert.Equal("/api/query", u.Path)
+			assert.Equal(tt.expectedValues, u.Query())
+		})
+	}
+}
+
+func TestExportLogSearch(t *testing.T) {
+	assert := assert.New(t)
+	pageSize := 2
+	entries := []map[string]interface{}{
+		{"time": "2022-01-02T15:04:05Z", "api_name": "PutObject", "response_status_code": float64(200), "bucket": nil},
+		{"time": "2022-01-02T15:04:06Z", "api_name": "GetObject", "response_status_code": float64(404), "bucket": "test"},
+		{"time": "2022-01-02T15:04:07Z", "api_name": "ListObjects", "response_status_code": float64(200), "bucket": "test,1"},
+	}
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pageNo, _ := strconv.Atoi(r.URL.Query().Get("pageNo"))
+		requestedPages = append(requestedPages, r.URL.Query().Get("pageNo"))
+		start := pageNo * pageSize
+		if start >= len(entries) {
+			fmt.Fprintln(w, "null")
+			return
+		}
+		end := start + pageSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		json.NewEncoder(w).Encode(entries[start:end])
+	}))
+	defer server.Close()
+
+	query := logSearchQuery{
+		queryType: "reqinfo",
+		order:     "timeAsc",
+		pageSize:  int32(pageSize),
+	}
+
+	// ndjson export
+	var buf bytes.Buffer
+	err := exportLogSearch(context.Background(), server.URL, "token", query, newLogSearchExportWriter("ndjson", &buf))
+	assert.Nil(err)
+	assert.Equal([]string{"0", "1"}, requestedPages)
+	decoder := json.NewDecoder(&buf)
+	var exported []map[string]interface{}
+	for decoder.More() {
+		var entry map[string]interface{}
+		assert.Nil(decoder.Decode(&entry))
+		exported = append(exported, entry)
+	}
+	assert.Equal(entries, exported)
+
+	// csv export
+	buf.Reset()
+	err = exportLogSearch(context.Background(), server.URL, "token", query, newLogSearchExportWriter("csv", &buf))
+	assert.Nil(err)
+	expectedCSV := "api_name,bucket,response_status_code,time\n" +
+		"PutObject,,200,2022-01-02T15:04:05Z\n" +
+		"GetObject,test,404,2022-01-02T15:04:06Z\n" +
+		"ListObjects,\"test,1\",200,2022-01-02T15:04:07Z\n"
+	assert.Equal(expectedCSV, buf.String())
+
+	// a page that exactly fills the page size keeps the export going until an empty page
+	requestedPages = nil
+	buf.Reset()
+	entries = entries[:2]
+	err = exportLogSearch(context.Background(), server.URL, "token", query, newLogSearchExportWriter("ndjson", &buf))
+	assert.Nil(err)
+	assert.Equal([]string{"0", "1"}, requestedPages)
+}
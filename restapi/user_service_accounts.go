@@ -317,20 +317,16 @@ func getCreateServiceAccountCredsResponse(session *models.Principal, params saAp
 }
 
 // getUserServiceAccount gets list of the user's service accounts
-func getUserServiceAccounts(ctx context.Context, userClient MinioAdmin, user string) (models.ServiceAccounts, error) {
+func getUserServiceAccounts(ctx context.Context, userClient MinioAdmin, user string) ([]string, error) {
 	listServAccs, err := userClient.listServiceAccounts(ctx, user)
 	if err != nil {
 		return nil, err
 	}
-	serviceAccounts := models.ServiceAccounts{}
-	for _, acc := range listServAccs.Accounts {
-		serviceAccounts = append(serviceAccounts, acc)
-	}
-	return serviceAccounts, nil
+	return listServAccs.Accounts, nil
 }
 
-// getUserServiceAccountsResponse authenticates the user and calls
-// getUserServiceAccounts to list the user's service accounts
+// getUserServiceAccountsResponse authenticates the user and lists the user's service accounts with
+// their usage
 func getUserServiceAccountsResponse(ctx context.Context, session *models.Principal, user string) (models.ServiceAccounts, *models.Error) {
 	userAdmin, err := NewMinioAdminClient(session)
 	if err != nil {
@@ -343,16 +339,27 @@ func getUserServiceAccountsResponse(ctx context.Context, session *models.Princip
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
-	serviceAccounts, err := getUserServiceAccounts(ctx, userAdminClient, user)
+	parentUser := user
+	if parentUser == "" {
+		parentUser = session.AccountAccessKey
+	}
+	usage, err := getUserServiceAccountsUsage(ctx, userAdminClient, serviceAccountUsage, user, parentUser)
 	if err != nil {
 		return nil, ErrorWithContext(ctx, err)
 	}
-	return serviceAccounts, nil
+	return models.ServiceAccounts(usage.Accounts), nil
 }
 
-// deleteServiceAccount calls delete service account api
+// deleteServiceAccount calls delete service account api and forgets its expiration and usage
 func deleteServiceAccount(ctx context.Context, userClient MinioAdmin, accessKey string) error {
-	return userClient.deleteServiceAccount(ctx, accessKey)
+	if err := userClient.deleteServiceAccount(ctx, accessKey); err != nil {
+		return err
+	}
+	if err := forgetServiceAccountExpiry(getServiceAccountExpiryFile(), accessKey); err != nil {
+		LogError("unable to remove the expiration of service account %s: %v", accessKey, err)
+	}
+	serviceAccountUsage.forget(accessKey)
+	return nil
 }
 
 // getDeleteServiceAccountResponse authenticates the user and calls deleteServiceAccount
@@ -0,0 +1,485 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/pkg/utils"
+	"github.com/GuinsooLab/console/restapi/operations"
+	saApi "github.com/GuinsooLab/console/restapi/operations/service_account"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// serviceAccountRotationGracePeriod is how long the old credentials stay valid after a rotation by default
+const serviceAccountRotationGracePeriod = 24 * time.Hour
+
+// serviceAccountExpiryMu serializes the updates of the service accounts expiration file
+var serviceAccountExpiryMu sync.Mutex
+
+// serviceAccountExpiryEnabled is set once the scheduler disabling the expired service accounts is
+// running, expirations can't be set otherwise since they would never be enforced
+var serviceAccountExpiryEnabled bool
+
+// serviceAccountExpiryScheduler disables the expired service accounts every `interval` and deletes
+// them once they have been disabled for `deleteAfter`
+type serviceAccountExpiryScheduler struct {
+	file        string
+	interval    time.Duration
+	deleteAfter time.Duration
+	admin       MinioAdmin
+}
+
+func registerServiceAccountExpiryHandlers(api *operations.ConsoleAPI) {
+	// set service account expiration
+	api.ServiceAccountSetServiceAccountExpiryHandler = saApi.SetServiceAccountExpiryHandlerFunc(func(params saApi.SetServiceAccountExpiryParams, session *models.Principal) middleware.Responder {
+		resp, err := getSetServiceAccountExpiryResponse(session, params)
+		if err != nil {
+			return saApi.NewSetServiceAccountExpiryDefault(int(err.Code)).WithPayload(err)
+		}
+		return saApi.NewSetServiceAccountExpiryOK().WithPayload(resp)
+	})
+	// rotate service account
+	api.ServiceAccountRotateServiceAccountHandler = saApi.RotateServiceAccountHandlerFunc(func(params saApi.RotateServiceAccountParams, session *models.Principal) middleware.Responder {
+		resp, err := getRotateServiceAccountResponse(session, params)
+		if err != nil {
+			return saApi.NewRotateServiceAccountDefault(int(err.Code)).WithPayload(err)
+		}
+		return saApi.NewRotateServiceAccountCreated().WithPayload(resp)
+	})
+	// list service accounts expiring soon
+	api.ServiceAccountListExpiringServiceAccountsHandler = saApi.ListExpiringServiceAccountsHandlerFunc(func(params saApi.ListExpiringServiceAccountsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListExpiringServiceAccountsResponse(session, params)
+		if err != nil {
+			return saApi.NewListExpiringServiceAccountsDefault(int(err.Code)).WithPayload(err)
+		}
+		return saApi.NewListExpiringServiceAccountsOK().WithPayload(resp)
+	})
+}
+
+// getServiceAccountExpiryFile returns the file keeping the expiration of the service accounts
+func getServiceAccountExpiryFile() string {
+	return filepath.Join(getDataDir(), "service-accounts-expiry.json")
+}
+
+func readServiceAccountExpiry(file string) ([]*models.ServiceAccountExpiry, error) {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var accounts []*models.ServiceAccountExpiry
+	if err = json.Unmarshal(b, &accounts); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+func writeServiceAccountExpiry(file string, accounts []*models.ServiceAccountExpiry) error {
+	return writeDataFile(file, accounts)
+}
+
+func findServiceAccountExpiry(accounts []*models.ServiceAccountExpiry, accessKey string) *models.ServiceAccountExpiry {
+	for _, account := range accounts {
+		if account.AccessKey == accessKey {
+			return account
+		}
+	}
+	return nil
+}
+
+// startServiceAccountExpiryScheduler starts disabling and deleting the expired service accounts if
+// configured, it uses its own credentials since there is no user session to use
+func startServiceAccountExpiryScheduler(ctx context.Context) {
+	accessKey, secretKey := getServiceAccountsCredentials()
+	if accessKey == "" || secretKey == "" {
+		LogInfo("service account expiration disabled, %s and %s are required", ConsoleServiceAccountsAccessKey, ConsoleServiceAccountsSecretKey)
+		return
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		LogError("service account expiration disabled: %v", err)
+		return
+	}
+	mAdmin.SetCustomTransport(GetConsoleHTTPClient().Transport)
+	scheduler := &serviceAccountExpiryScheduler{
+		file:        getServiceAccountExpiryFile(),
+		interval:    getServiceAccountsInterval(),
+		deleteAfter: getServiceAccountsDeleteAfter(),
+		admin:       AdminClient{Client: mAdmin},
+	}
+	serviceAccountExpiryEnabled = true
+	go scheduler.run(ctx)
+}
+
+func (s *serviceAccountExpiryScheduler) run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		if err := enforceServiceAccountExpiry(ctx, s.admin, s.file, time.Now(), s.deleteAfter); err != nil {
+			LogError("error enforcing service accounts expiration: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// getServiceAccountInfo returns the service account, converting the error when it doesn't exist
+func getServiceAccountInfo(ctx context.Context, client MinioAdmin, accessKey string) (madmin.InfoServiceAccountResp, error) {
+	info, err := client.infoServiceAccount(ctx, accessKey)
+	if err != nil && madmin.ToErrorResponse(err).Code == "XMinioAdminServiceAccountNotFound" {
+		return info, ErrServiceAccountNotFound
+	}
+	return info, err
+}
+
+// parseServiceAccountExpiration returns the expiration of a request, zero when it has none
+func parseServiceAccountExpiration(expiresAt, expiresIn string, now time.Time) (time.Time, error) {
+	if expiresAt != "" {
+		t, err := time.Parse(time.RFC3339, expiresAt)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid time %q", ErrInvalidServiceAccountExpiry, expiresAt)
+		}
+		if !t.After(now) {
+			return time.Time{}, fmt.Errorf("%w: the expiration must be in the future", ErrInvalidServiceAccountExpiry)
+		}
+		return t.UTC(), nil
+	}
+	if expiresIn != "" {
+		d, err := time.ParseDuration(expiresIn)
+		if err != nil || d <= 0 {
+			return time.Time{}, fmt.Errorf("%w: invalid duration %q", ErrInvalidServiceAccountExpiry, expiresIn)
+		}
+		return now.Add(d).UTC(), nil
+	}
+	return time.Time{}, nil
+}
+
+// setServiceAccountExpiry sets or removes the expiration of a service account, a service account
+// disabled when it expired is enabled again
+func setServiceAccountExpiry(ctx context.Context, client MinioAdmin, file, accessKey string, req *models.ServiceAccountExpiryRequest, now time.Time) (*models.ServiceAccountExpiry, error) {
+	expiresAt, err := parseServiceAccountExpiration(req.ExpiresAt, req.ExpiresIn, now)
+	if err != nil {
+		return nil, err
+	}
+	info, err := getServiceAccountInfo(ctx, client, accessKey)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccountExpiryMu.Lock()
+	defer serviceAccountExpiryMu.Unlock()
+	accounts, err := readServiceAccountExpiry(file)
+	if err != nil {
+		return nil, err
+	}
+	account := findServiceAccountExpiry(accounts, accessKey)
+	if account == nil {
+		account = &models.ServiceAccountExpiry{AccessKey: accessKey}
+		accounts = append(accounts, account)
+	}
+	if account.Status == models.ServiceAccountExpiryStatusDisabled {
+		if err = client.updateServiceAccount(ctx, accessKey, madmin.UpdateServiceAccountReq{NewStatus: "on"}); err != nil {
+			return nil, err
+		}
+	}
+	account.ParentUser = info.ParentUser
+	account.Status = models.ServiceAccountExpiryStatusActive
+	account.ExpiresAt, account.DisabledAt, account.DeleteAt, account.Error = "", "", "", ""
+	if !expiresAt.IsZero() {
+		account.ExpiresAt = expiresAt.Format(time.RFC3339)
+	}
+	if err = writeServiceAccountExpiry(file, accounts); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// forgetServiceAccountExpiry removes the expiration of a deleted service account
+func forgetServiceAccountExpiry(file, accessKey string) error {
+	serviceAccountExpiryMu.Lock()
+	defer serviceAccountExpiryMu.Unlock()
+	accounts, err := readServiceAccountExpiry(file)
+	if err != nil || findServiceAccountExpiry(accounts, accessKey) == nil {
+		return err
+	}
+	kept := make([]*models.ServiceAccountExpiry, 0, len(accounts))
+	for _, account := range accounts {
+		if account.AccessKey != accessKey {
+			kept = append(kept, account)
+		}
+	}
+	return writeServiceAccountExpiry(file, kept)
+}
+
+// rotateServiceAccount creates a service account with the same parent and policy and makes the old
+// one expire after the grace period. MinIO keeps a single secret key per service account so both
+// credentials can only be valid at the same time as two service accounts, the rotation returns a new
+// access key along with the new secret key as documented in the API
+func rotateServiceAccount(ctx context.Context, client MinioAdmin, file, accessKey string, req *models.ServiceAccountRotateRequest, now time.Time) (*models.ServiceAccountRotation, error) {
+	grace := serviceAccountRotationGracePeriod
+	if req.GracePeriod != "" {
+		d, err := time.ParseDuration(req.GracePeriod)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("%w: invalid grace period %q", ErrInvalidServiceAccountExpiry, req.GracePeriod)
+		}
+		grace = d
+	}
+	expiresAt, err := parseServiceAccountExpiration("", req.ExpiresIn, now)
+	if err != nil {
+		return nil, err
+	}
+	info, err := getServiceAccountInfo(ctx, client, accessKey)
+	if err != nil {
+		return nil, err
+	}
+	if info.AccountStatus == "off" {
+		return nil, fmt.Errorf("%w: the service account is disabled", ErrInvalidServiceAccountExpiry)
+	}
+	// an implied policy is inherited from the parent user, so the new service account gets no policy either
+	var policy *iampolicy.Policy
+	if !info.ImpliedPolicy && strings.TrimSpace(info.Policy) != "" {
+		if policy, err = iampolicy.ParseConfig(bytes.NewReader([]byte(info.Policy))); err != nil {
+			return nil, err
+		}
+	}
+
+	serviceAccountExpiryMu.Lock()
+	defer serviceAccountExpiryMu.Unlock()
+	accounts, err := readServiceAccountExpiry(file)
+	if err != nil {
+		return nil, err
+	}
+	previous := findServiceAccountExpiry(accounts, accessKey)
+	if previous != nil && previous.RotatedTo != "" {
+		return nil, fmt.Errorf("%w: the service account was rotated already to %s", ErrInvalidServiceAccountExpiry, previous.RotatedTo)
+	}
+	creds, err := client.addServiceAccount(ctx, policy, info.ParentUser, "", "")
+	if err != nil {
+		return nil, err
+	}
+	if previous == nil {
+		previous = &models.ServiceAccountExpiry{AccessKey: accessKey, ParentUser: info.ParentUser, Status: models.ServiceAccountExpiryStatusActive}
+		accounts = append(accounts, previous)
+	}
+	// an earlier expiration of the old credentials is kept
+	previousExpiresAt := now.Add(grace).UTC()
+	if t, err := time.Parse(time.RFC3339, previous.ExpiresAt); err == nil && t.Before(previousExpiresAt) {
+		previousExpiresAt = t
+	}
+	previous.ExpiresAt = previousExpiresAt.Format(time.RFC3339)
+	previous.RotatedTo = creds.AccessKey
+	rotated := &models.ServiceAccountExpiry{
+		AccessKey:   creds.AccessKey,
+		ParentUser:  info.ParentUser,
+		Status:      models.ServiceAccountExpiryStatusActive,
+		RotatedFrom: accessKey,
+	}
+	if !expiresAt.IsZero() {
+		rotated.ExpiresAt = expiresAt.Format(time.RFC3339)
+	}
+	accounts = append(accounts, rotated)
+	if err = writeServiceAccountExpiry(file, accounts); err != nil {
+		// the new credentials can't be returned without the old ones expiring
+		if errDelete := client.deleteServiceAccount(ctx, creds.AccessKey); errDelete != nil {
+			LogError("unable to delete rotated service account %s: %v", creds.AccessKey, errDelete)
+		}
+		return nil, err
+	}
+	return &models.ServiceAccountRotation{
+		AccessKey:         creds.AccessKey,
+		SecretKey:         creds.SecretKey,
+		URL:               getMinIOServer(),
+		ExpiresAt:         rotated.ExpiresAt,
+		PreviousAccessKey: accessKey,
+		PreviousExpiresAt: previous.ExpiresAt,
+	}, nil
+}
+
+// enforceServiceAccountExpiry disables the expired service accounts and deletes the ones disabled for
+// longer than `deleteAfter`, failures are recorded and retried on the next run
+func enforceServiceAccountExpiry(ctx context.Context, client MinioAdmin, file string, now time.Time, deleteAfter time.Duration) error {
+	serviceAccountExpiryMu.Lock()
+	defer serviceAccountExpiryMu.Unlock()
+	accounts, err := readServiceAccountExpiry(file)
+	if err != nil {
+		return err
+	}
+	changed := false
+	kept := make([]*models.ServiceAccountExpiry, 0, len(accounts))
+	for _, account := range accounts {
+		expiresAt, err := time.Parse(time.RFC3339, account.ExpiresAt)
+		if err != nil || expiresAt.After(now) {
+			kept = append(kept, account)
+			continue
+		}
+		if account.Status == models.ServiceAccountExpiryStatusActive {
+			changed = true
+			err = client.updateServiceAccount(ctx, account.AccessKey, madmin.UpdateServiceAccountReq{NewStatus: "off"})
+			if err == nil {
+				account.Status = models.ServiceAccountExpiryStatusDisabled
+				account.DisabledAt = now.UTC().Format(time.RFC3339)
+				account.DeleteAt = now.Add(deleteAfter).UTC().Format(time.RFC3339)
+				account.Error = ""
+			}
+		} else {
+			deleteAt, errParse := time.Parse(time.RFC3339, account.DeleteAt)
+			if errParse == nil && deleteAt.After(now) {
+				kept = append(kept, account)
+				continue
+			}
+			changed = true
+			if err = client.deleteServiceAccount(ctx, account.AccessKey); err == nil {
+				LogInfo("deleted expired service account %s of %s", account.AccessKey, account.ParentUser)
+				continue
+			}
+		}
+		if err != nil {
+			if madmin.ToErrorResponse(err).Code == "XMinioAdminServiceAccountNotFound" {
+				continue
+			}
+			LogError("unable to expire service account %s: %v", account.AccessKey, err)
+			account.Error = err.Error()
+		}
+		kept = append(kept, account)
+	}
+	if !changed {
+		return nil
+	}
+	return writeServiceAccountExpiry(file, kept)
+}
+
+// listExpiringServiceAccounts returns the service accounts expiring within the duration, including
+// the expired ones waiting to be deleted, sorted by expiration
+func listExpiringServiceAccounts(file string, within time.Duration, now time.Time) (*models.ServiceAccountExpiryList, error) {
+	serviceAccountExpiryMu.Lock()
+	accounts, err := readServiceAccountExpiry(file)
+	serviceAccountExpiryMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	limit := now.Add(within)
+	expiring := []*models.ServiceAccountExpiry{}
+	for _, account := range accounts {
+		if expiresAt, err := time.Parse(time.RFC3339, account.ExpiresAt); err == nil && !expiresAt.After(limit) {
+			expiring = append(expiring, account)
+		}
+	}
+	// RFC 3339 times in UTC sort as strings
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].ExpiresAt < expiring[j].ExpiresAt
+	})
+	return &models.ServiceAccountExpiryList{Accounts: expiring, Total: int64(len(expiring))}, nil
+}
+
+// validateServiceAccountExpiryPermissions checks the session can update and remove service accounts, an
+// expired service account is disabled and deleted with the credentials of the scheduler so the session
+// must be allowed to do it itself
+func validateServiceAccountExpiryPermissions(ctx context.Context, session *models.Principal) *models.Error {
+	if err := validateSessionAdminAction(ctx, session, iampolicy.UpdateServiceAccountAdminAction, "Service account expiration not available."); err != nil {
+		return err
+	}
+	return validateSessionAdminAction(ctx, session, iampolicy.RemoveServiceAccountAdminAction, "Service account expiration not available.")
+}
+
+func getSetServiceAccountExpiryResponse(session *models.Principal, params saApi.SetServiceAccountExpiryParams) (*models.ServiceAccountExpiry, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if !serviceAccountExpiryEnabled {
+		return nil, ErrorWithContext(ctx, ErrServiceAccountExpiryDisabled)
+	}
+	if err := validateServiceAccountExpiryPermissions(ctx, session); err != nil {
+		return nil, err
+	}
+	accessKey, err := utils.DecodeBase64(params.AccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	userAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO user Admin Client interface implementation
+	// defining the client to be used
+	userAdminClient := AdminClient{Client: userAdmin}
+	account, err := setServiceAccountExpiry(ctx, userAdminClient, getServiceAccountExpiryFile(), accessKey, params.Body, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return account, nil
+}
+
+func getRotateServiceAccountResponse(session *models.Principal, params saApi.RotateServiceAccountParams) (*models.ServiceAccountRotation, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	if !serviceAccountExpiryEnabled {
+		return nil, ErrorWithContext(ctx, ErrServiceAccountExpiryDisabled)
+	}
+	if err := validateServiceAccountExpiryPermissions(ctx, session); err != nil {
+		return nil, err
+	}
+	accessKey, err := utils.DecodeBase64(params.AccessKey)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	userAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO user Admin Client interface implementation
+	// defining the client to be used
+	userAdminClient := AdminClient{Client: userAdmin}
+	rotation, err := rotateServiceAccount(ctx, userAdminClient, getServiceAccountExpiryFile(), accessKey, params.Body, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return rotation, nil
+}
+
+func getListExpiringServiceAccountsResponse(session *models.Principal, params saApi.ListExpiringServiceAccountsParams) (*models.ServiceAccountExpiryList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	// the report covers the service accounts of every user
+	if err := validateSessionAdminAction(ctx, session, iampolicy.ListServiceAccountsAdminAction, "Service accounts expiration report not available."); err != nil {
+		return nil, err
+	}
+	within, err := time.ParseDuration(swag.StringValue(params.Within))
+	if err != nil || within < 0 {
+		return nil, ErrorWithContext(ctx, fmt.Errorf("%w: invalid duration %q", ErrInvalidServiceAccountExpiry, swag.StringValue(params.Within)))
+	}
+	list, err := listExpiringServiceAccounts(getServiceAccountExpiryFile(), within, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return list, nil
+}
@@ -0,0 +1,159 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceAccountExpiry(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "service-accounts-expiry.json")
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	status := map[string]string{"ci": "on", "backup": "on"}
+	minioInfoServiceAccountMock = func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error) {
+		s, ok := status[serviceAccount]
+		if !ok {
+			return madmin.InfoServiceAccountResp{}, madmin.ErrorResponse{Code: "XMinioAdminServiceAccountNotFound"}
+		}
+		return madmin.InfoServiceAccountResp{ParentUser: "alice", AccountStatus: s, ImpliedPolicy: true}, nil
+	}
+	minioUpdateServiceAccountMock = func(ctx context.Context, serviceAccount string, opts madmin.UpdateServiceAccountReq) error {
+		status[serviceAccount] = opts.NewStatus
+		return nil
+	}
+	minioDeleteServiceAccountMock = func(ctx context.Context, serviceAccount string) error {
+		if _, ok := status[serviceAccount]; !ok {
+			return madmin.ErrorResponse{Code: "XMinioAdminServiceAccountNotFound"}
+		}
+		delete(status, serviceAccount)
+		return nil
+	}
+
+	// Test-1: expirations are set with a time or a duration
+	account, err := setServiceAccountExpiry(ctx, adminClientMock{}, file, "ci", &models.ServiceAccountExpiryRequest{ExpiresIn: "2h"}, now)
+	if assert.Nil(err) {
+		assert.Equal("2022-06-01T12:00:00Z", account.ExpiresAt)
+		assert.Equal("alice", account.ParentUser)
+	}
+	_, err = setServiceAccountExpiry(ctx, adminClientMock{}, file, "backup", &models.ServiceAccountExpiryRequest{ExpiresAt: "2022-06-20T00:00:00Z"}, now)
+	assert.Nil(err)
+
+	// Test-2: the report lists the service accounts expiring within the duration
+	list, err := listExpiringServiceAccounts(file, 24*time.Hour, now)
+	if assert.Nil(err) && assert.Equal(int64(1), list.Total) {
+		assert.Equal("ci", list.Accounts[0].AccessKey)
+	}
+	list, _ = listExpiringServiceAccounts(file, 30*24*time.Hour, now)
+	assert.Equal(int64(2), list.Total)
+
+	// Test-3: expired service accounts are disabled, then deleted
+	assert.Nil(enforceServiceAccountExpiry(ctx, adminClientMock{}, file, now.Add(time.Hour), 24*time.Hour))
+	assert.Equal("on", status["ci"])
+	assert.Nil(enforceServiceAccountExpiry(ctx, adminClientMock{}, file, now.Add(2*time.Hour), 24*time.Hour))
+	assert.Equal("off", status["ci"])
+	list, _ = listExpiringServiceAccounts(file, 0, now.Add(2*time.Hour))
+	if assert.Len(list.Accounts, 1) {
+		assert.Equal(models.ServiceAccountExpiryStatusDisabled, list.Accounts[0].Status)
+		assert.Equal("2022-06-02T12:00:00Z", list.Accounts[0].DeleteAt)
+	}
+	assert.Nil(enforceServiceAccountExpiry(ctx, adminClientMock{}, file, now.Add(26*time.Hour), 24*time.Hour))
+	assert.NotContains(status, "ci")
+	list, _ = listExpiringServiceAccounts(file, 30*24*time.Hour, now)
+	assert.Equal(int64(1), list.Total)
+
+	// Test-4: a new expiration enables a disabled service account again and an empty one removes it
+	assert.Nil(enforceServiceAccountExpiry(ctx, adminClientMock{}, file, now.Add(20*24*time.Hour), 24*time.Hour))
+	assert.Equal("off", status["backup"])
+	account, err = setServiceAccountExpiry(ctx, adminClientMock{}, file, "backup", &models.ServiceAccountExpiryRequest{}, now.Add(20*24*time.Hour))
+	if assert.Nil(err) {
+		assert.Empty(account.ExpiresAt)
+		assert.Equal(models.ServiceAccountExpiryStatusActive, account.Status)
+	}
+	assert.Equal("on", status["backup"])
+	list, _ = listExpiringServiceAccounts(file, 365*24*time.Hour, now)
+	assert.Empty(list.Accounts)
+
+	// Test-5: invalid requests
+	_, err = setServiceAccountExpiry(ctx, adminClientMock{}, file, "ci", &models.ServiceAccountExpiryRequest{ExpiresIn: "1h"}, now)
+	assert.Equal(ErrServiceAccountNotFound, err)
+	_, err = setServiceAccountExpiry(ctx, adminClientMock{}, file, "backup", &models.ServiceAccountExpiryRequest{ExpiresAt: "2022-05-01T00:00:00Z"}, now)
+	assert.Equal("invalid service account expiration: the expiration must be in the future", err.Error())
+	_, err = setServiceAccountExpiry(ctx, adminClientMock{}, file, "backup", &models.ServiceAccountExpiryRequest{ExpiresIn: "soon"}, now)
+	assert.True(errors.Is(err, ErrInvalidServiceAccountExpiry))
+}
+
+func TestRotateServiceAccount(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "service-accounts-expiry.json")
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	var created []*iampolicy.Policy
+	minioInfoServiceAccountMock = func(ctx context.Context, serviceAccount string) (madmin.InfoServiceAccountResp, error) {
+		return madmin.InfoServiceAccountResp{
+			ParentUser: "alice",
+			Policy:     `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::logs/*"]}]}`,
+		}, nil
+	}
+	minioAddServiceAccountMock = func(ctx context.Context, policy *iampolicy.Policy, user string, accessKey string, secretKey string) (madmin.Credentials, error) {
+		created = append(created, policy)
+		return madmin.Credentials{AccessKey: "newkey", SecretKey: "newsecret"}, nil
+	}
+
+	// Test-1: a new service account with the same policy is created and the old one expires after the grace period
+	rotation, err := rotateServiceAccount(ctx, adminClientMock{}, file, "ci", &models.ServiceAccountRotateRequest{GracePeriod: "1h", ExpiresIn: "720h"}, now)
+	if !assert.Nil(err) {
+		return
+	}
+	assert.Equal("newkey", rotation.AccessKey)
+	assert.Equal("newsecret", rotation.SecretKey)
+	assert.Equal("2022-06-01T11:00:00Z", rotation.PreviousExpiresAt)
+	assert.Equal("2022-07-01T10:00:00Z", rotation.ExpiresAt)
+	if assert.Len(created, 1) && assert.NotNil(created[0]) {
+		assert.True(created[0].IsAllowed(iampolicy.Args{Action: iampolicy.GetObjectAction, BucketName: "logs", ObjectName: "app.log"}))
+	}
+	accounts, err := readServiceAccountExpiry(file)
+	if assert.Nil(err) && assert.Len(accounts, 2) {
+		assert.Equal("newkey", accounts[0].RotatedTo)
+		assert.Equal("ci", accounts[1].RotatedFrom)
+	}
+
+	// Test-2: a service account is rotated once
+	_, err = rotateServiceAccount(ctx, adminClientMock{}, file, "ci", &models.ServiceAccountRotateRequest{}, now)
+	assert.Equal("invalid service account expiration: the service account was rotated already to newkey", err.Error())
+
+	// Test-3: the default grace period is kept shorter by an earlier expiration
+	_, err = setServiceAccountExpiry(ctx, adminClientMock{}, file, "backup", &models.ServiceAccountExpiryRequest{ExpiresIn: "2h"}, now)
+	assert.Nil(err)
+	rotation, err = rotateServiceAccount(ctx, adminClientMock{}, file, "backup", &models.ServiceAccountRotateRequest{}, now)
+	if assert.Nil(err) {
+		assert.Equal("2022-06-01T12:00:00Z", rotation.PreviousExpiresAt)
+		assert.Empty(rotation.ExpiresAt)
+	}
+	_, err = rotateServiceAccount(ctx, adminClientMock{}, file, "other", &models.ServiceAccountRotateRequest{GracePeriod: "-1h"}, now)
+	assert.True(errors.Is(err, ErrInvalidServiceAccountExpiry))
+}
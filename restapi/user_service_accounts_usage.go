@@ -0,0 +1,493 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GuinsooLab/console/models"
+	"github.com/GuinsooLab/console/pkg/utils"
+	"github.com/GuinsooLab/console/restapi/operations"
+	saApi "github.com/GuinsooLab/console/restapi/operations/service_account"
+	userApi "github.com/GuinsooLab/console/restapi/operations/user"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/madmin-go"
+)
+
+// serviceAccountUsageSourceIPs is the number of recent client addresses kept per access key
+const serviceAccountUsageSourceIPs = 10
+
+// serviceAccountUsageTracker accumulates the requests seen on the trace stream per service account,
+// the usage is kept in memory and written to `file` by the collector. Only the access keys in `known`
+// are tracked, the collector refreshes them from MinIO
+type serviceAccountUsageTracker struct {
+	sync.Mutex
+	file   string
+	loaded bool
+	dirty  bool
+	since  time.Time
+	usage  map[string]*models.ServiceAccountUsage
+	known  map[string]bool
+}
+
+// serviceAccountUsageState is the content of the usage file
+type serviceAccountUsageState struct {
+	Since    time.Time                              `json:"since"`
+	Accounts map[string]*models.ServiceAccountUsage `json:"accounts"`
+}
+
+// serviceAccountUsage is the usage tracked by this console
+var serviceAccountUsage = &serviceAccountUsageTracker{}
+
+// serviceAccountUsageCollector reads the S3 requests from the trace stream and writes the usage every `interval`
+type serviceAccountUsageCollector struct {
+	tracker  *serviceAccountUsageTracker
+	interval time.Duration
+	admin    MinioAdmin
+}
+
+func registerServiceAccountUsageHandlers(api *operations.ConsoleAPI) {
+	// list the usage of the user service accounts
+	api.ServiceAccountListUserServiceAccountsUsageHandler = saApi.ListUserServiceAccountsUsageHandlerFunc(func(params saApi.ListUserServiceAccountsUsageParams, session *models.Principal) middleware.Responder {
+		resp, err := getUserServiceAccountsUsageResponse(params.HTTPRequest.Context(), session, "")
+		if err != nil {
+			return saApi.NewListUserServiceAccountsUsageDefault(int(err.Code)).WithPayload(err)
+		}
+		return saApi.NewListUserServiceAccountsUsageOK().WithPayload(resp)
+	})
+	// list the usage of the service accounts of a user
+	api.UserListAUserServiceAccountsUsageHandler = userApi.ListAUserServiceAccountsUsageHandlerFunc(func(params userApi.ListAUserServiceAccountsUsageParams, session *models.Principal) middleware.Responder {
+		resp, err := getUserServiceAccountsUsageResponse(params.HTTPRequest.Context(), session, params.Name)
+		if err != nil {
+			return userApi.NewListAUserServiceAccountsUsageDefault(int(err.Code)).WithPayload(err)
+		}
+		return userApi.NewListAUserServiceAccountsUsageOK().WithPayload(resp)
+	})
+	// list stale service accounts
+	api.ServiceAccountListStaleServiceAccountsHandler = saApi.ListStaleServiceAccountsHandlerFunc(func(params saApi.ListStaleServiceAccountsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListStaleServiceAccountsResponse(session, params)
+		if err != nil {
+			return saApi.NewListStaleServiceAccountsDefault(int(err.Code)).WithPayload(err)
+		}
+		return saApi.NewListStaleServiceAccountsOK().WithPayload(resp)
+	})
+}
+
+// getServiceAccountUsageFile returns the file keeping the usage of the access keys
+func getServiceAccountUsageFile() string {
+	return filepath.Join(getDataDir(), "service-accounts-usage.json")
+}
+
+// loadLocked reads the usage file the first time the tracker is used, the lock must be held
+func (t *serviceAccountUsageTracker) loadLocked() error {
+	if t.loaded {
+		return nil
+	}
+	if t.file == "" {
+		t.file = getServiceAccountUsageFile()
+	}
+	t.usage = map[string]*models.ServiceAccountUsage{}
+	b, err := os.ReadFile(t.file)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err == nil {
+		var state serviceAccountUsageState
+		if err = json.Unmarshal(b, &state); err != nil {
+			return err
+		}
+		t.since = state.Since
+		if state.Accounts != nil {
+			t.usage = state.Accounts
+		}
+	}
+	t.loaded = true
+	return nil
+}
+
+// start marks when the usage started being tracked, unless it was tracked before
+func (t *serviceAccountUsageTracker) start(now time.Time) error {
+	t.Lock()
+	defer t.Unlock()
+	if err := t.loadLocked(); err != nil {
+		return err
+	}
+	if t.since.IsZero() {
+		t.since = now.UTC()
+		t.dirty = true
+	}
+	return nil
+}
+
+// setKnown replaces the service accounts being tracked and drops the usage of the ones that no longer exist
+func (t *serviceAccountUsageTracker) setKnown(accessKeys map[string]string) error {
+	t.Lock()
+	defer t.Unlock()
+	if err := t.loadLocked(); err != nil {
+		return err
+	}
+	t.known = map[string]bool{}
+	for accessKey := range accessKeys {
+		t.known[accessKey] = true
+	}
+	for accessKey := range t.usage {
+		if !t.known[accessKey] {
+			delete(t.usage, accessKey)
+			t.dirty = true
+		}
+	}
+	return nil
+}
+
+// record counts a traced request for the service account that signed it, requests signed by users,
+// root or temporary credentials are ignored
+func (t *serviceAccountUsageTracker) record(trace madmin.TraceInfo) {
+	if trace.HTTP == nil {
+		return
+	}
+	accessKey := traceAccessKey(trace.HTTP.ReqInfo)
+	if accessKey == "" {
+		return
+	}
+	t.Lock()
+	defer t.Unlock()
+	if err := t.loadLocked(); err != nil {
+		LogError("unable to load the service accounts usage: %v", err)
+		return
+	}
+	if !t.known[accessKey] {
+		return
+	}
+	usage, ok := t.usage[accessKey]
+	if !ok {
+		usage = &models.ServiceAccountUsage{AccessKey: accessKey, APICalls: map[string]int64{}, SourceIps: []string{}}
+		t.usage[accessKey] = usage
+	}
+	when := trace.Time
+	if when.IsZero() {
+		when = trace.HTTP.ReqInfo.Time
+	}
+	if lastUsed := when.UTC().Format(time.RFC3339); lastUsed > usage.LastUsed {
+		usage.LastUsed = lastUsed
+	}
+	if usage.APICalls == nil {
+		usage.APICalls = map[string]int64{}
+	}
+	usage.APICalls[trace.FuncName]++
+	usage.TotalCalls++
+	if ip := traceClientHost(trace.HTTP.ReqInfo.Client); ip != "" {
+		// the most recent address goes last
+		ips := []string{}
+		for _, seen := range usage.SourceIps {
+			if seen != ip {
+				ips = append(ips, seen)
+			}
+		}
+		ips = append(ips, ip)
+		if len(ips) > serviceAccountUsageSourceIPs {
+			ips = ips[len(ips)-serviceAccountUsageSourceIPs:]
+		}
+		usage.SourceIps = ips
+	}
+	t.dirty = true
+}
+
+// forget drops the usage of a deleted access key
+func (t *serviceAccountUsageTracker) forget(accessKey string) {
+	t.Lock()
+	defer t.Unlock()
+	if err := t.loadLocked(); err != nil {
+		return
+	}
+	delete(t.known, accessKey)
+	if _, ok := t.usage[accessKey]; ok {
+		delete(t.usage, accessKey)
+		t.dirty = true
+	}
+}
+
+// flush writes the usage if it changed since the last write
+func (t *serviceAccountUsageTracker) flush() error {
+	t.Lock()
+	defer t.Unlock()
+	if !t.loaded || !t.dirty {
+		return nil
+	}
+	if err := writeDataFile(t.file, serviceAccountUsageState{Since: t.since, Accounts: t.usage}); err != nil {
+		return err
+	}
+	t.dirty = false
+	return nil
+}
+
+// snapshot returns a copy of the usage of the access keys and when the tracking started
+func (t *serviceAccountUsageTracker) snapshot(accessKeys map[string]string) ([]*models.ServiceAccountUsage, time.Time, error) {
+	t.Lock()
+	defer t.Unlock()
+	if err := t.loadLocked(); err != nil {
+		return nil, time.Time{}, err
+	}
+	result := make([]*models.ServiceAccountUsage, 0, len(accessKeys))
+	for accessKey, parentUser := range accessKeys {
+		usage := &models.ServiceAccountUsage{AccessKey: accessKey, ParentUser: parentUser, APICalls: map[string]int64{}, SourceIps: []string{}, NeverUsed: true}
+		if tracked, ok := t.usage[accessKey]; ok {
+			usage.LastUsed = tracked.LastUsed
+			usage.NeverUsed = false
+			usage.TotalCalls = tracked.TotalCalls
+			usage.SourceIps = append(usage.SourceIps, tracked.SourceIps...)
+			for api, count := range tracked.APICalls {
+				usage.APICalls[api] = count
+			}
+		}
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].AccessKey < result[j].AccessKey
+	})
+	return result, t.since, nil
+}
+
+// traceAccessKey returns the access key signing a traced request, MinIO only redacts the signature
+func traceAccessKey(req madmin.TraceRequestInfo) string {
+	if auth := req.Headers.Get("Authorization"); auth != "" {
+		// AWS4-HMAC-SHA256 Credential=<access key>/<scope>, SignedHeaders=..., Signature=...
+		if i := strings.Index(auth, "Credential="); i >= 0 {
+			return strings.Split(auth[i+len("Credential="):], "/")[0]
+		}
+		// AWS <access key>:<signature>
+		if strings.HasPrefix(auth, "AWS ") {
+			if i := strings.LastIndex(auth, ":"); i > len("AWS ") {
+				return auth[len("AWS "):i]
+			}
+		}
+	}
+	// presigned requests
+	query, err := url.ParseQuery(req.RawQuery)
+	if err != nil {
+		return ""
+	}
+	if credential := query.Get("X-Amz-Credential"); credential != "" {
+		return strings.Split(credential, "/")[0]
+	}
+	return query.Get("AWSAccessKeyId")
+}
+
+// startServiceAccountUsageCollector starts tracking the usage of the access keys if configured, the
+// trace stream is read with the credentials used to expire the service accounts
+func startServiceAccountUsageCollector(ctx context.Context) {
+	accessKey, secretKey := getServiceAccountsCredentials()
+	if accessKey == "" || secretKey == "" {
+		LogInfo("service account usage disabled, %s and %s are required", ConsoleServiceAccountsAccessKey, ConsoleServiceAccountsSecretKey)
+		return
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		LogError("service account usage disabled: %v", err)
+		return
+	}
+	mAdmin.SetCustomTransport(GetConsoleHTTPClient().Transport)
+	if err = serviceAccountUsage.start(time.Now()); err != nil {
+		LogError("service account usage disabled: %v", err)
+		return
+	}
+	collector := &serviceAccountUsageCollector{
+		tracker:  serviceAccountUsage,
+		interval: getServiceAccountsInterval(),
+		admin:    AdminClient{Client: mAdmin},
+	}
+	go collector.run(ctx)
+}
+
+// run reads the trace stream, reconnecting after `interval` when it ends
+func (c *serviceAccountUsageCollector) run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		c.refresh(ctx)
+		c.consume(ctx, c.admin.serviceTrace(ctx, 0, true, false, false, false, false), ticker.C)
+		if err := c.tracker.flush(); err != nil {
+			LogError("unable to save the service accounts usage: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.interval):
+		}
+	}
+}
+
+func (c *serviceAccountUsageCollector) consume(ctx context.Context, traceCh <-chan madmin.ServiceTraceInfo, flushCh <-chan time.Time) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-flushCh:
+			c.refresh(ctx)
+			if err := c.tracker.flush(); err != nil {
+				LogError("unable to save the service accounts usage: %v", err)
+			}
+		case info, ok := <-traceCh:
+			if !ok {
+				return
+			}
+			if info.Err != nil {
+				LogError("error reading the trace stream: %v", info.Err)
+				continue
+			}
+			c.tracker.record(info.Trace)
+		}
+	}
+}
+
+// refresh lists the existing service accounts, the current ones are kept when they can't be listed
+func (c *serviceAccountUsageCollector) refresh(ctx context.Context) {
+	accessKeys, err := listAllServiceAccounts(ctx, c.admin)
+	if err == nil {
+		err = c.tracker.setKnown(accessKeys)
+	}
+	if err != nil {
+		LogError("unable to list the service accounts: %v", err)
+	}
+}
+
+// listAllServiceAccounts returns the service accounts of every user with their parent user
+func listAllServiceAccounts(ctx context.Context, client MinioAdmin) (map[string]string, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accessKeys := map[string]string{}
+	for user := range users {
+		serviceAccounts, err := client.listServiceAccounts(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		for _, accessKey := range serviceAccounts.Accounts {
+			accessKeys[accessKey] = user
+		}
+	}
+	return accessKeys, nil
+}
+
+func newServiceAccountUsageList(accounts []*models.ServiceAccountUsage, since time.Time) *models.ServiceAccountUsageList {
+	list := &models.ServiceAccountUsageList{Accounts: accounts, Total: int64(len(accounts))}
+	if !since.IsZero() {
+		list.TrackedSince = since.Format(time.RFC3339)
+	}
+	return list
+}
+
+// getUserServiceAccountsUsage returns the usage of the service accounts of a user, of the session
+// user when empty
+func getUserServiceAccountsUsage(ctx context.Context, userClient MinioAdmin, tracker *serviceAccountUsageTracker, user, parentUser string) (*models.ServiceAccountUsageList, error) {
+	serviceAccounts, err := getUserServiceAccounts(ctx, userClient, user)
+	if err != nil {
+		return nil, err
+	}
+	accessKeys := map[string]string{}
+	for _, accessKey := range serviceAccounts {
+		accessKeys[accessKey] = parentUser
+	}
+	accounts, since, err := tracker.snapshot(accessKeys)
+	if err != nil {
+		return nil, err
+	}
+	return newServiceAccountUsageList(accounts, since), nil
+}
+
+// listStaleServiceAccounts returns the service accounts of every user not used within `unusedFor`,
+// the never used ones first and then the least recently used
+func listStaleServiceAccounts(ctx context.Context, client MinioAdmin, tracker *serviceAccountUsageTracker, unusedFor time.Duration, now time.Time) (*models.ServiceAccountUsageList, error) {
+	accessKeys, err := listAllServiceAccounts(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	accounts, since, err := tracker.snapshot(accessKeys)
+	if err != nil {
+		return nil, err
+	}
+	if since.IsZero() {
+		return nil, ErrServiceAccountUsageDisabled
+	}
+	cutoff := now.Add(-unusedFor).UTC().Format(time.RFC3339)
+	stale := []*models.ServiceAccountUsage{}
+	for _, account := range accounts {
+		if account.LastUsed < cutoff {
+			stale = append(stale, account)
+		}
+	}
+	// RFC 3339 times in UTC sort as strings, never used accounts have no time
+	sort.SliceStable(stale, func(i, j int) bool {
+		return stale[i].LastUsed < stale[j].LastUsed
+	})
+	return newServiceAccountUsageList(stale, since), nil
+}
+
+// getUserServiceAccountsUsageResponse authenticates the user and returns the usage of the service
+// accounts of the user, base64 encoded, or of the session user
+func getUserServiceAccountsUsageResponse(ctx context.Context, session *models.Principal, user string) (*models.ServiceAccountUsageList, *models.Error) {
+	userAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO user Admin Client interface implementation
+	// defining the client to be used
+	userAdminClient := AdminClient{Client: userAdmin}
+	parentUser := session.AccountAccessKey
+	if user != "" {
+		if user, err = utils.DecodeBase64(user); err != nil {
+			return nil, ErrorWithContext(ctx, err)
+		}
+		parentUser = user
+	}
+	usage, err := getUserServiceAccountsUsage(ctx, userAdminClient, serviceAccountUsage, user, parentUser)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return usage, nil
+}
+
+func getListStaleServiceAccountsResponse(session *models.Principal, params saApi.ListStaleServiceAccountsParams) (*models.ServiceAccountUsageList, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	unusedFor, err := time.ParseDuration(swag.StringValue(params.UnusedFor))
+	if err != nil || unusedFor < 0 {
+		return nil, ErrorWithContext(ctx, fmt.Errorf("%w: invalid duration %q", ErrInvalidStaleServiceAccounts, swag.StringValue(params.UnusedFor)))
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	stale, err := listStaleServiceAccounts(ctx, adminClient, serviceAccountUsage, unusedFor, time.Now())
+	if err != nil {
+		return nil, ErrorWithContext(ctx, err)
+	}
+	return stale, nil
+}
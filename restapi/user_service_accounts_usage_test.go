@@ -0,0 +1,140 @@
+// This file is part of GuinsooLab Console Server
+// Copyright (c) 2022 GuinsooLab, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func usageTrace(funcName, client string, when time.Time, headers http.Header, rawQuery string) madmin.TraceInfo {
+	return madmin.TraceInfo{
+		FuncName: funcName,
+		Time:     when,
+		HTTP:     &madmin.TraceHTTPStats{ReqInfo: madmin.TraceRequestInfo{Client: client, Headers: headers, RawQuery: rawQuery}},
+	}
+}
+
+func TestTraceAccessKey(t *testing.T) {
+	assert := assert.New(t)
+	v4 := http.Header{"Authorization": {"AWS4-HMAC-SHA256 Credential=ci/20220601/us-east-1/s3/aws4_request, SignedHeaders=host, Signature=*REDACTED*"}}
+	assert.Equal("ci", traceAccessKey(madmin.TraceRequestInfo{Headers: v4}))
+	v2 := http.Header{"Authorization": {"AWS backup:c2lnbmF0dXJl"}}
+	assert.Equal("backup", traceAccessKey(madmin.TraceRequestInfo{Headers: v2}))
+	assert.Equal("share", traceAccessKey(madmin.TraceRequestInfo{RawQuery: "X-Amz-Algorithm=AWS4-HMAC-SHA256&X-Amz-Credential=share%2F20220601%2Fus-east-1%2Fs3%2Faws4_request"}))
+	assert.Equal("", traceAccessKey(madmin.TraceRequestInfo{}))
+}
+
+func TestServiceAccountUsage(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "service-accounts-usage.json")
+	now := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	signed := func(accessKey string) http.Header {
+		return http.Header{"Authorization": {fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/20220601/us-east-1/s3/aws4_request", accessKey)}}
+	}
+	minioListUsersMock = func() (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{"alice": {}, "bob": {}}, nil
+	}
+	minioListServiceAccountsMock = func(ctx context.Context, user string) (madmin.ListServiceAccountsResp, error) {
+		if user == "alice" {
+			return madmin.ListServiceAccountsResp{Accounts: []string{"ci", "backup"}}, nil
+		}
+		return madmin.ListServiceAccountsResp{Accounts: []string{"old", "unused"}}, nil
+	}
+
+	// Test-1: the stale report needs the usage to be tracked
+	tracker := &serviceAccountUsageTracker{file: file}
+	_, err := listStaleServiceAccounts(ctx, adminClientMock{}, tracker, time.Hour, now)
+	assert.Equal(ErrServiceAccountUsageDisabled, err)
+
+	// Test-2: requests are counted per service account with the recent source addresses
+	assert.Nil(tracker.start(now.Add(-60 * 24 * time.Hour)))
+	accessKeys, err := listAllServiceAccounts(ctx, adminClientMock{})
+	assert.Nil(err)
+	assert.Nil(tracker.setKnown(accessKeys))
+	tracker.record(usageTrace("s3.PutObject", "10.0.0.1:5000", now.Add(-time.Hour), signed("ci"), ""))
+	tracker.record(usageTrace("s3.PutObject", "10.0.0.2:5000", now.Add(-2*time.Hour), signed("ci"), ""))
+	tracker.record(usageTrace("s3.GetObject", "10.0.0.1:5001", now.Add(-30*time.Minute), signed("ci"), ""))
+	tracker.record(usageTrace("s3.GetObject", "10.0.0.3:5000", now.Add(-40*24*time.Hour), signed("old"), ""))
+	tracker.record(usageTrace("s3.ListBuckets", "10.0.0.3:5000", now, nil, ""))
+	tracker.record(usageTrace("s3.ListBuckets", "10.0.0.4:5000", now, signed("alice"), ""))
+	assert.NotContains(tracker.usage, "alice")
+	usage, err := getUserServiceAccountsUsage(ctx, adminClientMock{}, tracker, "alice", "alice")
+	if !assert.Nil(err) || !assert.Len(usage.Accounts, 2) {
+		return
+	}
+	assert.Equal("2022-04-02T10:00:00Z", usage.TrackedSince)
+	assert.Equal("backup", usage.Accounts[0].AccessKey)
+	assert.True(usage.Accounts[0].NeverUsed)
+	ci := usage.Accounts[1]
+	assert.Equal("alice", ci.ParentUser)
+	assert.Equal("2022-06-01T09:30:00Z", ci.LastUsed)
+	assert.Equal(int64(3), ci.TotalCalls)
+	assert.Equal(map[string]int64{"s3.PutObject": 2, "s3.GetObject": 1}, ci.APICalls)
+	assert.Equal([]string{"10.0.0.2", "10.0.0.1"}, ci.SourceIps)
+
+	// Test-3: the usage survives a restart once flushed
+	assert.Nil(tracker.flush())
+	tracker = &serviceAccountUsageTracker{file: file}
+	stale, err := listStaleServiceAccounts(ctx, adminClientMock{}, tracker, 30*24*time.Hour, now)
+	if assert.Nil(err) && assert.Len(stale.Accounts, 3) {
+		assert.True(stale.Accounts[0].NeverUsed)
+		assert.True(stale.Accounts[1].NeverUsed)
+		assert.Equal("old", stale.Accounts[2].AccessKey)
+		assert.Equal("bob", stale.Accounts[2].ParentUser)
+	}
+
+	// Test-4: deleted access keys are forgotten
+	tracker.forget("old")
+	stale, _ = listStaleServiceAccounts(ctx, adminClientMock{}, tracker, 30*24*time.Hour, now)
+	assert.Equal(int64(3), stale.Total)
+	assert.True(stale.Accounts[2].NeverUsed)
+
+	// Test-5: the usage of the service accounts that no longer exist is dropped
+	assert.Nil(tracker.setKnown(map[string]string{"backup": "alice"}))
+	assert.Empty(tracker.usage)
+	tracker.record(usageTrace("s3.GetObject", "10.0.0.1:5000", now, signed("ci"), ""))
+	assert.Empty(tracker.usage)
+}
+
+func TestServiceAccountUsageCollector(t *testing.T) {
+	assert := assert.New(t)
+	tracker := &serviceAccountUsageTracker{file: filepath.Join(t.TempDir(), "service-accounts-usage.json")}
+	assert.Nil(tracker.setKnown(map[string]string{"ci": "alice"}))
+	traceCh := make(chan madmin.ServiceTraceInfo, 4)
+	traceCh <- madmin.ServiceTraceInfo{Trace: usageTrace("s3.GetObject", "10.0.0.1:5000", time.Now(),
+		http.Header{"Authorization": {"AWS4-HMAC-SHA256 Credential=ci/20220601/us-east-1/s3/aws4_request"}}, "")}
+	traceCh <- madmin.ServiceTraceInfo{Trace: usageTrace("s3.GetObject", "10.0.0.1:5000", time.Now(),
+		http.Header{"Authorization": {"AWS4-HMAC-SHA256 Credential=minioadmin/20220601/us-east-1/s3/aws4_request"}}, "")}
+	traceCh <- madmin.ServiceTraceInfo{Err: fmt.Errorf("connection reset")}
+	close(traceCh)
+	collector := &serviceAccountUsageCollector{tracker: tracker, interval: time.Minute}
+	collector.consume(context.Background(), traceCh, nil)
+	accounts, _, err := tracker.snapshot(map[string]string{"ci": "alice"})
+	if assert.Nil(err) && assert.Len(accounts, 1) {
+		assert.Equal(int64(1), accounts[0].TotalCalls)
+	}
+	assert.Len(tracker.usage, 1)
+}
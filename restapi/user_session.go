@@ -32,6 +32,7 @@ import (
 	minioIAMPolicy "github.com/minio/pkg/iam/policy"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/GuinsooLab/console/models"
 	"github.com/GuinsooLab/console/pkg/auth/idp/oauth2"
 	"github.com/GuinsooLab/console/pkg/auth/ldap"
@@ -248,6 +249,27 @@ func getSessionResponse(ctx context.Context, session *models.Principal) (*models
 	return sessionResp, nil
 }
 
+// validateSessionAdminAction verifies the session is allowed to perform the admin action, used by the
+// endpoints serving data kept by console itself where no request reaches MinIO to enforce the policy
+func validateSessionAdminAction(ctx context.Context, session *models.Principal, action minioIAMPolicy.AdminAction, detailedMessage string) *models.Error {
+	sessionResp, err := getSessionResponse(ctx, session)
+	if err != nil {
+		return err
+	}
+	if permissions, ok := sessionResp.Permissions[ConsoleResourceName]; ok {
+		for _, permission := range permissions {
+			if permission == string(action) {
+				return nil
+			}
+		}
+	}
+	return &models.Error{
+		Code:            int32(403),
+		Message:         swag.String("Forbidden"),
+		DetailedMessage: swag.String(detailedMessage),
+	}
+}
+
 // getListOfEnabledFeatures returns a list of features
 func getListOfEnabledFeatures(session *models.Principal) []string {
 	features := []string{}
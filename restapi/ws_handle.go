@@ -21,9 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
-	"strconv"
 	"strings"
-	"time"
 
 	"github.com/GuinsooLab/console/pkg/utils"
 
@@ -31,7 +29,6 @@ import (
 	"github.com/GuinsooLab/console/pkg/auth"
 	"github.com/go-openapi/errors"
 	"github.com/gorilla/websocket"
-	"github.com/minio/madmin-go"
 )
 
 var upgrader = websocket.Upgrader{
@@ -94,16 +91,17 @@ type TraceRequest struct {
 	os         bool
 	threshold  int64
 	onlyErrors bool
-	statusCode int64
-	method     string
-	funcName   string
-	path       string
+	filter     *traceFilter
+	record     bool
+	query      string
 }
 
 // Type for log requests. This allows for filtering by node and kind
 type LogRequest struct {
-	node    string
-	logType string
+	node      string
+	logType   string
+	lineCount int
+	filter    *consoleLogFilter
 }
 
 func (c wsConn) writeMessage(messageType int, data []byte) error {
@@ -150,59 +148,49 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 	wsPath := strings.TrimPrefix(req.URL.Path, wsBasePath)
 	switch {
 	case strings.HasPrefix(wsPath, `/trace`):
+		traceRequestItem, err := getTraceOptionsFromReq(req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting trace options: %v", err))
+			closeWsConn(conn)
+			return
+		}
 		wsAdminClient, err := newWebSocketAdminClient(conn, session)
 		if err != nil {
 			ErrorWithContext(ctx, err)
 			closeWsConn(conn)
 			return
 		}
-
-		calls := req.URL.Query().Get("calls")
-		threshold, _ := strconv.ParseInt(req.URL.Query().Get("threshold"), 10, 64)
-		onlyErrors := req.URL.Query().Get("onlyErrors")
-		stCode, errorStCode := strconv.ParseInt(req.URL.Query().Get("statusCode"), 10, 64)
-		method := req.URL.Query().Get("method")
-		funcName := req.URL.Query().Get("funcname")
-		path := req.URL.Query().Get("path")
-
-		statusCode := int64(0)
-
-		if errorStCode == nil {
-			statusCode = stCode
+		go wsAdminClient.trace(ctx, traceRequestItem)
+	case strings.HasPrefix(wsPath, `/api-stats`):
+		apiStatsOpts, err := getAPIStatsOptionsFromReq(req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting api stats options: %v", err))
+			closeWsConn(conn)
+			return
 		}
-
-		traceRequestItem := TraceRequest{
-			s3:         strings.Contains(calls, "s3") || strings.Contains(calls, "all"),
-			internal:   strings.Contains(calls, "internal") || strings.Contains(calls, "all"),
-			storage:    strings.Contains(calls, "storage") || strings.Contains(calls, "all"),
-			os:         strings.Contains(calls, "os") || strings.Contains(calls, "all"),
-			onlyErrors: onlyErrors == "yes",
-			threshold:  threshold,
-			statusCode: statusCode,
-			method:     method,
-			funcName:   funcName,
-			path:       path,
+		wsAdminClient, err := newWebSocketAdminClient(conn, session)
+		if err != nil {
+			ErrorWithContext(ctx, err)
+			closeWsConn(conn)
+			return
 		}
-
-		go wsAdminClient.trace(ctx, traceRequestItem)
+		go wsAdminClient.apiStats(ctx, apiStatsOpts)
 	case strings.HasPrefix(wsPath, `/console`):
-
+		logRequestItem, err := getLogOptionsFromReq(req)
+		if err != nil {
+			ErrorWithContext(ctx, fmt.Errorf("error getting console log options: %v", err))
+			closeWsConn(conn)
+			return
+		}
 		wsAdminClient, err := newWebSocketAdminClient(conn, session)
 		if err != nil {
 			ErrorWithContext(ctx, err)
 			closeWsConn(conn)
 			return
 		}
-		node := req.URL.Query().Get("node")
-		logType := req.URL.Query().Get("logType")
-
-		logRequestItem := LogRequest{
-			node:    node,
-			logType: logType,
-		}
 		go wsAdminClient.console(ctx, logRequestItem)
 	case strings.HasPrefix(wsPath, `/health-info`):
-		deadline, err := getHealthInfoOptionsFromReq(req)
+		healthInfoOpts, err := getHealthInfoOptionsFromReq(req)
 		if err != nil {
 			ErrorWithContext(ctx, fmt.Errorf("error getting health info options: %v", err))
 			closeWsConn(conn)
@@ -214,7 +202,7 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			closeWsConn(conn)
 			return
 		}
-		go wsAdminClient.healthInfo(ctx, deadline)
+		go wsAdminClient.healthInfo(ctx, healthInfoOpts)
 	case strings.HasPrefix(wsPath, `/heal`):
 		hOptions, err := getHealOptionsFromReq(req)
 		if err != nil {
@@ -222,6 +210,7 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			closeWsConn(conn)
 			return
 		}
+		hOptions.Owner = session.AccountAccessKey
 		wsAdminClient, err := newWebSocketAdminClient(conn, session)
 		if err != nil {
 			ErrorWithContext(ctx, err)
@@ -387,6 +376,23 @@ func (wsc *wsAdminClient) trace(ctx context.Context, traceRequestItem TraceReque
 	sendWsCloseMessage(wsc.conn, err)
 }
 
+// apiStats serves rolling aggregates of madmin.ServiceTraceInfo
+// on a Websocket connection.
+func (wsc *wsAdminClient) apiStats(ctx context.Context, opts *apiStatsRequest) {
+	defer func() {
+		LogInfo("api stats stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfo("api stats started")
+
+	ctx = wsReadClientCtx(ctx, wsc.conn)
+
+	err := startAPIStats(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
 // console serves madmin.GetLogs
 // on a Websocket connection.
 func (wsc *wsAdminClient) console(ctx context.Context, logRequestItem LogRequest) {
@@ -434,7 +440,7 @@ func (wsc *wsAdminClient) heal(ctx context.Context, opts *healOptions) {
 	sendWsCloseMessage(wsc.conn, err)
 }
 
-func (wsc *wsAdminClient) healthInfo(ctx context.Context, deadline *time.Duration) {
+func (wsc *wsAdminClient) healthInfo(ctx context.Context, opts *healthInfoRequest) {
 	defer func() {
 		LogInfo("health info stopped")
 		// close connection after return
@@ -444,12 +450,12 @@ func (wsc *wsAdminClient) healthInfo(ctx context.Context, deadline *time.Duratio
 
 	ctx = wsReadClientCtx(ctx, wsc.conn)
 
-	err := startHealthInfo(ctx, wsc.conn, wsc.client, deadline)
+	err := startHealthInfo(ctx, wsc.conn, wsc.client, &opts.deadline, opts.anonymize)
 
 	sendWsCloseMessage(wsc.conn, err)
 }
 
-func (wsc *wsAdminClient) speedtest(ctx context.Context, opts *madmin.SpeedtestOpts) {
+func (wsc *wsAdminClient) speedtest(ctx context.Context, opts *speedtestRequest) {
 	defer func() {
 		LogInfo("speedtest stopped")
 		// close connection after return